@@ -10,6 +10,7 @@ import (
 	common "github.com/fffeng99999/hcp-server/api/generated/common"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -23,21 +24,62 @@ const (
 )
 
 type Benchmark struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Algorithm     string                 `protobuf:"bytes,4,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
-	NodeCount     int32                  `protobuf:"varint,5,opt,name=node_count,json=nodeCount,proto3" json:"node_count,omitempty"`
-	Duration      int32                  `protobuf:"varint,6,opt,name=duration,proto3" json:"duration,omitempty"`
-	TargetTps     int32                  `protobuf:"varint,7,opt,name=target_tps,json=targetTps,proto3" json:"target_tps,omitempty"`
-	Status        string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
-	ActualTps     float64                `protobuf:"fixed64,9,opt,name=actual_tps,json=actualTps,proto3" json:"actual_tps,omitempty"`
-	LatencyAvg    float64                `protobuf:"fixed64,10,opt,name=latency_avg,json=latencyAvg,proto3" json:"latency_avg,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,20,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     string                 `protobuf:"bytes,21,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name         string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description  string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Algorithm    string                 `protobuf:"bytes,4,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	NodeCount    int32                  `protobuf:"varint,5,opt,name=node_count,json=nodeCount,proto3" json:"node_count,omitempty"`
+	Duration     int32                  `protobuf:"varint,6,opt,name=duration,proto3" json:"duration,omitempty"`
+	TargetTps    int32                  `protobuf:"varint,7,opt,name=target_tps,json=targetTps,proto3" json:"target_tps,omitempty"`
+	Status       string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	ActualTps    float64                `protobuf:"fixed64,9,opt,name=actual_tps,json=actualTps,proto3" json:"actual_tps,omitempty"`
+	LatencyAvg   float64                `protobuf:"fixed64,10,opt,name=latency_avg,json=latencyAvg,proto3" json:"latency_avg,omitempty"`
+	ErrorMessage string                 `protobuf:"bytes,11,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	StartedAt    string                 `protobuf:"bytes,12,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	CompletedAt  string                 `protobuf:"bytes,13,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	// Bounds of the measured part of the run (the running phase).
+	MeasurementStartedAt string            `protobuf:"bytes,14,opt,name=measurement_started_at,json=measurementStartedAt,proto3" json:"measurement_started_at,omitempty"`
+	MeasurementEndedAt   string            `protobuf:"bytes,15,opt,name=measurement_ended_at,json=measurementEndedAt,proto3" json:"measurement_ended_at,omitempty"`
+	Results              *BenchmarkResults `protobuf:"bytes,16,opt,name=results,proto3" json:"results,omitempty"`
+	RunGroup             string            `protobuf:"bytes,17,opt,name=run_group,json=runGroup,proto3" json:"run_group,omitempty"`
+	// Set for runs expanded from an experiment matrix.
+	ExperimentId    string            `protobuf:"bytes,18,opt,name=experiment_id,json=experimentId,proto3" json:"experiment_id,omitempty"`
+	ConsensusParams map[string]string `protobuf:"bytes,19,rep,name=consensus_params,json=consensusParams,proto3" json:"consensus_params,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CreatedAt       string            `protobuf:"bytes,20,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// RFC3339 with sub-second precision; echo it back as
+	// UpdateBenchmarkRequest.expected_updated_at.
+	UpdatedAt string `protobuf:"bytes,21,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Provenance: the template the run was created from and the run it was
+	// cloned from, if any.
+	TemplateId      string           `protobuf:"bytes,22,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	ClonedFromId    string           `protobuf:"bytes,23,opt,name=cloned_from_id,json=clonedFromId,proto3" json:"cloned_from_id,omitempty"`
+	ConsensusConfig *ConsensusConfig `protobuf:"bytes,24,opt,name=consensus_config,json=consensusConfig,proto3" json:"consensus_config,omitempty"`
+	Environment     *Environment     `protobuf:"bytes,25,opt,name=environment,proto3" json:"environment,omitempty"`
+	// Participating nodes; only filled in by GetBenchmark.
+	Nodes      []*BenchmarkNode `protobuf:"bytes,26,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Tags       []string         `protobuf:"bytes,27,rep,name=tags,proto3" json:"tags,omitempty"`
+	ScheduleId string           `protobuf:"bytes,28,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	// Queueing: runs wait for a free slot on their cluster; higher priorities
+	// are dispatched first.
+	Priority int32  `protobuf:"varint,29,opt,name=priority,proto3" json:"priority,omitempty"`
+	Cluster  string `protobuf:"bytes,30,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	// 1-based place in the cluster's queue while queued, 0 otherwise. Only
+	// filled in by CreateBenchmark, CloneBenchmark, GetBenchmark and
+	// GetBenchmarkQueue.
+	QueuePosition int32 `protobuf:"varint,31,opt,name=queue_position,json=queuePosition,proto3" json:"queue_position,omitempty"`
+	// Seconds before and after the measured duration; transactions and metrics
+	// from them are left out of the results.
+	WarmupDuration   int32 `protobuf:"varint,32,opt,name=warmup_duration,json=warmupDuration,proto3" json:"warmup_duration,omitempty"`
+	CooldownDuration int32 `protobuf:"varint,33,opt,name=cooldown_duration,json=cooldownDuration,proto3" json:"cooldown_duration,omitempty"`
+	// SLA assertions and their outcomes, evaluated when the run completes.
+	// sla_status is "passed", "failed", or empty before completion and for runs
+	// without assertions.
+	Assertions       []string           `protobuf:"bytes,34,rep,name=assertions,proto3" json:"assertions,omitempty"`
+	AssertionResults []*AssertionResult `protobuf:"bytes,35,rep,name=assertion_results,json=assertionResults,proto3" json:"assertion_results,omitempty"`
+	SlaStatus        string             `protobuf:"bytes,36,opt,name=sla_status,json=slaStatus,proto3" json:"sla_status,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *Benchmark) Reset() {
@@ -140,6 +182,69 @@ func (x *Benchmark) GetLatencyAvg() float64 {
 	return 0
 }
 
+func (x *Benchmark) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *Benchmark) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+func (x *Benchmark) GetCompletedAt() string {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return ""
+}
+
+func (x *Benchmark) GetMeasurementStartedAt() string {
+	if x != nil {
+		return x.MeasurementStartedAt
+	}
+	return ""
+}
+
+func (x *Benchmark) GetMeasurementEndedAt() string {
+	if x != nil {
+		return x.MeasurementEndedAt
+	}
+	return ""
+}
+
+func (x *Benchmark) GetResults() *BenchmarkResults {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *Benchmark) GetRunGroup() string {
+	if x != nil {
+		return x.RunGroup
+	}
+	return ""
+}
+
+func (x *Benchmark) GetExperimentId() string {
+	if x != nil {
+		return x.ExperimentId
+	}
+	return ""
+}
+
+func (x *Benchmark) GetConsensusParams() map[string]string {
+	if x != nil {
+		return x.ConsensusParams
+	}
+	return nil
+}
+
 func (x *Benchmark) GetCreatedAt() string {
 	if x != nil {
 		return x.CreatedAt
@@ -154,32 +259,139 @@ func (x *Benchmark) GetUpdatedAt() string {
 	return ""
 }
 
-type CreateBenchmarkRequest struct {
+func (x *Benchmark) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *Benchmark) GetClonedFromId() string {
+	if x != nil {
+		return x.ClonedFromId
+	}
+	return ""
+}
+
+func (x *Benchmark) GetConsensusConfig() *ConsensusConfig {
+	if x != nil {
+		return x.ConsensusConfig
+	}
+	return nil
+}
+
+func (x *Benchmark) GetEnvironment() *Environment {
+	if x != nil {
+		return x.Environment
+	}
+	return nil
+}
+
+func (x *Benchmark) GetNodes() []*BenchmarkNode {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *Benchmark) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Benchmark) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+func (x *Benchmark) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *Benchmark) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+func (x *Benchmark) GetQueuePosition() int32 {
+	if x != nil {
+		return x.QueuePosition
+	}
+	return 0
+}
+
+func (x *Benchmark) GetWarmupDuration() int32 {
+	if x != nil {
+		return x.WarmupDuration
+	}
+	return 0
+}
+
+func (x *Benchmark) GetCooldownDuration() int32 {
+	if x != nil {
+		return x.CooldownDuration
+	}
+	return 0
+}
+
+func (x *Benchmark) GetAssertions() []string {
+	if x != nil {
+		return x.Assertions
+	}
+	return nil
+}
+
+func (x *Benchmark) GetAssertionResults() []*AssertionResult {
+	if x != nil {
+		return x.AssertionResults
+	}
+	return nil
+}
+
+func (x *Benchmark) GetSlaStatus() string {
+	if x != nil {
+		return x.SlaStatus
+	}
+	return ""
+}
+
+// AssertionResult is the outcome of one SLA assertion. left and right are the
+// values its two sides evaluated to; error is set when it could not be
+// evaluated, e.g. because a metric was never reported, and counts as failed.
+type AssertionResult struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Algorithm     string                 `protobuf:"bytes,3,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
-	NodeCount     int32                  `protobuf:"varint,4,opt,name=node_count,json=nodeCount,proto3" json:"node_count,omitempty"`
-	Duration      int32                  `protobuf:"varint,5,opt,name=duration,proto3" json:"duration,omitempty"`
-	TargetTps     int32                  `protobuf:"varint,6,opt,name=target_tps,json=targetTps,proto3" json:"target_tps,omitempty"`
+	Assertion     string                 `protobuf:"bytes,1,opt,name=assertion,proto3" json:"assertion,omitempty"`
+	Passed        bool                   `protobuf:"varint,2,opt,name=passed,proto3" json:"passed,omitempty"`
+	Left          float64                `protobuf:"fixed64,3,opt,name=left,proto3" json:"left,omitempty"`
+	Right         float64                `protobuf:"fixed64,4,opt,name=right,proto3" json:"right,omitempty"`
+	Error         string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateBenchmarkRequest) Reset() {
-	*x = CreateBenchmarkRequest{}
+func (x *AssertionResult) Reset() {
+	*x = AssertionResult{}
 	mi := &file_api_proto_benchmark_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateBenchmarkRequest) String() string {
+func (x *AssertionResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateBenchmarkRequest) ProtoMessage() {}
+func (*AssertionResult) ProtoMessage() {}
 
-func (x *CreateBenchmarkRequest) ProtoReflect() protoreflect.Message {
+func (x *AssertionResult) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_benchmark_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -188,78 +400,5251 @@ func (x *CreateBenchmarkRequest) ProtoReflect() protoreflect.Message {
 		}
 		return ms
 	}
-	return mi.MessageOf(x)
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssertionResult.ProtoReflect.Descriptor instead.
+func (*AssertionResult) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AssertionResult) GetAssertion() string {
+	if x != nil {
+		return x.Assertion
+	}
+	return ""
+}
+
+func (x *AssertionResult) GetPassed() bool {
+	if x != nil {
+		return x.Passed
+	}
+	return false
+}
+
+func (x *AssertionResult) GetLeft() float64 {
+	if x != nil {
+		return x.Left
+	}
+	return 0
+}
+
+func (x *AssertionResult) GetRight() float64 {
+	if x != nil {
+		return x.Right
+	}
+	return 0
+}
+
+func (x *AssertionResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// ConsensusConfig holds the consensus settings of a run. Zero means the
+// implementation's default; settings without a field go in consensus_params.
+type ConsensusConfig struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	BlockSize           int32                  `protobuf:"varint,1,opt,name=block_size,json=blockSize,proto3" json:"block_size,omitempty"`
+	BatchSize           int32                  `protobuf:"varint,2,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	BlockIntervalMs     int32                  `protobuf:"varint,3,opt,name=block_interval_ms,json=blockIntervalMs,proto3" json:"block_interval_ms,omitempty"`
+	RequestTimeoutMs    int32                  `protobuf:"varint,4,opt,name=request_timeout_ms,json=requestTimeoutMs,proto3" json:"request_timeout_ms,omitempty"`
+	ViewChangeTimeoutMs int32                  `protobuf:"varint,5,opt,name=view_change_timeout_ms,json=viewChangeTimeoutMs,proto3" json:"view_change_timeout_ms,omitempty"`
+	CheckpointInterval  int32                  `protobuf:"varint,6,opt,name=checkpoint_interval,json=checkpointInterval,proto3" json:"checkpoint_interval,omitempty"`
+	Network             *NetworkEmulation      `protobuf:"bytes,7,opt,name=network,proto3" json:"network,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ConsensusConfig) Reset() {
+	*x = ConsensusConfig{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsensusConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsensusConfig) ProtoMessage() {}
+
+func (x *ConsensusConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsensusConfig.ProtoReflect.Descriptor instead.
+func (*ConsensusConfig) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ConsensusConfig) GetBlockSize() int32 {
+	if x != nil {
+		return x.BlockSize
+	}
+	return 0
+}
+
+func (x *ConsensusConfig) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *ConsensusConfig) GetBlockIntervalMs() int32 {
+	if x != nil {
+		return x.BlockIntervalMs
+	}
+	return 0
+}
+
+func (x *ConsensusConfig) GetRequestTimeoutMs() int32 {
+	if x != nil {
+		return x.RequestTimeoutMs
+	}
+	return 0
+}
+
+func (x *ConsensusConfig) GetViewChangeTimeoutMs() int32 {
+	if x != nil {
+		return x.ViewChangeTimeoutMs
+	}
+	return 0
+}
+
+func (x *ConsensusConfig) GetCheckpointInterval() int32 {
+	if x != nil {
+		return x.CheckpointInterval
+	}
+	return 0
+}
+
+func (x *ConsensusConfig) GetNetwork() *NetworkEmulation {
+	if x != nil {
+		return x.Network
+	}
+	return nil
+}
+
+// NetworkEmulation describes the impairments injected between nodes.
+type NetworkEmulation struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	LatencyMs         float64                `protobuf:"fixed64,1,opt,name=latency_ms,json=latencyMs,proto3" json:"latency_ms,omitempty"`
+	JitterMs          float64                `protobuf:"fixed64,2,opt,name=jitter_ms,json=jitterMs,proto3" json:"jitter_ms,omitempty"`
+	PacketLossPercent float64                `protobuf:"fixed64,3,opt,name=packet_loss_percent,json=packetLossPercent,proto3" json:"packet_loss_percent,omitempty"`
+	BandwidthMbps     float64                `protobuf:"fixed64,4,opt,name=bandwidth_mbps,json=bandwidthMbps,proto3" json:"bandwidth_mbps,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *NetworkEmulation) Reset() {
+	*x = NetworkEmulation{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetworkEmulation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkEmulation) ProtoMessage() {}
+
+func (x *NetworkEmulation) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkEmulation.ProtoReflect.Descriptor instead.
+func (*NetworkEmulation) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *NetworkEmulation) GetLatencyMs() float64 {
+	if x != nil {
+		return x.LatencyMs
+	}
+	return 0
+}
+
+func (x *NetworkEmulation) GetJitterMs() float64 {
+	if x != nil {
+		return x.JitterMs
+	}
+	return 0
+}
+
+func (x *NetworkEmulation) GetPacketLossPercent() float64 {
+	if x != nil {
+		return x.PacketLossPercent
+	}
+	return 0
+}
+
+func (x *NetworkEmulation) GetBandwidthMbps() float64 {
+	if x != nil {
+		return x.BandwidthMbps
+	}
+	return 0
+}
+
+// Environment is a snapshot of what a run executed on.
+type Environment struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Commit of the consensus implementation under test.
+	GitCommit     string            `protobuf:"bytes,1,opt,name=git_commit,json=gitCommit,proto3" json:"git_commit,omitempty"`
+	Version       string            `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Os            string            `protobuf:"bytes,3,opt,name=os,proto3" json:"os,omitempty"`
+	Kernel        string            `protobuf:"bytes,4,opt,name=kernel,proto3" json:"kernel,omitempty"`
+	CpuModel      string            `protobuf:"bytes,5,opt,name=cpu_model,json=cpuModel,proto3" json:"cpu_model,omitempty"`
+	CpuCores      int32             `protobuf:"varint,6,opt,name=cpu_cores,json=cpuCores,proto3" json:"cpu_cores,omitempty"`
+	MemoryGb      float64           `protobuf:"fixed64,7,opt,name=memory_gb,json=memoryGb,proto3" json:"memory_gb,omitempty"`
+	InstanceType  string            `protobuf:"bytes,8,opt,name=instance_type,json=instanceType,proto3" json:"instance_type,omitempty"`
+	Extra         map[string]string `protobuf:"bytes,9,rep,name=extra,proto3" json:"extra,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Environment) Reset() {
+	*x = Environment{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Environment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Environment) ProtoMessage() {}
+
+func (x *Environment) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Environment.ProtoReflect.Descriptor instead.
+func (*Environment) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Environment) GetGitCommit() string {
+	if x != nil {
+		return x.GitCommit
+	}
+	return ""
+}
+
+func (x *Environment) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *Environment) GetOs() string {
+	if x != nil {
+		return x.Os
+	}
+	return ""
+}
+
+func (x *Environment) GetKernel() string {
+	if x != nil {
+		return x.Kernel
+	}
+	return ""
+}
+
+func (x *Environment) GetCpuModel() string {
+	if x != nil {
+		return x.CpuModel
+	}
+	return ""
+}
+
+func (x *Environment) GetCpuCores() int32 {
+	if x != nil {
+		return x.CpuCores
+	}
+	return 0
+}
+
+func (x *Environment) GetMemoryGb() float64 {
+	if x != nil {
+		return x.MemoryGb
+	}
+	return 0
+}
+
+func (x *Environment) GetInstanceType() string {
+	if x != nil {
+		return x.InstanceType
+	}
+	return ""
+}
+
+func (x *Environment) GetExtra() map[string]string {
+	if x != nil {
+		return x.Extra
+	}
+	return nil
+}
+
+// BenchmarkResults is the summary computed when a run finishes.
+type BenchmarkResults struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActualTps     float64                `protobuf:"fixed64,1,opt,name=actual_tps,json=actualTps,proto3" json:"actual_tps,omitempty"`
+	Latency       *LatencyStats          `protobuf:"bytes,2,opt,name=latency,proto3" json:"latency,omitempty"`
+	Transactions  *TransactionCounts     `protobuf:"bytes,3,opt,name=transactions,proto3" json:"transactions,omitempty"`
+	Blocks        *BlockStats            `protobuf:"bytes,4,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	Resources     *ResourceUsage         `protobuf:"bytes,5,opt,name=resources,proto3" json:"resources,omitempty"`
+	Consensus     *ConsensusStats        `protobuf:"bytes,6,opt,name=consensus,proto3" json:"consensus,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BenchmarkResults) Reset() {
+	*x = BenchmarkResults{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BenchmarkResults) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BenchmarkResults) ProtoMessage() {}
+
+func (x *BenchmarkResults) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BenchmarkResults.ProtoReflect.Descriptor instead.
+func (*BenchmarkResults) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BenchmarkResults) GetActualTps() float64 {
+	if x != nil {
+		return x.ActualTps
+	}
+	return 0
+}
+
+func (x *BenchmarkResults) GetLatency() *LatencyStats {
+	if x != nil {
+		return x.Latency
+	}
+	return nil
+}
+
+func (x *BenchmarkResults) GetTransactions() *TransactionCounts {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+func (x *BenchmarkResults) GetBlocks() *BlockStats {
+	if x != nil {
+		return x.Blocks
+	}
+	return nil
+}
+
+func (x *BenchmarkResults) GetResources() *ResourceUsage {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+func (x *BenchmarkResults) GetConsensus() *ConsensusStats {
+	if x != nil {
+		return x.Consensus
+	}
+	return nil
+}
+
+// Latencies are in milliseconds.
+type LatencyStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	P50           float64                `protobuf:"fixed64,1,opt,name=p50,proto3" json:"p50,omitempty"`
+	P90           float64                `protobuf:"fixed64,2,opt,name=p90,proto3" json:"p90,omitempty"`
+	P99           float64                `protobuf:"fixed64,3,opt,name=p99,proto3" json:"p99,omitempty"`
+	P999          float64                `protobuf:"fixed64,4,opt,name=p999,proto3" json:"p999,omitempty"`
+	Avg           float64                `protobuf:"fixed64,5,opt,name=avg,proto3" json:"avg,omitempty"`
+	Min           float64                `protobuf:"fixed64,6,opt,name=min,proto3" json:"min,omitempty"`
+	Max           float64                `protobuf:"fixed64,7,opt,name=max,proto3" json:"max,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LatencyStats) Reset() {
+	*x = LatencyStats{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LatencyStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LatencyStats) ProtoMessage() {}
+
+func (x *LatencyStats) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LatencyStats.ProtoReflect.Descriptor instead.
+func (*LatencyStats) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *LatencyStats) GetP50() float64 {
+	if x != nil {
+		return x.P50
+	}
+	return 0
+}
+
+func (x *LatencyStats) GetP90() float64 {
+	if x != nil {
+		return x.P90
+	}
+	return 0
+}
+
+func (x *LatencyStats) GetP99() float64 {
+	if x != nil {
+		return x.P99
+	}
+	return 0
+}
+
+func (x *LatencyStats) GetP999() float64 {
+	if x != nil {
+		return x.P999
+	}
+	return 0
+}
+
+func (x *LatencyStats) GetAvg() float64 {
+	if x != nil {
+		return x.Avg
+	}
+	return 0
+}
+
+func (x *LatencyStats) GetMin() float64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *LatencyStats) GetMax() float64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+type TransactionCounts struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Successful    int32                  `protobuf:"varint,2,opt,name=successful,proto3" json:"successful,omitempty"`
+	Failed        int32                  `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransactionCounts) Reset() {
+	*x = TransactionCounts{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionCounts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionCounts) ProtoMessage() {}
+
+func (x *TransactionCounts) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionCounts.ProtoReflect.Descriptor instead.
+func (*TransactionCounts) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TransactionCounts) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *TransactionCounts) GetSuccessful() int32 {
+	if x != nil {
+		return x.Successful
+	}
+	return 0
+}
+
+func (x *TransactionCounts) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+type BlockStats struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	BlockCount           int32                  `protobuf:"varint,1,opt,name=block_count,json=blockCount,proto3" json:"block_count,omitempty"`
+	BlockSizeAvg         float64                `protobuf:"fixed64,2,opt,name=block_size_avg,json=blockSizeAvg,proto3" json:"block_size_avg,omitempty"`
+	BlockPropagationTime float64                `protobuf:"fixed64,3,opt,name=block_propagation_time,json=blockPropagationTime,proto3" json:"block_propagation_time,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *BlockStats) Reset() {
+	*x = BlockStats{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockStats) ProtoMessage() {}
+
+func (x *BlockStats) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockStats.ProtoReflect.Descriptor instead.
+func (*BlockStats) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *BlockStats) GetBlockCount() int32 {
+	if x != nil {
+		return x.BlockCount
+	}
+	return 0
+}
+
+func (x *BlockStats) GetBlockSizeAvg() float64 {
+	if x != nil {
+		return x.BlockSizeAvg
+	}
+	return 0
+}
+
+func (x *BlockStats) GetBlockPropagationTime() float64 {
+	if x != nil {
+		return x.BlockPropagationTime
+	}
+	return 0
+}
+
+type ResourceUsage struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CpuUsageAvg    float64                `protobuf:"fixed64,1,opt,name=cpu_usage_avg,json=cpuUsageAvg,proto3" json:"cpu_usage_avg,omitempty"`
+	CpuUsageMax    float64                `protobuf:"fixed64,2,opt,name=cpu_usage_max,json=cpuUsageMax,proto3" json:"cpu_usage_max,omitempty"`
+	MemoryUsageAvg float64                `protobuf:"fixed64,3,opt,name=memory_usage_avg,json=memoryUsageAvg,proto3" json:"memory_usage_avg,omitempty"`
+	MemoryUsageMax float64                `protobuf:"fixed64,4,opt,name=memory_usage_max,json=memoryUsageMax,proto3" json:"memory_usage_max,omitempty"`
+	NetworkInMbps  float64                `protobuf:"fixed64,5,opt,name=network_in_mbps,json=networkInMbps,proto3" json:"network_in_mbps,omitempty"`
+	NetworkOutMbps float64                `protobuf:"fixed64,6,opt,name=network_out_mbps,json=networkOutMbps,proto3" json:"network_out_mbps,omitempty"`
+	DiskIoRead     float64                `protobuf:"fixed64,7,opt,name=disk_io_read,json=diskIoRead,proto3" json:"disk_io_read,omitempty"`
+	DiskIoWrite    float64                `protobuf:"fixed64,8,opt,name=disk_io_write,json=diskIoWrite,proto3" json:"disk_io_write,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ResourceUsage) Reset() {
+	*x = ResourceUsage{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceUsage) ProtoMessage() {}
+
+func (x *ResourceUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceUsage.ProtoReflect.Descriptor instead.
+func (*ResourceUsage) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ResourceUsage) GetCpuUsageAvg() float64 {
+	if x != nil {
+		return x.CpuUsageAvg
+	}
+	return 0
+}
+
+func (x *ResourceUsage) GetCpuUsageMax() float64 {
+	if x != nil {
+		return x.CpuUsageMax
+	}
+	return 0
+}
+
+func (x *ResourceUsage) GetMemoryUsageAvg() float64 {
+	if x != nil {
+		return x.MemoryUsageAvg
+	}
+	return 0
+}
+
+func (x *ResourceUsage) GetMemoryUsageMax() float64 {
+	if x != nil {
+		return x.MemoryUsageMax
+	}
+	return 0
+}
+
+func (x *ResourceUsage) GetNetworkInMbps() float64 {
+	if x != nil {
+		return x.NetworkInMbps
+	}
+	return 0
+}
+
+func (x *ResourceUsage) GetNetworkOutMbps() float64 {
+	if x != nil {
+		return x.NetworkOutMbps
+	}
+	return 0
+}
+
+func (x *ResourceUsage) GetDiskIoRead() float64 {
+	if x != nil {
+		return x.DiskIoRead
+	}
+	return 0
+}
+
+func (x *ResourceUsage) GetDiskIoWrite() float64 {
+	if x != nil {
+		return x.DiskIoWrite
+	}
+	return 0
+}
+
+type ConsensusStats struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	ViewChangeCount     int32                  `protobuf:"varint,1,opt,name=view_change_count,json=viewChangeCount,proto3" json:"view_change_count,omitempty"`
+	PreparePhaseLatency float64                `protobuf:"fixed64,2,opt,name=prepare_phase_latency,json=preparePhaseLatency,proto3" json:"prepare_phase_latency,omitempty"`
+	CommitPhaseLatency  float64                `protobuf:"fixed64,3,opt,name=commit_phase_latency,json=commitPhaseLatency,proto3" json:"commit_phase_latency,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ConsensusStats) Reset() {
+	*x = ConsensusStats{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsensusStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsensusStats) ProtoMessage() {}
+
+func (x *ConsensusStats) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsensusStats.ProtoReflect.Descriptor instead.
+func (*ConsensusStats) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ConsensusStats) GetViewChangeCount() int32 {
+	if x != nil {
+		return x.ViewChangeCount
+	}
+	return 0
+}
+
+func (x *ConsensusStats) GetPreparePhaseLatency() float64 {
+	if x != nil {
+		return x.PreparePhaseLatency
+	}
+	return 0
+}
+
+func (x *ConsensusStats) GetCommitPhaseLatency() float64 {
+	if x != nil {
+		return x.CommitPhaseLatency
+	}
+	return 0
+}
+
+type CreateBenchmarkRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Name        string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Algorithm   string                 `protobuf:"bytes,3,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	NodeCount   int32                  `protobuf:"varint,4,opt,name=node_count,json=nodeCount,proto3" json:"node_count,omitempty"`
+	Duration    int32                  `protobuf:"varint,5,opt,name=duration,proto3" json:"duration,omitempty"`
+	TargetTps   int32                  `protobuf:"varint,6,opt,name=target_tps,json=targetTps,proto3" json:"target_tps,omitempty"`
+	// Optional label shared by repeated runs of the same experiment.
+	RunGroup        string            `protobuf:"bytes,7,opt,name=run_group,json=runGroup,proto3" json:"run_group,omitempty"`
+	ConsensusParams map[string]string `protobuf:"bytes,8,rep,name=consensus_params,json=consensusParams,proto3" json:"consensus_params,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Optional template to take the configuration from. Fields set on the
+	// request take precedence; consensus_params are merged over the template's.
+	TemplateId string `protobuf:"bytes,9,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	// Replaces the template's consensus_config when set.
+	ConsensusConfig *ConsensusConfig `protobuf:"bytes,10,opt,name=consensus_config,json=consensusConfig,proto3" json:"consensus_config,omitempty"`
+	Environment     *Environment     `protobuf:"bytes,11,opt,name=environment,proto3" json:"environment,omitempty"`
+	Tags            []string         `protobuf:"bytes,12,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Higher runs first; defaults to 0.
+	Priority int32 `protobuf:"varint,13,opt,name=priority,proto3" json:"priority,omitempty"`
+	// Cluster or region to queue on; defaults to "default".
+	Cluster          string `protobuf:"bytes,14,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	WarmupDuration   int32  `protobuf:"varint,15,opt,name=warmup_duration,json=warmupDuration,proto3" json:"warmup_duration,omitempty"`
+	CooldownDuration int32  `protobuf:"varint,16,opt,name=cooldown_duration,json=cooldownDuration,proto3" json:"cooldown_duration,omitempty"`
+	// SLA assertions checked against the results when the run completes, e.g.
+	// "actual_tps >= 0.9*target_tps", "latency_p99 < 500ms",
+	// "failed_tx/transaction_count < 1%" or "max(cpu_usage) < 80". Replace the
+	// template's when set.
+	Assertions    []string `protobuf:"bytes,17,rep,name=assertions,proto3" json:"assertions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBenchmarkRequest) Reset() {
+	*x = CreateBenchmarkRequest{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBenchmarkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBenchmarkRequest) ProtoMessage() {}
+
+func (x *CreateBenchmarkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBenchmarkRequest.ProtoReflect.Descriptor instead.
+func (*CreateBenchmarkRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CreateBenchmarkRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateBenchmarkRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateBenchmarkRequest) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *CreateBenchmarkRequest) GetNodeCount() int32 {
+	if x != nil {
+		return x.NodeCount
+	}
+	return 0
+}
+
+func (x *CreateBenchmarkRequest) GetDuration() int32 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *CreateBenchmarkRequest) GetTargetTps() int32 {
+	if x != nil {
+		return x.TargetTps
+	}
+	return 0
+}
+
+func (x *CreateBenchmarkRequest) GetRunGroup() string {
+	if x != nil {
+		return x.RunGroup
+	}
+	return ""
+}
+
+func (x *CreateBenchmarkRequest) GetConsensusParams() map[string]string {
+	if x != nil {
+		return x.ConsensusParams
+	}
+	return nil
+}
+
+func (x *CreateBenchmarkRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *CreateBenchmarkRequest) GetConsensusConfig() *ConsensusConfig {
+	if x != nil {
+		return x.ConsensusConfig
+	}
+	return nil
+}
+
+func (x *CreateBenchmarkRequest) GetEnvironment() *Environment {
+	if x != nil {
+		return x.Environment
+	}
+	return nil
+}
+
+func (x *CreateBenchmarkRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreateBenchmarkRequest) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *CreateBenchmarkRequest) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+func (x *CreateBenchmarkRequest) GetWarmupDuration() int32 {
+	if x != nil {
+		return x.WarmupDuration
+	}
+	return 0
+}
+
+func (x *CreateBenchmarkRequest) GetCooldownDuration() int32 {
+	if x != nil {
+		return x.CooldownDuration
+	}
+	return 0
+}
+
+func (x *CreateBenchmarkRequest) GetAssertions() []string {
+	if x != nil {
+		return x.Assertions
+	}
+	return nil
+}
+
+type CreateBenchmarkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Benchmark     *Benchmark             `protobuf:"bytes,1,opt,name=benchmark,proto3" json:"benchmark,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBenchmarkResponse) Reset() {
+	*x = CreateBenchmarkResponse{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBenchmarkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBenchmarkResponse) ProtoMessage() {}
+
+func (x *CreateBenchmarkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBenchmarkResponse.ProtoReflect.Descriptor instead.
+func (*CreateBenchmarkResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CreateBenchmarkResponse) GetBenchmark() *Benchmark {
+	if x != nil {
+		return x.Benchmark
+	}
+	return nil
+}
+
+type GetBenchmarkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBenchmarkRequest) Reset() {
+	*x = GetBenchmarkRequest{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBenchmarkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBenchmarkRequest) ProtoMessage() {}
+
+func (x *GetBenchmarkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBenchmarkRequest.ProtoReflect.Descriptor instead.
+func (*GetBenchmarkRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetBenchmarkRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetBenchmarkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Benchmark     *Benchmark             `protobuf:"bytes,1,opt,name=benchmark,proto3" json:"benchmark,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBenchmarkResponse) Reset() {
+	*x = GetBenchmarkResponse{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBenchmarkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBenchmarkResponse) ProtoMessage() {}
+
+func (x *GetBenchmarkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBenchmarkResponse.ProtoReflect.Descriptor instead.
+func (*GetBenchmarkResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetBenchmarkResponse) GetBenchmark() *Benchmark {
+	if x != nil {
+		return x.Benchmark
+	}
+	return nil
+}
+
+// ListBenchmarksRequest returns the benchmarks matching every filter set,
+// newest first unless sort_by says otherwise.
+type ListBenchmarksRequest struct {
+	state      protoimpl.MessageState    `protogen:"open.v1"`
+	Pagination *common.PaginationRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Status     string                    `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	RunGroup   string                    `protobuf:"bytes,3,opt,name=run_group,json=runGroup,proto3" json:"run_group,omitempty"`
+	// Configuration filters by dotted path, all of which must match, e.g.
+	// {"consensus.block_size": "500", "environment.git_commit": "9f1c2ab",
+	// "params.batch_size": "64"}. Roots are consensus, environment and params.
+	Parameters map[string]string `protobuf:"bytes,4,rep,name=parameters,proto3" json:"parameters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Algorithm  string            `protobuf:"bytes,5,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	// Inclusive node count bounds; 0 leaves a bound open.
+	MinNodeCount int32 `protobuf:"varint,6,opt,name=min_node_count,json=minNodeCount,proto3" json:"min_node_count,omitempty"`
+	MaxNodeCount int32 `protobuf:"varint,7,opt,name=max_node_count,json=maxNodeCount,proto3" json:"max_node_count,omitempty"`
+	// RFC3339 creation time bounds: created_after is inclusive,
+	// created_before exclusive.
+	CreatedAfter  string `protobuf:"bytes,8,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	CreatedBefore string `protobuf:"bytes,9,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+	// Benchmarks must carry all of these tags.
+	Tags []string `protobuf:"bytes,10,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Full-text search over name and description. Supports "quoted phrases",
+	// -exclusions and or.
+	Search string `protobuf:"bytes,11,opt,name=search,proto3" json:"search,omitempty"`
+	// One of created_at (default), algorithm, status, node_count, actual_tps.
+	SortBy string `protobuf:"bytes,12,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	// asc or desc (default).
+	SortOrder string `protobuf:"bytes,13,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+	// passed or failed: only runs whose SLA assertions were evaluated with
+	// that outcome.
+	SlaStatus string `protobuf:"bytes,14,opt,name=sla_status,json=slaStatus,proto3" json:"sla_status,omitempty"`
+	// Only runs of experiments with this name.
+	Experiment    string `protobuf:"bytes,15,opt,name=experiment,proto3" json:"experiment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBenchmarksRequest) Reset() {
+	*x = ListBenchmarksRequest{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBenchmarksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBenchmarksRequest) ProtoMessage() {}
+
+func (x *ListBenchmarksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBenchmarksRequest.ProtoReflect.Descriptor instead.
+func (*ListBenchmarksRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListBenchmarksRequest) GetPagination() *common.PaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListBenchmarksRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListBenchmarksRequest) GetRunGroup() string {
+	if x != nil {
+		return x.RunGroup
+	}
+	return ""
+}
+
+func (x *ListBenchmarksRequest) GetParameters() map[string]string {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+func (x *ListBenchmarksRequest) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *ListBenchmarksRequest) GetMinNodeCount() int32 {
+	if x != nil {
+		return x.MinNodeCount
+	}
+	return 0
+}
+
+func (x *ListBenchmarksRequest) GetMaxNodeCount() int32 {
+	if x != nil {
+		return x.MaxNodeCount
+	}
+	return 0
+}
+
+func (x *ListBenchmarksRequest) GetCreatedAfter() string {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return ""
+}
+
+func (x *ListBenchmarksRequest) GetCreatedBefore() string {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return ""
+}
+
+func (x *ListBenchmarksRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ListBenchmarksRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *ListBenchmarksRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *ListBenchmarksRequest) GetSortOrder() string {
+	if x != nil {
+		return x.SortOrder
+	}
+	return ""
+}
+
+func (x *ListBenchmarksRequest) GetSlaStatus() string {
+	if x != nil {
+		return x.SlaStatus
+	}
+	return ""
+}
+
+func (x *ListBenchmarksRequest) GetExperiment() string {
+	if x != nil {
+		return x.Experiment
+	}
+	return ""
+}
+
+type ListBenchmarksResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Benchmarks    []*Benchmark               `protobuf:"bytes,1,rep,name=benchmarks,proto3" json:"benchmarks,omitempty"`
+	Pagination    *common.PaginationResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBenchmarksResponse) Reset() {
+	*x = ListBenchmarksResponse{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBenchmarksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBenchmarksResponse) ProtoMessage() {}
+
+func (x *ListBenchmarksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBenchmarksResponse.ProtoReflect.Descriptor instead.
+func (*ListBenchmarksResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListBenchmarksResponse) GetBenchmarks() []*Benchmark {
+	if x != nil {
+		return x.Benchmarks
+	}
+	return nil
+}
+
+func (x *ListBenchmarksResponse) GetPagination() *common.PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// UpdateBenchmarkRequest applies a partial update. Only the fields named in
+// update_mask are written; paths use the field names below. If update_mask is
+// empty, every non-default field is treated as set.
+type UpdateBenchmarkRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Ends a run early: only failed or cancelled are accepted. Runs are
+	// started by the queue. The status change and the other fields are
+	// written together.
+	Status           string  `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Name             string  `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description      string  `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	ErrorMessage     string  `protobuf:"bytes,5,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	ActualTps        float64 `protobuf:"fixed64,6,opt,name=actual_tps,json=actualTps,proto3" json:"actual_tps,omitempty"`
+	LatencyP50       float64 `protobuf:"fixed64,7,opt,name=latency_p50,json=latencyP50,proto3" json:"latency_p50,omitempty"`
+	LatencyP90       float64 `protobuf:"fixed64,8,opt,name=latency_p90,json=latencyP90,proto3" json:"latency_p90,omitempty"`
+	LatencyP99       float64 `protobuf:"fixed64,9,opt,name=latency_p99,json=latencyP99,proto3" json:"latency_p99,omitempty"`
+	LatencyP999      float64 `protobuf:"fixed64,10,opt,name=latency_p999,json=latencyP999,proto3" json:"latency_p999,omitempty"`
+	LatencyAvg       float64 `protobuf:"fixed64,11,opt,name=latency_avg,json=latencyAvg,proto3" json:"latency_avg,omitempty"`
+	LatencyMin       float64 `protobuf:"fixed64,12,opt,name=latency_min,json=latencyMin,proto3" json:"latency_min,omitempty"`
+	LatencyMax       float64 `protobuf:"fixed64,13,opt,name=latency_max,json=latencyMax,proto3" json:"latency_max,omitempty"`
+	TransactionCount int32   `protobuf:"varint,14,opt,name=transaction_count,json=transactionCount,proto3" json:"transaction_count,omitempty"`
+	SuccessfulTx     int32   `protobuf:"varint,15,opt,name=successful_tx,json=successfulTx,proto3" json:"successful_tx,omitempty"`
+	FailedTx         int32   `protobuf:"varint,16,opt,name=failed_tx,json=failedTx,proto3" json:"failed_tx,omitempty"`
+	// Reported by the runner once the nodes are provisioned.
+	Environment *Environment `protobuf:"bytes,17,opt,name=environment,proto3" json:"environment,omitempty"`
+	Tags        []string     `protobuf:"bytes,18,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Reorders the run in its queue.
+	Priority   int32                  `protobuf:"varint,19,opt,name=priority,proto3" json:"priority,omitempty"`
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,20,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// updated_at as last read by the client (RFC3339). When set, the update is
+	// rejected with ABORTED if the benchmark changed in the meantime.
+	ExpectedUpdatedAt string `protobuf:"bytes,21,opt,name=expected_updated_at,json=expectedUpdatedAt,proto3" json:"expected_updated_at,omitempty"`
+	// Required when status is cancelled, as for CancelBenchmark.
+	CancelledBy   string `protobuf:"bytes,22,opt,name=cancelled_by,json=cancelledBy,proto3" json:"cancelled_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBenchmarkRequest) Reset() {
+	*x = UpdateBenchmarkRequest{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBenchmarkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBenchmarkRequest) ProtoMessage() {}
+
+func (x *UpdateBenchmarkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBenchmarkRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBenchmarkRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *UpdateBenchmarkRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateBenchmarkRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UpdateBenchmarkRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateBenchmarkRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateBenchmarkRequest) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *UpdateBenchmarkRequest) GetActualTps() float64 {
+	if x != nil {
+		return x.ActualTps
+	}
+	return 0
+}
+
+func (x *UpdateBenchmarkRequest) GetLatencyP50() float64 {
+	if x != nil {
+		return x.LatencyP50
+	}
+	return 0
+}
+
+func (x *UpdateBenchmarkRequest) GetLatencyP90() float64 {
+	if x != nil {
+		return x.LatencyP90
+	}
+	return 0
+}
+
+func (x *UpdateBenchmarkRequest) GetLatencyP99() float64 {
+	if x != nil {
+		return x.LatencyP99
+	}
+	return 0
+}
+
+func (x *UpdateBenchmarkRequest) GetLatencyP999() float64 {
+	if x != nil {
+		return x.LatencyP999
+	}
+	return 0
+}
+
+func (x *UpdateBenchmarkRequest) GetLatencyAvg() float64 {
+	if x != nil {
+		return x.LatencyAvg
+	}
+	return 0
+}
+
+func (x *UpdateBenchmarkRequest) GetLatencyMin() float64 {
+	if x != nil {
+		return x.LatencyMin
+	}
+	return 0
+}
+
+func (x *UpdateBenchmarkRequest) GetLatencyMax() float64 {
+	if x != nil {
+		return x.LatencyMax
+	}
+	return 0
+}
+
+func (x *UpdateBenchmarkRequest) GetTransactionCount() int32 {
+	if x != nil {
+		return x.TransactionCount
+	}
+	return 0
+}
+
+func (x *UpdateBenchmarkRequest) GetSuccessfulTx() int32 {
+	if x != nil {
+		return x.SuccessfulTx
+	}
+	return 0
+}
+
+func (x *UpdateBenchmarkRequest) GetFailedTx() int32 {
+	if x != nil {
+		return x.FailedTx
+	}
+	return 0
+}
+
+func (x *UpdateBenchmarkRequest) GetEnvironment() *Environment {
+	if x != nil {
+		return x.Environment
+	}
+	return nil
+}
+
+func (x *UpdateBenchmarkRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *UpdateBenchmarkRequest) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *UpdateBenchmarkRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateBenchmarkRequest) GetExpectedUpdatedAt() string {
+	if x != nil {
+		return x.ExpectedUpdatedAt
+	}
+	return ""
+}
+
+func (x *UpdateBenchmarkRequest) GetCancelledBy() string {
+	if x != nil {
+		return x.CancelledBy
+	}
+	return ""
+}
+
+type UpdateBenchmarkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Benchmark     *Benchmark             `protobuf:"bytes,1,opt,name=benchmark,proto3" json:"benchmark,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBenchmarkResponse) Reset() {
+	*x = UpdateBenchmarkResponse{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBenchmarkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBenchmarkResponse) ProtoMessage() {}
+
+func (x *UpdateBenchmarkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBenchmarkResponse.ProtoReflect.Descriptor instead.
+func (*UpdateBenchmarkResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UpdateBenchmarkResponse) GetBenchmark() *Benchmark {
+	if x != nil {
+		return x.Benchmark
+	}
+	return nil
+}
+
+type DeleteBenchmarkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBenchmarkRequest) Reset() {
+	*x = DeleteBenchmarkRequest{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBenchmarkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBenchmarkRequest) ProtoMessage() {}
+
+func (x *DeleteBenchmarkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBenchmarkRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBenchmarkRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DeleteBenchmarkRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RecomputeBenchmarkResultsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecomputeBenchmarkResultsRequest) Reset() {
+	*x = RecomputeBenchmarkResultsRequest{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecomputeBenchmarkResultsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecomputeBenchmarkResultsRequest) ProtoMessage() {}
+
+func (x *RecomputeBenchmarkResultsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecomputeBenchmarkResultsRequest.ProtoReflect.Descriptor instead.
+func (*RecomputeBenchmarkResultsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RecomputeBenchmarkResultsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RecomputeBenchmarkResultsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Benchmark     *Benchmark             `protobuf:"bytes,1,opt,name=benchmark,proto3" json:"benchmark,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecomputeBenchmarkResultsResponse) Reset() {
+	*x = RecomputeBenchmarkResultsResponse{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecomputeBenchmarkResultsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecomputeBenchmarkResultsResponse) ProtoMessage() {}
+
+func (x *RecomputeBenchmarkResultsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecomputeBenchmarkResultsResponse.ProtoReflect.Descriptor instead.
+func (*RecomputeBenchmarkResultsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RecomputeBenchmarkResultsResponse) GetBenchmark() *Benchmark {
+	if x != nil {
+		return x.Benchmark
+	}
+	return nil
+}
+
+type CompareBenchmarksRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Two or more benchmark IDs. The first one is the baseline deltas are
+	// computed against.
+	Ids           []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompareBenchmarksRequest) Reset() {
+	*x = CompareBenchmarksRequest{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompareBenchmarksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareBenchmarksRequest) ProtoMessage() {}
+
+func (x *CompareBenchmarksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareBenchmarksRequest.ProtoReflect.Descriptor instead.
+func (*CompareBenchmarksRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CompareBenchmarksRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type CompareBenchmarksResponse struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Benchmarks []*Benchmark           `protobuf:"bytes,1,rep,name=benchmarks,proto3" json:"benchmarks,omitempty"`
+	Metrics    []*MetricComparison    `protobuf:"bytes,2,rep,name=metrics,proto3" json:"metrics,omitempty"`
+	// Benchmarks without a stored summary whose values were derived from raw
+	// transactions and metrics.
+	RecomputedIds []string `protobuf:"bytes,3,rep,name=recomputed_ids,json=recomputedIds,proto3" json:"recomputed_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompareBenchmarksResponse) Reset() {
+	*x = CompareBenchmarksResponse{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompareBenchmarksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareBenchmarksResponse) ProtoMessage() {}
+
+func (x *CompareBenchmarksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareBenchmarksResponse.ProtoReflect.Descriptor instead.
+func (*CompareBenchmarksResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CompareBenchmarksResponse) GetBenchmarks() []*Benchmark {
+	if x != nil {
+		return x.Benchmarks
+	}
+	return nil
+}
+
+func (x *CompareBenchmarksResponse) GetMetrics() []*MetricComparison {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+func (x *CompareBenchmarksResponse) GetRecomputedIds() []string {
+	if x != nil {
+		return x.RecomputedIds
+	}
+	return nil
+}
+
+type MetricComparison struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Category       string                 `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	Unit           string                 `protobuf:"bytes,3,opt,name=unit,proto3" json:"unit,omitempty"`
+	HigherIsBetter bool                   `protobuf:"varint,4,opt,name=higher_is_better,json=higherIsBetter,proto3" json:"higher_is_better,omitempty"`
+	// One entry per benchmark, in request order.
+	Values []*ComparedValue `protobuf:"bytes,5,rep,name=values,proto3" json:"values,omitempty"`
+	// Empty when no benchmark has data or the best value is tied.
+	WinnerId      string `protobuf:"bytes,6,opt,name=winner_id,json=winnerId,proto3" json:"winner_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MetricComparison) Reset() {
+	*x = MetricComparison{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MetricComparison) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetricComparison) ProtoMessage() {}
+
+func (x *MetricComparison) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetricComparison.ProtoReflect.Descriptor instead.
+func (*MetricComparison) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *MetricComparison) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MetricComparison) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *MetricComparison) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *MetricComparison) GetHigherIsBetter() bool {
+	if x != nil {
+		return x.HigherIsBetter
+	}
+	return false
+}
+
+func (x *MetricComparison) GetValues() []*ComparedValue {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *MetricComparison) GetWinnerId() string {
+	if x != nil {
+		return x.WinnerId
+	}
+	return ""
+}
+
+type ComparedValue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BenchmarkId   string                 `protobuf:"bytes,1,opt,name=benchmark_id,json=benchmarkId,proto3" json:"benchmark_id,omitempty"`
+	Value         float64                `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+	AbsoluteDelta float64                `protobuf:"fixed64,3,opt,name=absolute_delta,json=absoluteDelta,proto3" json:"absolute_delta,omitempty"`
+	RelativeDelta float64                `protobuf:"fixed64,4,opt,name=relative_delta,json=relativeDelta,proto3" json:"relative_delta,omitempty"`
+	Winner        bool                   `protobuf:"varint,5,opt,name=winner,proto3" json:"winner,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ComparedValue) Reset() {
+	*x = ComparedValue{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ComparedValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComparedValue) ProtoMessage() {}
+
+func (x *ComparedValue) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComparedValue.ProtoReflect.Descriptor instead.
+func (*ComparedValue) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ComparedValue) GetBenchmarkId() string {
+	if x != nil {
+		return x.BenchmarkId
+	}
+	return ""
+}
+
+func (x *ComparedValue) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *ComparedValue) GetAbsoluteDelta() float64 {
+	if x != nil {
+		return x.AbsoluteDelta
+	}
+	return 0
+}
+
+func (x *ComparedValue) GetRelativeDelta() float64 {
+	if x != nil {
+		return x.RelativeDelta
+	}
+	return 0
+}
+
+func (x *ComparedValue) GetWinner() bool {
+	if x != nil {
+		return x.Winner
+	}
+	return false
+}
+
+// BenchmarkGroup selects the runs forming one sample: every benchmark with the
+// given run_group, or the listed benchmark IDs.
+type BenchmarkGroup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RunGroup      string                 `protobuf:"bytes,1,opt,name=run_group,json=runGroup,proto3" json:"run_group,omitempty"`
+	BenchmarkIds  []string               `protobuf:"bytes,2,rep,name=benchmark_ids,json=benchmarkIds,proto3" json:"benchmark_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BenchmarkGroup) Reset() {
+	*x = BenchmarkGroup{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BenchmarkGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BenchmarkGroup) ProtoMessage() {}
+
+func (x *BenchmarkGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BenchmarkGroup.ProtoReflect.Descriptor instead.
+func (*BenchmarkGroup) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *BenchmarkGroup) GetRunGroup() string {
+	if x != nil {
+		return x.RunGroup
+	}
+	return ""
+}
+
+func (x *BenchmarkGroup) GetBenchmarkIds() []string {
+	if x != nil {
+		return x.BenchmarkIds
+	}
+	return nil
+}
+
+type CompareBenchmarkGroupsRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Baseline            *BenchmarkGroup        `protobuf:"bytes,1,opt,name=baseline,proto3" json:"baseline,omitempty"`
+	Candidate           *BenchmarkGroup        `protobuf:"bytes,2,opt,name=candidate,proto3" json:"candidate,omitempty"`
+	ConfidenceLevel     float64                `protobuf:"fixed64,3,opt,name=confidence_level,json=confidenceLevel,proto3" json:"confidence_level,omitempty"`            // default 0.95
+	Alpha               float64                `protobuf:"fixed64,4,opt,name=alpha,proto3" json:"alpha,omitempty"`                                                       // default 0.05
+	BootstrapIterations int32                  `protobuf:"varint,5,opt,name=bootstrap_iterations,json=bootstrapIterations,proto3" json:"bootstrap_iterations,omitempty"` // default 1000
+	MaxSamples          int32                  `protobuf:"varint,6,opt,name=max_samples,json=maxSamples,proto3" json:"max_samples,omitempty"`                            // per group, default 10000
+	Seed                int64                  `protobuf:"varint,7,opt,name=seed,proto3" json:"seed,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CompareBenchmarkGroupsRequest) Reset() {
+	*x = CompareBenchmarkGroupsRequest{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompareBenchmarkGroupsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareBenchmarkGroupsRequest) ProtoMessage() {}
+
+func (x *CompareBenchmarkGroupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareBenchmarkGroupsRequest.ProtoReflect.Descriptor instead.
+func (*CompareBenchmarkGroupsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CompareBenchmarkGroupsRequest) GetBaseline() *BenchmarkGroup {
+	if x != nil {
+		return x.Baseline
+	}
+	return nil
+}
+
+func (x *CompareBenchmarkGroupsRequest) GetCandidate() *BenchmarkGroup {
+	if x != nil {
+		return x.Candidate
+	}
+	return nil
+}
+
+func (x *CompareBenchmarkGroupsRequest) GetConfidenceLevel() float64 {
+	if x != nil {
+		return x.ConfidenceLevel
+	}
+	return 0
+}
+
+func (x *CompareBenchmarkGroupsRequest) GetAlpha() float64 {
+	if x != nil {
+		return x.Alpha
+	}
+	return 0
+}
+
+func (x *CompareBenchmarkGroupsRequest) GetBootstrapIterations() int32 {
+	if x != nil {
+		return x.BootstrapIterations
+	}
+	return 0
+}
+
+func (x *CompareBenchmarkGroupsRequest) GetMaxSamples() int32 {
+	if x != nil {
+		return x.MaxSamples
+	}
+	return 0
+}
+
+func (x *CompareBenchmarkGroupsRequest) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+type ConfidenceInterval struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lower         float64                `protobuf:"fixed64,1,opt,name=lower,proto3" json:"lower,omitempty"`
+	Upper         float64                `protobuf:"fixed64,2,opt,name=upper,proto3" json:"upper,omitempty"`
+	Level         float64                `protobuf:"fixed64,3,opt,name=level,proto3" json:"level,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfidenceInterval) Reset() {
+	*x = ConfidenceInterval{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfidenceInterval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfidenceInterval) ProtoMessage() {}
+
+func (x *ConfidenceInterval) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfidenceInterval.ProtoReflect.Descriptor instead.
+func (*ConfidenceInterval) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ConfidenceInterval) GetLower() float64 {
+	if x != nil {
+		return x.Lower
+	}
+	return 0
+}
+
+func (x *ConfidenceInterval) GetUpper() float64 {
+	if x != nil {
+		return x.Upper
+	}
+	return 0
+}
+
+func (x *ConfidenceInterval) GetLevel() float64 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+// GroupStatistics summarises the per-transaction latency_ms sample of a group.
+type GroupStatistics struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BenchmarkIds  []string               `protobuf:"bytes,1,rep,name=benchmark_ids,json=benchmarkIds,proto3" json:"benchmark_ids,omitempty"`
+	SampleSize    int32                  `protobuf:"varint,2,opt,name=sample_size,json=sampleSize,proto3" json:"sample_size,omitempty"`
+	Mean          float64                `protobuf:"fixed64,3,opt,name=mean,proto3" json:"mean,omitempty"`
+	MeanCi        *ConfidenceInterval    `protobuf:"bytes,4,opt,name=mean_ci,json=meanCi,proto3" json:"mean_ci,omitempty"`
+	Median        float64                `protobuf:"fixed64,5,opt,name=median,proto3" json:"median,omitempty"`
+	P99           float64                `protobuf:"fixed64,6,opt,name=p99,proto3" json:"p99,omitempty"`
+	P99Ci         *ConfidenceInterval    `protobuf:"bytes,7,opt,name=p99_ci,json=p99Ci,proto3" json:"p99_ci,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupStatistics) Reset() {
+	*x = GroupStatistics{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupStatistics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupStatistics) ProtoMessage() {}
+
+func (x *GroupStatistics) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupStatistics.ProtoReflect.Descriptor instead.
+func (*GroupStatistics) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GroupStatistics) GetBenchmarkIds() []string {
+	if x != nil {
+		return x.BenchmarkIds
+	}
+	return nil
+}
+
+func (x *GroupStatistics) GetSampleSize() int32 {
+	if x != nil {
+		return x.SampleSize
+	}
+	return 0
+}
+
+func (x *GroupStatistics) GetMean() float64 {
+	if x != nil {
+		return x.Mean
+	}
+	return 0
+}
+
+func (x *GroupStatistics) GetMeanCi() *ConfidenceInterval {
+	if x != nil {
+		return x.MeanCi
+	}
+	return nil
+}
+
+func (x *GroupStatistics) GetMedian() float64 {
+	if x != nil {
+		return x.Median
+	}
+	return 0
+}
+
+func (x *GroupStatistics) GetP99() float64 {
+	if x != nil {
+		return x.P99
+	}
+	return 0
+}
+
+func (x *GroupStatistics) GetP99Ci() *ConfidenceInterval {
+	if x != nil {
+		return x.P99Ci
+	}
+	return nil
+}
+
+type MannWhitneyResult struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	U      float64                `protobuf:"fixed64,1,opt,name=u,proto3" json:"u,omitempty"`
+	Z      float64                `protobuf:"fixed64,2,opt,name=z,proto3" json:"z,omitempty"`
+	PValue float64                `protobuf:"fixed64,3,opt,name=p_value,json=pValue,proto3" json:"p_value,omitempty"`
+	// Rank-biserial correlation; positive when candidate latencies are higher.
+	EffectSize    float64 `protobuf:"fixed64,4,opt,name=effect_size,json=effectSize,proto3" json:"effect_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MannWhitneyResult) Reset() {
+	*x = MannWhitneyResult{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MannWhitneyResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MannWhitneyResult) ProtoMessage() {}
+
+func (x *MannWhitneyResult) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MannWhitneyResult.ProtoReflect.Descriptor instead.
+func (*MannWhitneyResult) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *MannWhitneyResult) GetU() float64 {
+	if x != nil {
+		return x.U
+	}
+	return 0
+}
+
+func (x *MannWhitneyResult) GetZ() float64 {
+	if x != nil {
+		return x.Z
+	}
+	return 0
+}
+
+func (x *MannWhitneyResult) GetPValue() float64 {
+	if x != nil {
+		return x.PValue
+	}
+	return 0
+}
+
+func (x *MannWhitneyResult) GetEffectSize() float64 {
+	if x != nil {
+		return x.EffectSize
+	}
+	return 0
+}
+
+type CompareBenchmarkGroupsResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Baseline    *GroupStatistics       `protobuf:"bytes,1,opt,name=baseline,proto3" json:"baseline,omitempty"`
+	Candidate   *GroupStatistics       `protobuf:"bytes,2,opt,name=candidate,proto3" json:"candidate,omitempty"`
+	MannWhitney *MannWhitneyResult     `protobuf:"bytes,3,opt,name=mann_whitney,json=mannWhitney,proto3" json:"mann_whitney,omitempty"`
+	// improvement, regression or inconclusive, from the candidate's side.
+	Verdict       string `protobuf:"bytes,4,opt,name=verdict,proto3" json:"verdict,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompareBenchmarkGroupsResponse) Reset() {
+	*x = CompareBenchmarkGroupsResponse{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompareBenchmarkGroupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareBenchmarkGroupsResponse) ProtoMessage() {}
+
+func (x *CompareBenchmarkGroupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareBenchmarkGroupsResponse.ProtoReflect.Descriptor instead.
+func (*CompareBenchmarkGroupsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CompareBenchmarkGroupsResponse) GetBaseline() *GroupStatistics {
+	if x != nil {
+		return x.Baseline
+	}
+	return nil
+}
+
+func (x *CompareBenchmarkGroupsResponse) GetCandidate() *GroupStatistics {
+	if x != nil {
+		return x.Candidate
+	}
+	return nil
+}
+
+func (x *CompareBenchmarkGroupsResponse) GetMannWhitney() *MannWhitneyResult {
+	if x != nil {
+		return x.MannWhitney
+	}
+	return nil
+}
+
+func (x *CompareBenchmarkGroupsResponse) GetVerdict() string {
+	if x != nil {
+		return x.Verdict
+	}
+	return ""
+}
+
+// CloneBenchmarkRequest starts a new run with the configuration of an
+// existing one. Results and lifecycle state are not copied.
+type CloneBenchmarkRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Defaults to the source benchmark's name.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Defaults to the source benchmark's run group.
+	RunGroup      string `protobuf:"bytes,3,opt,name=run_group,json=runGroup,proto3" json:"run_group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloneBenchmarkRequest) Reset() {
+	*x = CloneBenchmarkRequest{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloneBenchmarkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloneBenchmarkRequest) ProtoMessage() {}
+
+func (x *CloneBenchmarkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloneBenchmarkRequest.ProtoReflect.Descriptor instead.
+func (*CloneBenchmarkRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CloneBenchmarkRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CloneBenchmarkRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CloneBenchmarkRequest) GetRunGroup() string {
+	if x != nil {
+		return x.RunGroup
+	}
+	return ""
+}
+
+type CloneBenchmarkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Benchmark     *Benchmark             `protobuf:"bytes,1,opt,name=benchmark,proto3" json:"benchmark,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloneBenchmarkResponse) Reset() {
+	*x = CloneBenchmarkResponse{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloneBenchmarkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloneBenchmarkResponse) ProtoMessage() {}
+
+func (x *CloneBenchmarkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloneBenchmarkResponse.ProtoReflect.Descriptor instead.
+func (*CloneBenchmarkResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CloneBenchmarkResponse) GetBenchmark() *Benchmark {
+	if x != nil {
+		return x.Benchmark
+	}
+	return nil
+}
+
+// CancelBenchmarkRequest stops a benchmark that has not finished yet. Its
+// pending transactions are marked failed. "cancelled by <cancelled_by>:
+// <reason>" is recorded as the benchmark's error message.
+type CancelBenchmarkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CancelledBy   string                 `protobuf:"bytes,2,opt,name=cancelled_by,json=cancelledBy,proto3" json:"cancelled_by,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelBenchmarkRequest) Reset() {
+	*x = CancelBenchmarkRequest{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelBenchmarkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelBenchmarkRequest) ProtoMessage() {}
+
+func (x *CancelBenchmarkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelBenchmarkRequest.ProtoReflect.Descriptor instead.
+func (*CancelBenchmarkRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CancelBenchmarkRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CancelBenchmarkRequest) GetCancelledBy() string {
+	if x != nil {
+		return x.CancelledBy
+	}
+	return ""
+}
+
+func (x *CancelBenchmarkRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CancelBenchmarkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Benchmark     *Benchmark             `protobuf:"bytes,1,opt,name=benchmark,proto3" json:"benchmark,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelBenchmarkResponse) Reset() {
+	*x = CancelBenchmarkResponse{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelBenchmarkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelBenchmarkResponse) ProtoMessage() {}
+
+func (x *CancelBenchmarkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelBenchmarkResponse.ProtoReflect.Descriptor instead.
+func (*CancelBenchmarkResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CancelBenchmarkResponse) GetBenchmark() *Benchmark {
+	if x != nil {
+		return x.Benchmark
+	}
+	return nil
+}
+
+type GetBenchmarkQueueRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Restricts the queue to one cluster; empty covers all clusters.
+	Cluster       string `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBenchmarkQueueRequest) Reset() {
+	*x = GetBenchmarkQueueRequest{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBenchmarkQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBenchmarkQueueRequest) ProtoMessage() {}
+
+func (x *GetBenchmarkQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBenchmarkQueueRequest.ProtoReflect.Descriptor instead.
+func (*GetBenchmarkQueueRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetBenchmarkQueueRequest) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+type GetBenchmarkQueueResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Queued runs grouped by cluster in dispatch order, with queue_position set.
+	Benchmarks    []*Benchmark    `protobuf:"bytes,1,rep,name=benchmarks,proto3" json:"benchmarks,omitempty"`
+	Clusters      []*ClusterQueue `protobuf:"bytes,2,rep,name=clusters,proto3" json:"clusters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBenchmarkQueueResponse) Reset() {
+	*x = GetBenchmarkQueueResponse{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBenchmarkQueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBenchmarkQueueResponse) ProtoMessage() {}
+
+func (x *GetBenchmarkQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBenchmarkQueueResponse.ProtoReflect.Descriptor instead.
+func (*GetBenchmarkQueueResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetBenchmarkQueueResponse) GetBenchmarks() []*Benchmark {
+	if x != nil {
+		return x.Benchmarks
+	}
+	return nil
+}
+
+func (x *GetBenchmarkQueueResponse) GetClusters() []*ClusterQueue {
+	if x != nil {
+		return x.Clusters
+	}
+	return nil
+}
+
+// ClusterQueue is the occupancy of one cluster.
+type ClusterQueue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cluster       string                 `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	Queued        int32                  `protobuf:"varint,2,opt,name=queued,proto3" json:"queued,omitempty"`
+	Active        int32                  `protobuf:"varint,3,opt,name=active,proto3" json:"active,omitempty"`
+	MaxConcurrent int32                  `protobuf:"varint,4,opt,name=max_concurrent,json=maxConcurrent,proto3" json:"max_concurrent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClusterQueue) Reset() {
+	*x = ClusterQueue{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClusterQueue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClusterQueue) ProtoMessage() {}
+
+func (x *ClusterQueue) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClusterQueue.ProtoReflect.Descriptor instead.
+func (*ClusterQueue) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ClusterQueue) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+func (x *ClusterQueue) GetQueued() int32 {
+	if x != nil {
+		return x.Queued
+	}
+	return 0
+}
+
+func (x *ClusterQueue) GetActive() int32 {
+	if x != nil {
+		return x.Active
+	}
+	return 0
+}
+
+func (x *ClusterQueue) GetMaxConcurrent() int32 {
+	if x != nil {
+		return x.MaxConcurrent
+	}
+	return 0
+}
+
+// GetLeaderboardRequest ranks algorithms by their completed runs, separately
+// for every node count. Results are cached for a few minutes or until the
+// next run completes.
+type GetLeaderboardRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Algorithms to rank; every supported algorithm when empty.
+	Algorithms []string `protobuf:"bytes,1,rep,name=algorithms,proto3" json:"algorithms,omitempty"`
+	// Restricts the leaderboard to one node count; 0 covers all.
+	NodeCount int32 `protobuf:"varint,2,opt,name=node_count,json=nodeCount,proto3" json:"node_count,omitempty"`
+	// RFC3339 creation time bounds: created_after is inclusive,
+	// created_before exclusive.
+	CreatedAfter  string `protobuf:"bytes,3,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	CreatedBefore string `protobuf:"bytes,4,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+	// One of median_tps (default), best_tps, median_latency_p99 or
+	// best_latency_p99.
+	RankBy        string `protobuf:"bytes,5,opt,name=rank_by,json=rankBy,proto3" json:"rank_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLeaderboardRequest) Reset() {
+	*x = GetLeaderboardRequest{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLeaderboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeaderboardRequest) ProtoMessage() {}
+
+func (x *GetLeaderboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeaderboardRequest.ProtoReflect.Descriptor instead.
+func (*GetLeaderboardRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetLeaderboardRequest) GetAlgorithms() []string {
+	if x != nil {
+		return x.Algorithms
+	}
+	return nil
+}
+
+func (x *GetLeaderboardRequest) GetNodeCount() int32 {
+	if x != nil {
+		return x.NodeCount
+	}
+	return 0
+}
+
+func (x *GetLeaderboardRequest) GetCreatedAfter() string {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return ""
+}
+
+func (x *GetLeaderboardRequest) GetCreatedBefore() string {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return ""
+}
+
+func (x *GetLeaderboardRequest) GetRankBy() string {
+	if x != nil {
+		return x.RankBy
+	}
+	return ""
+}
+
+type GetLeaderboardResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	RankBy string                 `protobuf:"bytes,1,opt,name=rank_by,json=rankBy,proto3" json:"rank_by,omitempty"`
+	// Ordered by node count.
+	Buckets       []*LeaderboardBucket `protobuf:"bytes,2,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	GeneratedAt   string               `protobuf:"bytes,3,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLeaderboardResponse) Reset() {
+	*x = GetLeaderboardResponse{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLeaderboardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeaderboardResponse) ProtoMessage() {}
+
+func (x *GetLeaderboardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeaderboardResponse.ProtoReflect.Descriptor instead.
+func (*GetLeaderboardResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetLeaderboardResponse) GetRankBy() string {
+	if x != nil {
+		return x.RankBy
+	}
+	return ""
+}
+
+func (x *GetLeaderboardResponse) GetBuckets() []*LeaderboardBucket {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+func (x *GetLeaderboardResponse) GetGeneratedAt() string {
+	if x != nil {
+		return x.GeneratedAt
+	}
+	return ""
+}
+
+type LeaderboardBucket struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	NodeCount int32                  `protobuf:"varint,1,opt,name=node_count,json=nodeCount,proto3" json:"node_count,omitempty"`
+	// Best first; equal values share a rank.
+	Entries       []*LeaderboardEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaderboardBucket) Reset() {
+	*x = LeaderboardBucket{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaderboardBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaderboardBucket) ProtoMessage() {}
+
+func (x *LeaderboardBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaderboardBucket.ProtoReflect.Descriptor instead.
+func (*LeaderboardBucket) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *LeaderboardBucket) GetNodeCount() int32 {
+	if x != nil {
+		return x.NodeCount
+	}
+	return 0
+}
+
+func (x *LeaderboardBucket) GetEntries() []*LeaderboardEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type LeaderboardEntry struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Rank      int32                  `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	Algorithm string                 `protobuf:"bytes,2,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	Runs      int32                  `protobuf:"varint,3,opt,name=runs,proto3" json:"runs,omitempty"`
+	BestTps   float64                `protobuf:"fixed64,4,opt,name=best_tps,json=bestTps,proto3" json:"best_tps,omitempty"`
+	MedianTps float64                `protobuf:"fixed64,5,opt,name=median_tps,json=medianTps,proto3" json:"median_tps,omitempty"`
+	// Latencies in milliseconds; 0 when no run measured any.
+	BestLatencyP99   float64 `protobuf:"fixed64,6,opt,name=best_latency_p99,json=bestLatencyP99,proto3" json:"best_latency_p99,omitempty"`
+	MedianLatencyP99 float64 `protobuf:"fixed64,7,opt,name=median_latency_p99,json=medianLatencyP99,proto3" json:"median_latency_p99,omitempty"`
+	// The run with the highest throughput.
+	BestBenchmarkId string `protobuf:"bytes,8,opt,name=best_benchmark_id,json=bestBenchmarkId,proto3" json:"best_benchmark_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *LeaderboardEntry) Reset() {
+	*x = LeaderboardEntry{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaderboardEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaderboardEntry) ProtoMessage() {}
+
+func (x *LeaderboardEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaderboardEntry.ProtoReflect.Descriptor instead.
+func (*LeaderboardEntry) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *LeaderboardEntry) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *LeaderboardEntry) GetRuns() int32 {
+	if x != nil {
+		return x.Runs
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetBestTps() float64 {
+	if x != nil {
+		return x.BestTps
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetMedianTps() float64 {
+	if x != nil {
+		return x.MedianTps
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetBestLatencyP99() float64 {
+	if x != nil {
+		return x.BestLatencyP99
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetMedianLatencyP99() float64 {
+	if x != nil {
+		return x.MedianLatencyP99
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetBestBenchmarkId() string {
+	if x != nil {
+		return x.BestBenchmarkId
+	}
+	return ""
+}
+
+// GetTrendRequest follows a result metric of one algorithm's completed runs
+// over time or over implementation versions. Results are cached like the
+// leaderboard.
+type GetTrendRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Algorithm string                 `protobuf:"bytes,1,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	// One of actual_tps (default), latency_p50, latency_p90, latency_p99,
+	// latency_p999, latency_avg, cpu_usage_avg, cpu_usage_max,
+	// memory_usage_avg, memory_usage_max, block_propagation_time or
+	// view_change_count.
+	Metric string `protobuf:"bytes,2,opt,name=metric,proto3" json:"metric,omitempty"`
+	// time (default) or version. Versions come from the runs' environment,
+	// falling back to the git commit.
+	GroupBy string `protobuf:"bytes,3,opt,name=group_by,json=groupBy,proto3" json:"group_by,omitempty"`
+	// Bucket width when grouping by time: hour, day (default), week or month.
+	Interval string `protobuf:"bytes,4,opt,name=interval,proto3" json:"interval,omitempty"`
+	// Restricts the trend to one node count; 0 covers all.
+	NodeCount     int32  `protobuf:"varint,5,opt,name=node_count,json=nodeCount,proto3" json:"node_count,omitempty"`
+	CreatedAfter  string `protobuf:"bytes,6,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	CreatedBefore string `protobuf:"bytes,7,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTrendRequest) Reset() {
+	*x = GetTrendRequest{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTrendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrendRequest) ProtoMessage() {}
+
+func (x *GetTrendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTrendRequest.ProtoReflect.Descriptor instead.
+func (*GetTrendRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetTrendRequest) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *GetTrendRequest) GetMetric() string {
+	if x != nil {
+		return x.Metric
+	}
+	return ""
+}
+
+func (x *GetTrendRequest) GetGroupBy() string {
+	if x != nil {
+		return x.GroupBy
+	}
+	return ""
+}
+
+func (x *GetTrendRequest) GetInterval() string {
+	if x != nil {
+		return x.Interval
+	}
+	return ""
+}
+
+func (x *GetTrendRequest) GetNodeCount() int32 {
+	if x != nil {
+		return x.NodeCount
+	}
+	return 0
+}
+
+func (x *GetTrendRequest) GetCreatedAfter() string {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return ""
+}
+
+func (x *GetTrendRequest) GetCreatedBefore() string {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return ""
+}
+
+type GetTrendResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Algorithm string                 `protobuf:"bytes,1,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	Metric    string                 `protobuf:"bytes,2,opt,name=metric,proto3" json:"metric,omitempty"`
+	GroupBy   string                 `protobuf:"bytes,3,opt,name=group_by,json=groupBy,proto3" json:"group_by,omitempty"`
+	Interval  string                 `protobuf:"bytes,4,opt,name=interval,proto3" json:"interval,omitempty"`
+	// In chronological order.
+	Points        []*TrendPoint `protobuf:"bytes,5,rep,name=points,proto3" json:"points,omitempty"`
+	GeneratedAt   string        `protobuf:"bytes,6,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTrendResponse) Reset() {
+	*x = GetTrendResponse{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTrendResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrendResponse) ProtoMessage() {}
+
+func (x *GetTrendResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTrendResponse.ProtoReflect.Descriptor instead.
+func (*GetTrendResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetTrendResponse) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *GetTrendResponse) GetMetric() string {
+	if x != nil {
+		return x.Metric
+	}
+	return ""
+}
+
+func (x *GetTrendResponse) GetGroupBy() string {
+	if x != nil {
+		return x.GroupBy
+	}
+	return ""
+}
+
+func (x *GetTrendResponse) GetInterval() string {
+	if x != nil {
+		return x.Interval
+	}
+	return ""
+}
+
+func (x *GetTrendResponse) GetPoints() []*TrendPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+func (x *GetTrendResponse) GetGeneratedAt() string {
+	if x != nil {
+		return x.GeneratedAt
+	}
+	return ""
+}
+
+// TrendPoint summarises the runs of one time bucket or version.
+type TrendPoint struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The version, or the start of the time bucket in RFC3339.
+	Label         string  `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	FirstRunAt    string  `protobuf:"bytes,2,opt,name=first_run_at,json=firstRunAt,proto3" json:"first_run_at,omitempty"`
+	Runs          int32   `protobuf:"varint,3,opt,name=runs,proto3" json:"runs,omitempty"`
+	Mean          float64 `protobuf:"fixed64,4,opt,name=mean,proto3" json:"mean,omitempty"`
+	Median        float64 `protobuf:"fixed64,5,opt,name=median,proto3" json:"median,omitempty"`
+	Min           float64 `protobuf:"fixed64,6,opt,name=min,proto3" json:"min,omitempty"`
+	Max           float64 `protobuf:"fixed64,7,opt,name=max,proto3" json:"max,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrendPoint) Reset() {
+	*x = TrendPoint{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrendPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrendPoint) ProtoMessage() {}
+
+func (x *TrendPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrendPoint.ProtoReflect.Descriptor instead.
+func (*TrendPoint) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *TrendPoint) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *TrendPoint) GetFirstRunAt() string {
+	if x != nil {
+		return x.FirstRunAt
+	}
+	return ""
+}
+
+func (x *TrendPoint) GetRuns() int32 {
+	if x != nil {
+		return x.Runs
+	}
+	return 0
+}
+
+func (x *TrendPoint) GetMean() float64 {
+	if x != nil {
+		return x.Mean
+	}
+	return 0
+}
+
+func (x *TrendPoint) GetMedian() float64 {
+	if x != nil {
+		return x.Median
+	}
+	return 0
+}
+
+func (x *TrendPoint) GetMin() float64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *TrendPoint) GetMax() float64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+type BenchmarkTemplate struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Name given to runs created from the template. Supports the placeholders
+	// {template}, {algorithm}, {node_count}, {target_tps}, {duration}, {date}
+	// and {time}.
+	NamePattern      string            `protobuf:"bytes,4,opt,name=name_pattern,json=namePattern,proto3" json:"name_pattern,omitempty"`
+	Algorithm        string            `protobuf:"bytes,5,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	NodeCount        int32             `protobuf:"varint,6,opt,name=node_count,json=nodeCount,proto3" json:"node_count,omitempty"`
+	Duration         int32             `protobuf:"varint,7,opt,name=duration,proto3" json:"duration,omitempty"`
+	TargetTps        int32             `protobuf:"varint,8,opt,name=target_tps,json=targetTps,proto3" json:"target_tps,omitempty"`
+	RunGroup         string            `protobuf:"bytes,9,opt,name=run_group,json=runGroup,proto3" json:"run_group,omitempty"`
+	ConsensusParams  map[string]string `protobuf:"bytes,10,rep,name=consensus_params,json=consensusParams,proto3" json:"consensus_params,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ConsensusConfig  *ConsensusConfig  `protobuf:"bytes,11,opt,name=consensus_config,json=consensusConfig,proto3" json:"consensus_config,omitempty"`
+	WarmupDuration   int32             `protobuf:"varint,12,opt,name=warmup_duration,json=warmupDuration,proto3" json:"warmup_duration,omitempty"`
+	CooldownDuration int32             `protobuf:"varint,13,opt,name=cooldown_duration,json=cooldownDuration,proto3" json:"cooldown_duration,omitempty"`
+	Assertions       []string          `protobuf:"bytes,14,rep,name=assertions,proto3" json:"assertions,omitempty"`
+	CreatedAt        string            `protobuf:"bytes,20,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        string            `protobuf:"bytes,21,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *BenchmarkTemplate) Reset() {
+	*x = BenchmarkTemplate{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BenchmarkTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BenchmarkTemplate) ProtoMessage() {}
+
+func (x *BenchmarkTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BenchmarkTemplate.ProtoReflect.Descriptor instead.
+func (*BenchmarkTemplate) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *BenchmarkTemplate) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BenchmarkTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BenchmarkTemplate) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *BenchmarkTemplate) GetNamePattern() string {
+	if x != nil {
+		return x.NamePattern
+	}
+	return ""
+}
+
+func (x *BenchmarkTemplate) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *BenchmarkTemplate) GetNodeCount() int32 {
+	if x != nil {
+		return x.NodeCount
+	}
+	return 0
+}
+
+func (x *BenchmarkTemplate) GetDuration() int32 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *BenchmarkTemplate) GetTargetTps() int32 {
+	if x != nil {
+		return x.TargetTps
+	}
+	return 0
+}
+
+func (x *BenchmarkTemplate) GetRunGroup() string {
+	if x != nil {
+		return x.RunGroup
+	}
+	return ""
+}
+
+func (x *BenchmarkTemplate) GetConsensusParams() map[string]string {
+	if x != nil {
+		return x.ConsensusParams
+	}
+	return nil
+}
+
+func (x *BenchmarkTemplate) GetConsensusConfig() *ConsensusConfig {
+	if x != nil {
+		return x.ConsensusConfig
+	}
+	return nil
+}
+
+func (x *BenchmarkTemplate) GetWarmupDuration() int32 {
+	if x != nil {
+		return x.WarmupDuration
+	}
+	return 0
+}
+
+func (x *BenchmarkTemplate) GetCooldownDuration() int32 {
+	if x != nil {
+		return x.CooldownDuration
+	}
+	return 0
+}
+
+func (x *BenchmarkTemplate) GetAssertions() []string {
+	if x != nil {
+		return x.Assertions
+	}
+	return nil
+}
+
+func (x *BenchmarkTemplate) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *BenchmarkTemplate) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type CreateBenchmarkTemplateRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Name             string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description      string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	NamePattern      string                 `protobuf:"bytes,3,opt,name=name_pattern,json=namePattern,proto3" json:"name_pattern,omitempty"`
+	Algorithm        string                 `protobuf:"bytes,4,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	NodeCount        int32                  `protobuf:"varint,5,opt,name=node_count,json=nodeCount,proto3" json:"node_count,omitempty"`
+	Duration         int32                  `protobuf:"varint,6,opt,name=duration,proto3" json:"duration,omitempty"`
+	TargetTps        int32                  `protobuf:"varint,7,opt,name=target_tps,json=targetTps,proto3" json:"target_tps,omitempty"`
+	RunGroup         string                 `protobuf:"bytes,8,opt,name=run_group,json=runGroup,proto3" json:"run_group,omitempty"`
+	ConsensusParams  map[string]string      `protobuf:"bytes,9,rep,name=consensus_params,json=consensusParams,proto3" json:"consensus_params,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ConsensusConfig  *ConsensusConfig       `protobuf:"bytes,10,opt,name=consensus_config,json=consensusConfig,proto3" json:"consensus_config,omitempty"`
+	WarmupDuration   int32                  `protobuf:"varint,11,opt,name=warmup_duration,json=warmupDuration,proto3" json:"warmup_duration,omitempty"`
+	CooldownDuration int32                  `protobuf:"varint,12,opt,name=cooldown_duration,json=cooldownDuration,proto3" json:"cooldown_duration,omitempty"`
+	Assertions       []string               `protobuf:"bytes,13,rep,name=assertions,proto3" json:"assertions,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateBenchmarkTemplateRequest) Reset() {
+	*x = CreateBenchmarkTemplateRequest{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBenchmarkTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBenchmarkTemplateRequest) ProtoMessage() {}
+
+func (x *CreateBenchmarkTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBenchmarkTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateBenchmarkTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *CreateBenchmarkTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateBenchmarkTemplateRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateBenchmarkTemplateRequest) GetNamePattern() string {
+	if x != nil {
+		return x.NamePattern
+	}
+	return ""
+}
+
+func (x *CreateBenchmarkTemplateRequest) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *CreateBenchmarkTemplateRequest) GetNodeCount() int32 {
+	if x != nil {
+		return x.NodeCount
+	}
+	return 0
+}
+
+func (x *CreateBenchmarkTemplateRequest) GetDuration() int32 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *CreateBenchmarkTemplateRequest) GetTargetTps() int32 {
+	if x != nil {
+		return x.TargetTps
+	}
+	return 0
+}
+
+func (x *CreateBenchmarkTemplateRequest) GetRunGroup() string {
+	if x != nil {
+		return x.RunGroup
+	}
+	return ""
+}
+
+func (x *CreateBenchmarkTemplateRequest) GetConsensusParams() map[string]string {
+	if x != nil {
+		return x.ConsensusParams
+	}
+	return nil
+}
+
+func (x *CreateBenchmarkTemplateRequest) GetConsensusConfig() *ConsensusConfig {
+	if x != nil {
+		return x.ConsensusConfig
+	}
+	return nil
+}
+
+func (x *CreateBenchmarkTemplateRequest) GetWarmupDuration() int32 {
+	if x != nil {
+		return x.WarmupDuration
+	}
+	return 0
+}
+
+func (x *CreateBenchmarkTemplateRequest) GetCooldownDuration() int32 {
+	if x != nil {
+		return x.CooldownDuration
+	}
+	return 0
+}
+
+func (x *CreateBenchmarkTemplateRequest) GetAssertions() []string {
+	if x != nil {
+		return x.Assertions
+	}
+	return nil
+}
+
+type CreateBenchmarkTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *BenchmarkTemplate     `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBenchmarkTemplateResponse) Reset() {
+	*x = CreateBenchmarkTemplateResponse{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBenchmarkTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBenchmarkTemplateResponse) ProtoMessage() {}
+
+func (x *CreateBenchmarkTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBenchmarkTemplateResponse.ProtoReflect.Descriptor instead.
+func (*CreateBenchmarkTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *CreateBenchmarkTemplateResponse) GetTemplate() *BenchmarkTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type GetBenchmarkTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBenchmarkTemplateRequest) Reset() {
+	*x = GetBenchmarkTemplateRequest{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBenchmarkTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBenchmarkTemplateRequest) ProtoMessage() {}
+
+func (x *GetBenchmarkTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBenchmarkTemplateRequest.ProtoReflect.Descriptor instead.
+func (*GetBenchmarkTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetBenchmarkTemplateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetBenchmarkTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *BenchmarkTemplate     `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBenchmarkTemplateResponse) Reset() {
+	*x = GetBenchmarkTemplateResponse{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBenchmarkTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBenchmarkTemplateResponse) ProtoMessage() {}
+
+func (x *GetBenchmarkTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBenchmarkTemplateResponse.ProtoReflect.Descriptor instead.
+func (*GetBenchmarkTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetBenchmarkTemplateResponse) GetTemplate() *BenchmarkTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+// ListBenchmarkTemplatesRequest returns templates ordered by name.
+type ListBenchmarkTemplatesRequest struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Pagination    *common.PaginationRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBenchmarkTemplatesRequest) Reset() {
+	*x = ListBenchmarkTemplatesRequest{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBenchmarkTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBenchmarkTemplatesRequest) ProtoMessage() {}
+
+func (x *ListBenchmarkTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBenchmarkTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListBenchmarkTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ListBenchmarkTemplatesRequest) GetPagination() *common.PaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ListBenchmarkTemplatesResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Templates     []*BenchmarkTemplate       `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	Pagination    *common.PaginationResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBenchmarkTemplatesResponse) Reset() {
+	*x = ListBenchmarkTemplatesResponse{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBenchmarkTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBenchmarkTemplatesResponse) ProtoMessage() {}
+
+func (x *ListBenchmarkTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBenchmarkTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListBenchmarkTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ListBenchmarkTemplatesResponse) GetTemplates() []*BenchmarkTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+func (x *ListBenchmarkTemplatesResponse) GetPagination() *common.PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type DeleteBenchmarkTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBenchmarkTemplateRequest) Reset() {
+	*x = DeleteBenchmarkTemplateRequest{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBenchmarkTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBenchmarkTemplateRequest) ProtoMessage() {}
+
+func (x *DeleteBenchmarkTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBenchmarkTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBenchmarkTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *DeleteBenchmarkTemplateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// BenchmarkNode is a node's participation in a benchmark run.
+type BenchmarkNode struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	NodeId   string                 `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	NodeName string                 `protobuf:"bytes,2,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	Address  string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Region   string                 `protobuf:"bytes,4,opt,name=region,proto3" json:"region,omitempty"`
+	// leader, validator or observer.
+	Role string `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty"`
+	// active, healthy, crashed, byzantine or partitioned.
+	FinalStatus string `protobuf:"bytes,6,opt,name=final_status,json=finalStatus,proto3" json:"final_status,omitempty"`
+	// True for crashed, byzantine and partitioned nodes.
+	Faulty        bool   `protobuf:"varint,7,opt,name=faulty,proto3" json:"faulty,omitempty"`
+	JoinedAt      string `protobuf:"bytes,8,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
+	LeftAt        string `protobuf:"bytes,9,opt,name=left_at,json=leftAt,proto3" json:"left_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BenchmarkNode) Reset() {
+	*x = BenchmarkNode{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BenchmarkNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BenchmarkNode) ProtoMessage() {}
+
+func (x *BenchmarkNode) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BenchmarkNode.ProtoReflect.Descriptor instead.
+func (*BenchmarkNode) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *BenchmarkNode) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *BenchmarkNode) GetNodeName() string {
+	if x != nil {
+		return x.NodeName
+	}
+	return ""
+}
+
+func (x *BenchmarkNode) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *BenchmarkNode) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *BenchmarkNode) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *BenchmarkNode) GetFinalStatus() string {
+	if x != nil {
+		return x.FinalStatus
+	}
+	return ""
+}
+
+func (x *BenchmarkNode) GetFaulty() bool {
+	if x != nil {
+		return x.Faulty
+	}
+	return false
+}
+
+func (x *BenchmarkNode) GetJoinedAt() string {
+	if x != nil {
+		return x.JoinedAt
+	}
+	return ""
+}
+
+func (x *BenchmarkNode) GetLeftAt() string {
+	if x != nil {
+		return x.LeftAt
+	}
+	return ""
+}
+
+// BenchmarkNodeReport is a join or leave report for one node. Empty role or
+// final_status keep the recorded value; a new node needs a role.
+type BenchmarkNodeReport struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	NodeId      string                 `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Role        string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	FinalStatus string                 `protobuf:"bytes,3,opt,name=final_status,json=finalStatus,proto3" json:"final_status,omitempty"`
+	// RFC3339; empty keeps the recorded time.
+	JoinedAt      string `protobuf:"bytes,4,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
+	LeftAt        string `protobuf:"bytes,5,opt,name=left_at,json=leftAt,proto3" json:"left_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BenchmarkNodeReport) Reset() {
+	*x = BenchmarkNodeReport{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BenchmarkNodeReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BenchmarkNodeReport) ProtoMessage() {}
+
+func (x *BenchmarkNodeReport) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BenchmarkNodeReport.ProtoReflect.Descriptor instead.
+func (*BenchmarkNodeReport) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *BenchmarkNodeReport) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *BenchmarkNodeReport) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *BenchmarkNodeReport) GetFinalStatus() string {
+	if x != nil {
+		return x.FinalStatus
+	}
+	return ""
+}
+
+func (x *BenchmarkNodeReport) GetJoinedAt() string {
+	if x != nil {
+		return x.JoinedAt
+	}
+	return ""
+}
+
+func (x *BenchmarkNodeReport) GetLeftAt() string {
+	if x != nil {
+		return x.LeftAt
+	}
+	return ""
+}
+
+type RecordBenchmarkNodesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BenchmarkId   string                 `protobuf:"bytes,1,opt,name=benchmark_id,json=benchmarkId,proto3" json:"benchmark_id,omitempty"`
+	Nodes         []*BenchmarkNodeReport `protobuf:"bytes,2,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordBenchmarkNodesRequest) Reset() {
+	*x = RecordBenchmarkNodesRequest{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordBenchmarkNodesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordBenchmarkNodesRequest) ProtoMessage() {}
+
+func (x *RecordBenchmarkNodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordBenchmarkNodesRequest.ProtoReflect.Descriptor instead.
+func (*RecordBenchmarkNodesRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *RecordBenchmarkNodesRequest) GetBenchmarkId() string {
+	if x != nil {
+		return x.BenchmarkId
+	}
+	return ""
+}
+
+func (x *RecordBenchmarkNodesRequest) GetNodes() []*BenchmarkNodeReport {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+type RecordBenchmarkNodesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Nodes         []*BenchmarkNode       `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordBenchmarkNodesResponse) Reset() {
+	*x = RecordBenchmarkNodesResponse{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordBenchmarkNodesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordBenchmarkNodesResponse) ProtoMessage() {}
+
+func (x *RecordBenchmarkNodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordBenchmarkNodesResponse.ProtoReflect.Descriptor instead.
+func (*RecordBenchmarkNodesResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *RecordBenchmarkNodesResponse) GetNodes() []*BenchmarkNode {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+type ListBenchmarkNodesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BenchmarkId   string                 `protobuf:"bytes,1,opt,name=benchmark_id,json=benchmarkId,proto3" json:"benchmark_id,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	FaultyOnly    bool                   `protobuf:"varint,3,opt,name=faulty_only,json=faultyOnly,proto3" json:"faulty_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBenchmarkNodesRequest) Reset() {
+	*x = ListBenchmarkNodesRequest{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBenchmarkNodesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBenchmarkNodesRequest) ProtoMessage() {}
+
+func (x *ListBenchmarkNodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBenchmarkNodesRequest.ProtoReflect.Descriptor instead.
+func (*ListBenchmarkNodesRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListBenchmarkNodesRequest) GetBenchmarkId() string {
+	if x != nil {
+		return x.BenchmarkId
+	}
+	return ""
+}
+
+func (x *ListBenchmarkNodesRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *ListBenchmarkNodesRequest) GetFaultyOnly() bool {
+	if x != nil {
+		return x.FaultyOnly
+	}
+	return false
+}
+
+type ListBenchmarkNodesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Nodes         []*BenchmarkNode       `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBenchmarkNodesResponse) Reset() {
+	*x = ListBenchmarkNodesResponse{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBenchmarkNodesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBenchmarkNodesResponse) ProtoMessage() {}
+
+func (x *ListBenchmarkNodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBenchmarkNodesResponse.ProtoReflect.Descriptor instead.
+func (*ListBenchmarkNodesResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ListBenchmarkNodesResponse) GetNodes() []*BenchmarkNode {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+// ExportBenchmarkReportRequest renders a completed benchmark as a report.
+type ExportBenchmarkReportRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// One of "csv" (zip archive of CSV files), "json", "markdown" or "html".
+	Format string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	// Width of the time series buckets. Defaults to about 300 points per run.
+	BucketSeconds uint32 `protobuf:"varint,3,opt,name=bucket_seconds,json=bucketSeconds,proto3" json:"bucket_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportBenchmarkReportRequest) Reset() {
+	*x = ExportBenchmarkReportRequest{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportBenchmarkReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportBenchmarkReportRequest) ProtoMessage() {}
+
+func (x *ExportBenchmarkReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportBenchmarkReportRequest.ProtoReflect.Descriptor instead.
+func (*ExportBenchmarkReportRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ExportBenchmarkReportRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ExportBenchmarkReportRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ExportBenchmarkReportRequest) GetBucketSeconds() uint32 {
+	if x != nil {
+		return x.BucketSeconds
+	}
+	return 0
+}
+
+type ExportBenchmarkReportResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Content     []byte                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	ContentType string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// Suggested file name.
+	Filename      string `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportBenchmarkReportResponse) Reset() {
+	*x = ExportBenchmarkReportResponse{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportBenchmarkReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportBenchmarkReportResponse) ProtoMessage() {}
+
+func (x *ExportBenchmarkReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportBenchmarkReportResponse.ProtoReflect.Descriptor instead.
+func (*ExportBenchmarkReportResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ExportBenchmarkReportResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *ExportBenchmarkReportResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportBenchmarkReportResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+// WatchBenchmarkRequest streams progress frames until the benchmark reaches a
+// terminal state. Status transitions are pushed as soon as they happen.
+type WatchBenchmarkRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Time between frames. Defaults to 1000, at least 100.
+	IntervalMs uint32 `protobuf:"varint,2,opt,name=interval_ms,json=intervalMs,proto3" json:"interval_ms,omitempty"`
+	// Window the rolling figures are computed over. Defaults to 10, at most 60.
+	WindowSeconds uint32 `protobuf:"varint,3,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchBenchmarkRequest) Reset() {
+	*x = WatchBenchmarkRequest{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchBenchmarkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchBenchmarkRequest) ProtoMessage() {}
+
+func (x *WatchBenchmarkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchBenchmarkRequest.ProtoReflect.Descriptor instead.
+func (*WatchBenchmarkRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *WatchBenchmarkRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WatchBenchmarkRequest) GetIntervalMs() uint32 {
+	if x != nil {
+		return x.IntervalMs
+	}
+	return 0
+}
+
+func (x *WatchBenchmarkRequest) GetWindowSeconds() uint32 {
+	if x != nil {
+		return x.WindowSeconds
+	}
+	return 0
+}
+
+type BenchmarkProgress struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	BenchmarkId    string                 `protobuf:"bytes,1,opt,name=benchmark_id,json=benchmarkId,proto3" json:"benchmark_id,omitempty"`
+	Status         string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Timestamp      string                 `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	ElapsedSeconds float64                `protobuf:"fixed64,4,opt,name=elapsed_seconds,json=elapsedSeconds,proto3" json:"elapsed_seconds,omitempty"`
+	WindowSeconds  float64                `protobuf:"fixed64,5,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"`
+	RollingTps     float64                `protobuf:"fixed64,6,opt,name=rolling_tps,json=rollingTps,proto3" json:"rolling_tps,omitempty"`
+	LatencyP50     float64                `protobuf:"fixed64,7,opt,name=latency_p50,json=latencyP50,proto3" json:"latency_p50,omitempty"`
+	LatencyP99     float64                `protobuf:"fixed64,8,opt,name=latency_p99,json=latencyP99,proto3" json:"latency_p99,omitempty"`
+	PendingCount   int64                  `protobuf:"varint,9,opt,name=pending_count,json=pendingCount,proto3" json:"pending_count,omitempty"`
+	ConfirmedCount int64                  `protobuf:"varint,10,opt,name=confirmed_count,json=confirmedCount,proto3" json:"confirmed_count,omitempty"`
+	FailedCount    int64                  `protobuf:"varint,11,opt,name=failed_count,json=failedCount,proto3" json:"failed_count,omitempty"`
+	Nodes          []*NodeHealth          `protobuf:"bytes,12,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	// Status transitions since the previous frame.
+	Transitions   []*StatusTransition `protobuf:"bytes,13,rep,name=transitions,proto3" json:"transitions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BenchmarkProgress) Reset() {
+	*x = BenchmarkProgress{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BenchmarkProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BenchmarkProgress) ProtoMessage() {}
+
+func (x *BenchmarkProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BenchmarkProgress.ProtoReflect.Descriptor instead.
+func (*BenchmarkProgress) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *BenchmarkProgress) GetBenchmarkId() string {
+	if x != nil {
+		return x.BenchmarkId
+	}
+	return ""
+}
+
+func (x *BenchmarkProgress) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *BenchmarkProgress) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *BenchmarkProgress) GetElapsedSeconds() float64 {
+	if x != nil {
+		return x.ElapsedSeconds
+	}
+	return 0
+}
+
+func (x *BenchmarkProgress) GetWindowSeconds() float64 {
+	if x != nil {
+		return x.WindowSeconds
+	}
+	return 0
+}
+
+func (x *BenchmarkProgress) GetRollingTps() float64 {
+	if x != nil {
+		return x.RollingTps
+	}
+	return 0
+}
+
+func (x *BenchmarkProgress) GetLatencyP50() float64 {
+	if x != nil {
+		return x.LatencyP50
+	}
+	return 0
+}
+
+func (x *BenchmarkProgress) GetLatencyP99() float64 {
+	if x != nil {
+		return x.LatencyP99
+	}
+	return 0
+}
+
+func (x *BenchmarkProgress) GetPendingCount() int64 {
+	if x != nil {
+		return x.PendingCount
+	}
+	return 0
+}
+
+func (x *BenchmarkProgress) GetConfirmedCount() int64 {
+	if x != nil {
+		return x.ConfirmedCount
+	}
+	return 0
+}
+
+func (x *BenchmarkProgress) GetFailedCount() int64 {
+	if x != nil {
+		return x.FailedCount
+	}
+	return 0
+}
+
+func (x *BenchmarkProgress) GetNodes() []*NodeHealth {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *BenchmarkProgress) GetTransitions() []*StatusTransition {
+	if x != nil {
+		return x.Transitions
+	}
+	return nil
+}
+
+type NodeHealth struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	NodeId string                 `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	// "healthy", or "stale" when the node has not reported metrics recently.
+	Health     string `protobuf:"bytes,2,opt,name=health,proto3" json:"health,omitempty"`
+	LastSeenAt string `protobuf:"bytes,3,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty"`
+	// Latest value of every metric the node reported.
+	Metrics       map[string]float64 `protobuf:"bytes,4,rep,name=metrics,proto3" json:"metrics,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeHealth) Reset() {
+	*x = NodeHealth{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeHealth) ProtoMessage() {}
+
+func (x *NodeHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeHealth.ProtoReflect.Descriptor instead.
+func (*NodeHealth) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *NodeHealth) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *NodeHealth) GetHealth() string {
+	if x != nil {
+		return x.Health
+	}
+	return ""
+}
+
+func (x *NodeHealth) GetLastSeenAt() string {
+	if x != nil {
+		return x.LastSeenAt
+	}
+	return ""
+}
+
+func (x *NodeHealth) GetMetrics() map[string]float64 {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+type StatusTransition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	At            string                 `protobuf:"bytes,3,opt,name=at,proto3" json:"at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatusTransition) Reset() {
+	*x = StatusTransition{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusTransition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusTransition) ProtoMessage() {}
+
+func (x *StatusTransition) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusTransition.ProtoReflect.Descriptor instead.
+func (*StatusTransition) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *StatusTransition) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *StatusTransition) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *StatusTransition) GetAt() string {
+	if x != nil {
+		return x.At
+	}
+	return ""
+}
+
+// BenchmarkSchedule starts a benchmark whenever its cron expression fires,
+// from the referenced template or, without one, from its own configuration.
+type BenchmarkSchedule struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Five-field cron expression (minute hour day-of-month month day-of-week)
+	// or one of @hourly, @daily, @weekly, @monthly and @yearly.
+	CronExpr string `protobuf:"bytes,4,opt,name=cron_expr,json=cronExpr,proto3" json:"cron_expr,omitempty"`
+	// IANA time zone the expression is evaluated in. Defaults to UTC.
+	Timezone        string            `protobuf:"bytes,5,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	Enabled         bool              `protobuf:"varint,6,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	TemplateId      string            `protobuf:"bytes,7,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	NamePattern     string            `protobuf:"bytes,8,opt,name=name_pattern,json=namePattern,proto3" json:"name_pattern,omitempty"`
+	Algorithm       string            `protobuf:"bytes,9,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	NodeCount       int32             `protobuf:"varint,10,opt,name=node_count,json=nodeCount,proto3" json:"node_count,omitempty"`
+	Duration        int32             `protobuf:"varint,11,opt,name=duration,proto3" json:"duration,omitempty"`
+	TargetTps       int32             `protobuf:"varint,12,opt,name=target_tps,json=targetTps,proto3" json:"target_tps,omitempty"`
+	ConsensusConfig *ConsensusConfig  `protobuf:"bytes,13,opt,name=consensus_config,json=consensusConfig,proto3" json:"consensus_config,omitempty"`
+	ConsensusParams map[string]string `protobuf:"bytes,14,rep,name=consensus_params,json=consensusParams,proto3" json:"consensus_params,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Defaults to the template's run group, then to the schedule name.
+	RunGroup string `protobuf:"bytes,15,opt,name=run_group,json=runGroup,proto3" json:"run_group,omitempty"`
+	// Added to the tags of every run.
+	Tags            []string `protobuf:"bytes,16,rep,name=tags,proto3" json:"tags,omitempty"`
+	NextRunAt       string   `protobuf:"bytes,17,opt,name=next_run_at,json=nextRunAt,proto3" json:"next_run_at,omitempty"`
+	LastRunAt       string   `protobuf:"bytes,18,opt,name=last_run_at,json=lastRunAt,proto3" json:"last_run_at,omitempty"`
+	LastBenchmarkId string   `protobuf:"bytes,19,opt,name=last_benchmark_id,json=lastBenchmarkId,proto3" json:"last_benchmark_id,omitempty"`
+	// Why the last run could not be started, if it could not.
+	LastError        string `protobuf:"bytes,20,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	WarmupDuration   int32  `protobuf:"varint,21,opt,name=warmup_duration,json=warmupDuration,proto3" json:"warmup_duration,omitempty"`
+	CooldownDuration int32  `protobuf:"varint,22,opt,name=cooldown_duration,json=cooldownDuration,proto3" json:"cooldown_duration,omitempty"`
+	CreatedAt        string `protobuf:"bytes,30,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        string `protobuf:"bytes,31,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *BenchmarkSchedule) Reset() {
+	*x = BenchmarkSchedule{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BenchmarkSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BenchmarkSchedule) ProtoMessage() {}
+
+func (x *BenchmarkSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BenchmarkSchedule.ProtoReflect.Descriptor instead.
+func (*BenchmarkSchedule) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *BenchmarkSchedule) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BenchmarkSchedule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BenchmarkSchedule) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *BenchmarkSchedule) GetCronExpr() string {
+	if x != nil {
+		return x.CronExpr
+	}
+	return ""
+}
+
+func (x *BenchmarkSchedule) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *BenchmarkSchedule) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *BenchmarkSchedule) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *BenchmarkSchedule) GetNamePattern() string {
+	if x != nil {
+		return x.NamePattern
+	}
+	return ""
+}
+
+func (x *BenchmarkSchedule) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *BenchmarkSchedule) GetNodeCount() int32 {
+	if x != nil {
+		return x.NodeCount
+	}
+	return 0
+}
+
+func (x *BenchmarkSchedule) GetDuration() int32 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *BenchmarkSchedule) GetTargetTps() int32 {
+	if x != nil {
+		return x.TargetTps
+	}
+	return 0
+}
+
+func (x *BenchmarkSchedule) GetConsensusConfig() *ConsensusConfig {
+	if x != nil {
+		return x.ConsensusConfig
+	}
+	return nil
+}
+
+func (x *BenchmarkSchedule) GetConsensusParams() map[string]string {
+	if x != nil {
+		return x.ConsensusParams
+	}
+	return nil
+}
+
+func (x *BenchmarkSchedule) GetRunGroup() string {
+	if x != nil {
+		return x.RunGroup
+	}
+	return ""
+}
+
+func (x *BenchmarkSchedule) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *BenchmarkSchedule) GetNextRunAt() string {
+	if x != nil {
+		return x.NextRunAt
+	}
+	return ""
+}
+
+func (x *BenchmarkSchedule) GetLastRunAt() string {
+	if x != nil {
+		return x.LastRunAt
+	}
+	return ""
+}
+
+func (x *BenchmarkSchedule) GetLastBenchmarkId() string {
+	if x != nil {
+		return x.LastBenchmarkId
+	}
+	return ""
+}
+
+func (x *BenchmarkSchedule) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *BenchmarkSchedule) GetWarmupDuration() int32 {
+	if x != nil {
+		return x.WarmupDuration
+	}
+	return 0
+}
+
+func (x *BenchmarkSchedule) GetCooldownDuration() int32 {
+	if x != nil {
+		return x.CooldownDuration
+	}
+	return 0
+}
+
+func (x *BenchmarkSchedule) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *BenchmarkSchedule) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type CreateBenchmarkScheduleRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Name        string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	CronExpr    string                 `protobuf:"bytes,3,opt,name=cron_expr,json=cronExpr,proto3" json:"cron_expr,omitempty"`
+	Timezone    string                 `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// Create the schedule without activating it.
+	Disabled bool `protobuf:"varint,5,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// Either a template or algorithm, node_count and duration are required.
+	TemplateId       string            `protobuf:"bytes,6,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	NamePattern      string            `protobuf:"bytes,7,opt,name=name_pattern,json=namePattern,proto3" json:"name_pattern,omitempty"`
+	Algorithm        string            `protobuf:"bytes,8,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	NodeCount        int32             `protobuf:"varint,9,opt,name=node_count,json=nodeCount,proto3" json:"node_count,omitempty"`
+	Duration         int32             `protobuf:"varint,10,opt,name=duration,proto3" json:"duration,omitempty"`
+	TargetTps        int32             `protobuf:"varint,11,opt,name=target_tps,json=targetTps,proto3" json:"target_tps,omitempty"`
+	ConsensusConfig  *ConsensusConfig  `protobuf:"bytes,12,opt,name=consensus_config,json=consensusConfig,proto3" json:"consensus_config,omitempty"`
+	ConsensusParams  map[string]string `protobuf:"bytes,13,rep,name=consensus_params,json=consensusParams,proto3" json:"consensus_params,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	RunGroup         string            `protobuf:"bytes,14,opt,name=run_group,json=runGroup,proto3" json:"run_group,omitempty"`
+	Tags             []string          `protobuf:"bytes,15,rep,name=tags,proto3" json:"tags,omitempty"`
+	WarmupDuration   int32             `protobuf:"varint,16,opt,name=warmup_duration,json=warmupDuration,proto3" json:"warmup_duration,omitempty"`
+	CooldownDuration int32             `protobuf:"varint,17,opt,name=cooldown_duration,json=cooldownDuration,proto3" json:"cooldown_duration,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateBenchmarkScheduleRequest) Reset() {
+	*x = CreateBenchmarkScheduleRequest{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBenchmarkScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBenchmarkScheduleRequest) ProtoMessage() {}
+
+func (x *CreateBenchmarkScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBenchmarkScheduleRequest.ProtoReflect.Descriptor instead.
+func (*CreateBenchmarkScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *CreateBenchmarkScheduleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateBenchmarkScheduleRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateBenchmarkScheduleRequest) GetCronExpr() string {
+	if x != nil {
+		return x.CronExpr
+	}
+	return ""
+}
+
+func (x *CreateBenchmarkScheduleRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
 }
 
-// Deprecated: Use CreateBenchmarkRequest.ProtoReflect.Descriptor instead.
-func (*CreateBenchmarkRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{1}
+func (x *CreateBenchmarkScheduleRequest) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
 }
 
-func (x *CreateBenchmarkRequest) GetName() string {
+func (x *CreateBenchmarkScheduleRequest) GetTemplateId() string {
 	if x != nil {
-		return x.Name
+		return x.TemplateId
 	}
 	return ""
 }
 
-func (x *CreateBenchmarkRequest) GetDescription() string {
+func (x *CreateBenchmarkScheduleRequest) GetNamePattern() string {
 	if x != nil {
-		return x.Description
+		return x.NamePattern
 	}
 	return ""
 }
 
-func (x *CreateBenchmarkRequest) GetAlgorithm() string {
+func (x *CreateBenchmarkScheduleRequest) GetAlgorithm() string {
 	if x != nil {
 		return x.Algorithm
 	}
 	return ""
 }
 
-func (x *CreateBenchmarkRequest) GetNodeCount() int32 {
+func (x *CreateBenchmarkScheduleRequest) GetNodeCount() int32 {
 	if x != nil {
 		return x.NodeCount
 	}
 	return 0
 }
 
-func (x *CreateBenchmarkRequest) GetDuration() int32 {
+func (x *CreateBenchmarkScheduleRequest) GetDuration() int32 {
 	if x != nil {
 		return x.Duration
 	}
 	return 0
 }
 
-func (x *CreateBenchmarkRequest) GetTargetTps() int32 {
+func (x *CreateBenchmarkScheduleRequest) GetTargetTps() int32 {
 	if x != nil {
 		return x.TargetTps
 	}
 	return 0
 }
 
-type CreateBenchmarkResponse struct {
+func (x *CreateBenchmarkScheduleRequest) GetConsensusConfig() *ConsensusConfig {
+	if x != nil {
+		return x.ConsensusConfig
+	}
+	return nil
+}
+
+func (x *CreateBenchmarkScheduleRequest) GetConsensusParams() map[string]string {
+	if x != nil {
+		return x.ConsensusParams
+	}
+	return nil
+}
+
+func (x *CreateBenchmarkScheduleRequest) GetRunGroup() string {
+	if x != nil {
+		return x.RunGroup
+	}
+	return ""
+}
+
+func (x *CreateBenchmarkScheduleRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreateBenchmarkScheduleRequest) GetWarmupDuration() int32 {
+	if x != nil {
+		return x.WarmupDuration
+	}
+	return 0
+}
+
+func (x *CreateBenchmarkScheduleRequest) GetCooldownDuration() int32 {
+	if x != nil {
+		return x.CooldownDuration
+	}
+	return 0
+}
+
+type CreateBenchmarkScheduleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Benchmark     *Benchmark             `protobuf:"bytes,1,opt,name=benchmark,proto3" json:"benchmark,omitempty"`
+	Schedule      *BenchmarkSchedule     `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateBenchmarkResponse) Reset() {
-	*x = CreateBenchmarkResponse{}
-	mi := &file_api_proto_benchmark_proto_msgTypes[2]
+func (x *CreateBenchmarkScheduleResponse) Reset() {
+	*x = CreateBenchmarkScheduleResponse{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateBenchmarkResponse) String() string {
+func (x *CreateBenchmarkScheduleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateBenchmarkResponse) ProtoMessage() {}
+func (*CreateBenchmarkScheduleResponse) ProtoMessage() {}
 
-func (x *CreateBenchmarkResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_benchmark_proto_msgTypes[2]
+func (x *CreateBenchmarkScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -270,40 +5655,40 @@ func (x *CreateBenchmarkResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateBenchmarkResponse.ProtoReflect.Descriptor instead.
-func (*CreateBenchmarkResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use CreateBenchmarkScheduleResponse.ProtoReflect.Descriptor instead.
+func (*CreateBenchmarkScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *CreateBenchmarkResponse) GetBenchmark() *Benchmark {
+func (x *CreateBenchmarkScheduleResponse) GetSchedule() *BenchmarkSchedule {
 	if x != nil {
-		return x.Benchmark
+		return x.Schedule
 	}
 	return nil
 }
 
-type GetBenchmarkRequest struct {
+type GetBenchmarkScheduleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetBenchmarkRequest) Reset() {
-	*x = GetBenchmarkRequest{}
-	mi := &file_api_proto_benchmark_proto_msgTypes[3]
+func (x *GetBenchmarkScheduleRequest) Reset() {
+	*x = GetBenchmarkScheduleRequest{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBenchmarkRequest) String() string {
+func (x *GetBenchmarkScheduleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBenchmarkRequest) ProtoMessage() {}
+func (*GetBenchmarkScheduleRequest) ProtoMessage() {}
 
-func (x *GetBenchmarkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_benchmark_proto_msgTypes[3]
+func (x *GetBenchmarkScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -314,40 +5699,40 @@ func (x *GetBenchmarkRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBenchmarkRequest.ProtoReflect.Descriptor instead.
-func (*GetBenchmarkRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use GetBenchmarkScheduleRequest.ProtoReflect.Descriptor instead.
+func (*GetBenchmarkScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *GetBenchmarkRequest) GetId() string {
+func (x *GetBenchmarkScheduleRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type GetBenchmarkResponse struct {
+type GetBenchmarkScheduleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Benchmark     *Benchmark             `protobuf:"bytes,1,opt,name=benchmark,proto3" json:"benchmark,omitempty"`
+	Schedule      *BenchmarkSchedule     `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetBenchmarkResponse) Reset() {
-	*x = GetBenchmarkResponse{}
-	mi := &file_api_proto_benchmark_proto_msgTypes[4]
+func (x *GetBenchmarkScheduleResponse) Reset() {
+	*x = GetBenchmarkScheduleResponse{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBenchmarkResponse) String() string {
+func (x *GetBenchmarkScheduleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBenchmarkResponse) ProtoMessage() {}
+func (*GetBenchmarkScheduleResponse) ProtoMessage() {}
 
-func (x *GetBenchmarkResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_benchmark_proto_msgTypes[4]
+func (x *GetBenchmarkScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -358,40 +5743,41 @@ func (x *GetBenchmarkResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBenchmarkResponse.ProtoReflect.Descriptor instead.
-func (*GetBenchmarkResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use GetBenchmarkScheduleResponse.ProtoReflect.Descriptor instead.
+func (*GetBenchmarkScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *GetBenchmarkResponse) GetBenchmark() *Benchmark {
+func (x *GetBenchmarkScheduleResponse) GetSchedule() *BenchmarkSchedule {
 	if x != nil {
-		return x.Benchmark
+		return x.Schedule
 	}
 	return nil
 }
 
-type ListBenchmarksRequest struct {
+// ListBenchmarkSchedulesRequest returns schedules ordered by name.
+type ListBenchmarkSchedulesRequest struct {
 	state         protoimpl.MessageState    `protogen:"open.v1"`
 	Pagination    *common.PaginationRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListBenchmarksRequest) Reset() {
-	*x = ListBenchmarksRequest{}
-	mi := &file_api_proto_benchmark_proto_msgTypes[5]
+func (x *ListBenchmarkSchedulesRequest) Reset() {
+	*x = ListBenchmarkSchedulesRequest{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListBenchmarksRequest) String() string {
+func (x *ListBenchmarkSchedulesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBenchmarksRequest) ProtoMessage() {}
+func (*ListBenchmarkSchedulesRequest) ProtoMessage() {}
 
-func (x *ListBenchmarksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_benchmark_proto_msgTypes[5]
+func (x *ListBenchmarkSchedulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -402,41 +5788,41 @@ func (x *ListBenchmarksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBenchmarksRequest.ProtoReflect.Descriptor instead.
-func (*ListBenchmarksRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use ListBenchmarkSchedulesRequest.ProtoReflect.Descriptor instead.
+func (*ListBenchmarkSchedulesRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *ListBenchmarksRequest) GetPagination() *common.PaginationRequest {
+func (x *ListBenchmarkSchedulesRequest) GetPagination() *common.PaginationRequest {
 	if x != nil {
 		return x.Pagination
 	}
 	return nil
 }
 
-type ListBenchmarksResponse struct {
+type ListBenchmarkSchedulesResponse struct {
 	state         protoimpl.MessageState     `protogen:"open.v1"`
-	Benchmarks    []*Benchmark               `protobuf:"bytes,1,rep,name=benchmarks,proto3" json:"benchmarks,omitempty"`
+	Schedules     []*BenchmarkSchedule       `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules,omitempty"`
 	Pagination    *common.PaginationResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListBenchmarksResponse) Reset() {
-	*x = ListBenchmarksResponse{}
-	mi := &file_api_proto_benchmark_proto_msgTypes[6]
+func (x *ListBenchmarkSchedulesResponse) Reset() {
+	*x = ListBenchmarkSchedulesResponse{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListBenchmarksResponse) String() string {
+func (x *ListBenchmarkSchedulesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBenchmarksResponse) ProtoMessage() {}
+func (*ListBenchmarkSchedulesResponse) ProtoMessage() {}
 
-func (x *ListBenchmarksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_benchmark_proto_msgTypes[6]
+func (x *ListBenchmarkSchedulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -447,48 +5833,58 @@ func (x *ListBenchmarksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBenchmarksResponse.ProtoReflect.Descriptor instead.
-func (*ListBenchmarksResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ListBenchmarkSchedulesResponse.ProtoReflect.Descriptor instead.
+func (*ListBenchmarkSchedulesResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *ListBenchmarksResponse) GetBenchmarks() []*Benchmark {
+func (x *ListBenchmarkSchedulesResponse) GetSchedules() []*BenchmarkSchedule {
 	if x != nil {
-		return x.Benchmarks
+		return x.Schedules
 	}
 	return nil
 }
 
-func (x *ListBenchmarksResponse) GetPagination() *common.PaginationResponse {
+func (x *ListBenchmarkSchedulesResponse) GetPagination() *common.PaginationResponse {
 	if x != nil {
 		return x.Pagination
 	}
 	return nil
 }
 
-type UpdateBenchmarkRequest struct {
+// UpdateBenchmarkScheduleRequest changes the fields named in update_mask, or
+// every populated field without one. Changing the run configuration requires
+// a new schedule.
+type UpdateBenchmarkScheduleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	CronExpr      string                 `protobuf:"bytes,4,opt,name=cron_expr,json=cronExpr,proto3" json:"cron_expr,omitempty"`
+	Timezone      string                 `protobuf:"bytes,5,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	Enabled       bool                   `protobuf:"varint,6,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	RunGroup      string                 `protobuf:"bytes,7,opt,name=run_group,json=runGroup,proto3" json:"run_group,omitempty"`
+	Tags          []string               `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,20,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateBenchmarkRequest) Reset() {
-	*x = UpdateBenchmarkRequest{}
-	mi := &file_api_proto_benchmark_proto_msgTypes[7]
+func (x *UpdateBenchmarkScheduleRequest) Reset() {
+	*x = UpdateBenchmarkScheduleRequest{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateBenchmarkRequest) String() string {
+func (x *UpdateBenchmarkScheduleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateBenchmarkRequest) ProtoMessage() {}
+func (*UpdateBenchmarkScheduleRequest) ProtoMessage() {}
 
-func (x *UpdateBenchmarkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_benchmark_proto_msgTypes[7]
+func (x *UpdateBenchmarkScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -499,47 +5895,96 @@ func (x *UpdateBenchmarkRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateBenchmarkRequest.ProtoReflect.Descriptor instead.
-func (*UpdateBenchmarkRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use UpdateBenchmarkScheduleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBenchmarkScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *UpdateBenchmarkRequest) GetId() string {
+func (x *UpdateBenchmarkScheduleRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdateBenchmarkRequest) GetStatus() string {
+func (x *UpdateBenchmarkScheduleRequest) GetName() string {
 	if x != nil {
-		return x.Status
+		return x.Name
 	}
 	return ""
 }
 
-type UpdateBenchmarkResponse struct {
+func (x *UpdateBenchmarkScheduleRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateBenchmarkScheduleRequest) GetCronExpr() string {
+	if x != nil {
+		return x.CronExpr
+	}
+	return ""
+}
+
+func (x *UpdateBenchmarkScheduleRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *UpdateBenchmarkScheduleRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *UpdateBenchmarkScheduleRequest) GetRunGroup() string {
+	if x != nil {
+		return x.RunGroup
+	}
+	return ""
+}
+
+func (x *UpdateBenchmarkScheduleRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *UpdateBenchmarkScheduleRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type UpdateBenchmarkScheduleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Benchmark     *Benchmark             `protobuf:"bytes,1,opt,name=benchmark,proto3" json:"benchmark,omitempty"`
+	Schedule      *BenchmarkSchedule     `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateBenchmarkResponse) Reset() {
-	*x = UpdateBenchmarkResponse{}
-	mi := &file_api_proto_benchmark_proto_msgTypes[8]
+func (x *UpdateBenchmarkScheduleResponse) Reset() {
+	*x = UpdateBenchmarkScheduleResponse{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateBenchmarkResponse) String() string {
+func (x *UpdateBenchmarkScheduleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateBenchmarkResponse) ProtoMessage() {}
+func (*UpdateBenchmarkScheduleResponse) ProtoMessage() {}
 
-func (x *UpdateBenchmarkResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_benchmark_proto_msgTypes[8]
+func (x *UpdateBenchmarkScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -550,40 +5995,40 @@ func (x *UpdateBenchmarkResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateBenchmarkResponse.ProtoReflect.Descriptor instead.
-func (*UpdateBenchmarkResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use UpdateBenchmarkScheduleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateBenchmarkScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *UpdateBenchmarkResponse) GetBenchmark() *Benchmark {
+func (x *UpdateBenchmarkScheduleResponse) GetSchedule() *BenchmarkSchedule {
 	if x != nil {
-		return x.Benchmark
+		return x.Schedule
 	}
 	return nil
 }
 
-type DeleteBenchmarkRequest struct {
+type DeleteBenchmarkScheduleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteBenchmarkRequest) Reset() {
-	*x = DeleteBenchmarkRequest{}
-	mi := &file_api_proto_benchmark_proto_msgTypes[9]
+func (x *DeleteBenchmarkScheduleRequest) Reset() {
+	*x = DeleteBenchmarkScheduleRequest{}
+	mi := &file_api_proto_benchmark_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteBenchmarkRequest) String() string {
+func (x *DeleteBenchmarkScheduleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteBenchmarkRequest) ProtoMessage() {}
+func (*DeleteBenchmarkScheduleRequest) ProtoMessage() {}
 
-func (x *DeleteBenchmarkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_benchmark_proto_msgTypes[9]
+func (x *DeleteBenchmarkScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_benchmark_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -594,12 +6039,12 @@ func (x *DeleteBenchmarkRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteBenchmarkRequest.ProtoReflect.Descriptor instead.
-func (*DeleteBenchmarkRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use DeleteBenchmarkScheduleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBenchmarkScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_benchmark_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *DeleteBenchmarkRequest) GetId() string {
+func (x *DeleteBenchmarkScheduleRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
@@ -610,7 +6055,7 @@ var File_api_proto_benchmark_proto protoreflect.FileDescriptor
 
 const file_api_proto_benchmark_proto_rawDesc = "" +
 	"\n" +
-	"\x19api/proto/benchmark.proto\x12\x10hcp.benchmark.v1\x1a\x16api/proto/common.proto\"\xdf\x02\n" +
+	"\x19api/proto/benchmark.proto\x12\x10hcp.benchmark.v1\x1a\x16api/proto/common.proto\x1a google/protobuf/field_mask.proto\"\xd3\v\n" +
 	"\tBenchmark\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -626,11 +6071,123 @@ const file_api_proto_benchmark_proto_rawDesc = "" +
 	"actual_tps\x18\t \x01(\x01R\tactualTps\x12\x1f\n" +
 	"\vlatency_avg\x18\n" +
 	" \x01(\x01R\n" +
-	"latencyAvg\x12\x1d\n" +
+	"latencyAvg\x12#\n" +
+	"\rerror_message\x18\v \x01(\tR\ferrorMessage\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\f \x01(\tR\tstartedAt\x12!\n" +
+	"\fcompleted_at\x18\r \x01(\tR\vcompletedAt\x124\n" +
+	"\x16measurement_started_at\x18\x0e \x01(\tR\x14measurementStartedAt\x120\n" +
+	"\x14measurement_ended_at\x18\x0f \x01(\tR\x12measurementEndedAt\x12<\n" +
+	"\aresults\x18\x10 \x01(\v2\".hcp.benchmark.v1.BenchmarkResultsR\aresults\x12\x1b\n" +
+	"\trun_group\x18\x11 \x01(\tR\brunGroup\x12#\n" +
+	"\rexperiment_id\x18\x12 \x01(\tR\fexperimentId\x12[\n" +
+	"\x10consensus_params\x18\x13 \x03(\v20.hcp.benchmark.v1.Benchmark.ConsensusParamsEntryR\x0fconsensusParams\x12\x1d\n" +
 	"\n" +
 	"created_at\x18\x14 \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\x15 \x01(\tR\tupdatedAt\"\xc6\x01\n" +
+	"updated_at\x18\x15 \x01(\tR\tupdatedAt\x12\x1f\n" +
+	"\vtemplate_id\x18\x16 \x01(\tR\n" +
+	"templateId\x12$\n" +
+	"\x0ecloned_from_id\x18\x17 \x01(\tR\fclonedFromId\x12L\n" +
+	"\x10consensus_config\x18\x18 \x01(\v2!.hcp.benchmark.v1.ConsensusConfigR\x0fconsensusConfig\x12?\n" +
+	"\venvironment\x18\x19 \x01(\v2\x1d.hcp.benchmark.v1.EnvironmentR\venvironment\x125\n" +
+	"\x05nodes\x18\x1a \x03(\v2\x1f.hcp.benchmark.v1.BenchmarkNodeR\x05nodes\x12\x12\n" +
+	"\x04tags\x18\x1b \x03(\tR\x04tags\x12\x1f\n" +
+	"\vschedule_id\x18\x1c \x01(\tR\n" +
+	"scheduleId\x12\x1a\n" +
+	"\bpriority\x18\x1d \x01(\x05R\bpriority\x12\x18\n" +
+	"\acluster\x18\x1e \x01(\tR\acluster\x12%\n" +
+	"\x0equeue_position\x18\x1f \x01(\x05R\rqueuePosition\x12'\n" +
+	"\x0fwarmup_duration\x18  \x01(\x05R\x0ewarmupDuration\x12+\n" +
+	"\x11cooldown_duration\x18! \x01(\x05R\x10cooldownDuration\x12\x1e\n" +
+	"\n" +
+	"assertions\x18\" \x03(\tR\n" +
+	"assertions\x12N\n" +
+	"\x11assertion_results\x18# \x03(\v2!.hcp.benchmark.v1.AssertionResultR\x10assertionResults\x12\x1d\n" +
+	"\n" +
+	"sla_status\x18$ \x01(\tR\tslaStatus\x1aB\n" +
+	"\x14ConsensusParamsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x87\x01\n" +
+	"\x0fAssertionResult\x12\x1c\n" +
+	"\tassertion\x18\x01 \x01(\tR\tassertion\x12\x16\n" +
+	"\x06passed\x18\x02 \x01(\bR\x06passed\x12\x12\n" +
+	"\x04left\x18\x03 \x01(\x01R\x04left\x12\x14\n" +
+	"\x05right\x18\x04 \x01(\x01R\x05right\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\"\xcd\x02\n" +
+	"\x0fConsensusConfig\x12\x1d\n" +
+	"\n" +
+	"block_size\x18\x01 \x01(\x05R\tblockSize\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x02 \x01(\x05R\tbatchSize\x12*\n" +
+	"\x11block_interval_ms\x18\x03 \x01(\x05R\x0fblockIntervalMs\x12,\n" +
+	"\x12request_timeout_ms\x18\x04 \x01(\x05R\x10requestTimeoutMs\x123\n" +
+	"\x16view_change_timeout_ms\x18\x05 \x01(\x05R\x13viewChangeTimeoutMs\x12/\n" +
+	"\x13checkpoint_interval\x18\x06 \x01(\x05R\x12checkpointInterval\x12<\n" +
+	"\anetwork\x18\a \x01(\v2\".hcp.benchmark.v1.NetworkEmulationR\anetwork\"\xa5\x01\n" +
+	"\x10NetworkEmulation\x12\x1d\n" +
+	"\n" +
+	"latency_ms\x18\x01 \x01(\x01R\tlatencyMs\x12\x1b\n" +
+	"\tjitter_ms\x18\x02 \x01(\x01R\bjitterMs\x12.\n" +
+	"\x13packet_loss_percent\x18\x03 \x01(\x01R\x11packetLossPercent\x12%\n" +
+	"\x0ebandwidth_mbps\x18\x04 \x01(\x01R\rbandwidthMbps\"\xe4\x02\n" +
+	"\vEnvironment\x12\x1d\n" +
+	"\n" +
+	"git_commit\x18\x01 \x01(\tR\tgitCommit\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\x12\x0e\n" +
+	"\x02os\x18\x03 \x01(\tR\x02os\x12\x16\n" +
+	"\x06kernel\x18\x04 \x01(\tR\x06kernel\x12\x1b\n" +
+	"\tcpu_model\x18\x05 \x01(\tR\bcpuModel\x12\x1b\n" +
+	"\tcpu_cores\x18\x06 \x01(\x05R\bcpuCores\x12\x1b\n" +
+	"\tmemory_gb\x18\a \x01(\x01R\bmemoryGb\x12#\n" +
+	"\rinstance_type\x18\b \x01(\tR\finstanceType\x12>\n" +
+	"\x05extra\x18\t \x03(\v2(.hcp.benchmark.v1.Environment.ExtraEntryR\x05extra\x1a8\n" +
+	"\n" +
+	"ExtraEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xe9\x02\n" +
+	"\x10BenchmarkResults\x12\x1d\n" +
+	"\n" +
+	"actual_tps\x18\x01 \x01(\x01R\tactualTps\x128\n" +
+	"\alatency\x18\x02 \x01(\v2\x1e.hcp.benchmark.v1.LatencyStatsR\alatency\x12G\n" +
+	"\ftransactions\x18\x03 \x01(\v2#.hcp.benchmark.v1.TransactionCountsR\ftransactions\x124\n" +
+	"\x06blocks\x18\x04 \x01(\v2\x1c.hcp.benchmark.v1.BlockStatsR\x06blocks\x12=\n" +
+	"\tresources\x18\x05 \x01(\v2\x1f.hcp.benchmark.v1.ResourceUsageR\tresources\x12>\n" +
+	"\tconsensus\x18\x06 \x01(\v2 .hcp.benchmark.v1.ConsensusStatsR\tconsensus\"\x8e\x01\n" +
+	"\fLatencyStats\x12\x10\n" +
+	"\x03p50\x18\x01 \x01(\x01R\x03p50\x12\x10\n" +
+	"\x03p90\x18\x02 \x01(\x01R\x03p90\x12\x10\n" +
+	"\x03p99\x18\x03 \x01(\x01R\x03p99\x12\x12\n" +
+	"\x04p999\x18\x04 \x01(\x01R\x04p999\x12\x10\n" +
+	"\x03avg\x18\x05 \x01(\x01R\x03avg\x12\x10\n" +
+	"\x03min\x18\x06 \x01(\x01R\x03min\x12\x10\n" +
+	"\x03max\x18\a \x01(\x01R\x03max\"a\n" +
+	"\x11TransactionCounts\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x12\x1e\n" +
+	"\n" +
+	"successful\x18\x02 \x01(\x05R\n" +
+	"successful\x12\x16\n" +
+	"\x06failed\x18\x03 \x01(\x05R\x06failed\"\x89\x01\n" +
+	"\n" +
+	"BlockStats\x12\x1f\n" +
+	"\vblock_count\x18\x01 \x01(\x05R\n" +
+	"blockCount\x12$\n" +
+	"\x0eblock_size_avg\x18\x02 \x01(\x01R\fblockSizeAvg\x124\n" +
+	"\x16block_propagation_time\x18\x03 \x01(\x01R\x14blockPropagationTime\"\xc3\x02\n" +
+	"\rResourceUsage\x12\"\n" +
+	"\rcpu_usage_avg\x18\x01 \x01(\x01R\vcpuUsageAvg\x12\"\n" +
+	"\rcpu_usage_max\x18\x02 \x01(\x01R\vcpuUsageMax\x12(\n" +
+	"\x10memory_usage_avg\x18\x03 \x01(\x01R\x0ememoryUsageAvg\x12(\n" +
+	"\x10memory_usage_max\x18\x04 \x01(\x01R\x0ememoryUsageMax\x12&\n" +
+	"\x0fnetwork_in_mbps\x18\x05 \x01(\x01R\rnetworkInMbps\x12(\n" +
+	"\x10network_out_mbps\x18\x06 \x01(\x01R\x0enetworkOutMbps\x12 \n" +
+	"\fdisk_io_read\x18\a \x01(\x01R\n" +
+	"diskIoRead\x12\"\n" +
+	"\rdisk_io_write\x18\b \x01(\x01R\vdiskIoWrite\"\xa2\x01\n" +
+	"\x0eConsensusStats\x12*\n" +
+	"\x11view_change_count\x18\x01 \x01(\x05R\x0fviewChangeCount\x122\n" +
+	"\x15prepare_phase_latency\x18\x02 \x01(\x01R\x13preparePhaseLatency\x120\n" +
+	"\x14commit_phase_latency\x18\x03 \x01(\x01R\x12commitPhaseLatency\"\x81\x06\n" +
 	"\x16CreateBenchmarkRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1c\n" +
@@ -639,37 +6196,496 @@ const file_api_proto_benchmark_proto_rawDesc = "" +
 	"node_count\x18\x04 \x01(\x05R\tnodeCount\x12\x1a\n" +
 	"\bduration\x18\x05 \x01(\x05R\bduration\x12\x1d\n" +
 	"\n" +
-	"target_tps\x18\x06 \x01(\x05R\ttargetTps\"T\n" +
+	"target_tps\x18\x06 \x01(\x05R\ttargetTps\x12\x1b\n" +
+	"\trun_group\x18\a \x01(\tR\brunGroup\x12h\n" +
+	"\x10consensus_params\x18\b \x03(\v2=.hcp.benchmark.v1.CreateBenchmarkRequest.ConsensusParamsEntryR\x0fconsensusParams\x12\x1f\n" +
+	"\vtemplate_id\x18\t \x01(\tR\n" +
+	"templateId\x12L\n" +
+	"\x10consensus_config\x18\n" +
+	" \x01(\v2!.hcp.benchmark.v1.ConsensusConfigR\x0fconsensusConfig\x12?\n" +
+	"\venvironment\x18\v \x01(\v2\x1d.hcp.benchmark.v1.EnvironmentR\venvironment\x12\x12\n" +
+	"\x04tags\x18\f \x03(\tR\x04tags\x12\x1a\n" +
+	"\bpriority\x18\r \x01(\x05R\bpriority\x12\x18\n" +
+	"\acluster\x18\x0e \x01(\tR\acluster\x12'\n" +
+	"\x0fwarmup_duration\x18\x0f \x01(\x05R\x0ewarmupDuration\x12+\n" +
+	"\x11cooldown_duration\x18\x10 \x01(\x05R\x10cooldownDuration\x12\x1e\n" +
+	"\n" +
+	"assertions\x18\x11 \x03(\tR\n" +
+	"assertions\x1aB\n" +
+	"\x14ConsensusParamsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"T\n" +
 	"\x17CreateBenchmarkResponse\x129\n" +
 	"\tbenchmark\x18\x01 \x01(\v2\x1b.hcp.benchmark.v1.BenchmarkR\tbenchmark\"%\n" +
 	"\x13GetBenchmarkRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"Q\n" +
 	"\x14GetBenchmarkResponse\x129\n" +
-	"\tbenchmark\x18\x01 \x01(\v2\x1b.hcp.benchmark.v1.BenchmarkR\tbenchmark\"Y\n" +
+	"\tbenchmark\x18\x01 \x01(\v2\x1b.hcp.benchmark.v1.BenchmarkR\tbenchmark\"\xff\x04\n" +
 	"\x15ListBenchmarksRequest\x12@\n" +
 	"\n" +
 	"pagination\x18\x01 \x01(\v2 .hcp.common.v1.PaginationRequestR\n" +
-	"pagination\"\x98\x01\n" +
+	"pagination\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1b\n" +
+	"\trun_group\x18\x03 \x01(\tR\brunGroup\x12W\n" +
+	"\n" +
+	"parameters\x18\x04 \x03(\v27.hcp.benchmark.v1.ListBenchmarksRequest.ParametersEntryR\n" +
+	"parameters\x12\x1c\n" +
+	"\talgorithm\x18\x05 \x01(\tR\talgorithm\x12$\n" +
+	"\x0emin_node_count\x18\x06 \x01(\x05R\fminNodeCount\x12$\n" +
+	"\x0emax_node_count\x18\a \x01(\x05R\fmaxNodeCount\x12#\n" +
+	"\rcreated_after\x18\b \x01(\tR\fcreatedAfter\x12%\n" +
+	"\x0ecreated_before\x18\t \x01(\tR\rcreatedBefore\x12\x12\n" +
+	"\x04tags\x18\n" +
+	" \x03(\tR\x04tags\x12\x16\n" +
+	"\x06search\x18\v \x01(\tR\x06search\x12\x17\n" +
+	"\asort_by\x18\f \x01(\tR\x06sortBy\x12\x1d\n" +
+	"\n" +
+	"sort_order\x18\r \x01(\tR\tsortOrder\x12\x1d\n" +
+	"\n" +
+	"sla_status\x18\x0e \x01(\tR\tslaStatus\x12\x1e\n" +
+	"\n" +
+	"experiment\x18\x0f \x01(\tR\n" +
+	"experiment\x1a=\n" +
+	"\x0fParametersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x98\x01\n" +
 	"\x16ListBenchmarksResponse\x12;\n" +
 	"\n" +
 	"benchmarks\x18\x01 \x03(\v2\x1b.hcp.benchmark.v1.BenchmarkR\n" +
 	"benchmarks\x12A\n" +
 	"\n" +
 	"pagination\x18\x02 \x01(\v2!.hcp.common.v1.PaginationResponseR\n" +
-	"pagination\"@\n" +
+	"pagination\"\x93\x06\n" +
 	"\x16UpdateBenchmarkRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
-	"\x06status\x18\x02 \x01(\tR\x06status\"T\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12#\n" +
+	"\rerror_message\x18\x05 \x01(\tR\ferrorMessage\x12\x1d\n" +
+	"\n" +
+	"actual_tps\x18\x06 \x01(\x01R\tactualTps\x12\x1f\n" +
+	"\vlatency_p50\x18\a \x01(\x01R\n" +
+	"latencyP50\x12\x1f\n" +
+	"\vlatency_p90\x18\b \x01(\x01R\n" +
+	"latencyP90\x12\x1f\n" +
+	"\vlatency_p99\x18\t \x01(\x01R\n" +
+	"latencyP99\x12!\n" +
+	"\flatency_p999\x18\n" +
+	" \x01(\x01R\vlatencyP999\x12\x1f\n" +
+	"\vlatency_avg\x18\v \x01(\x01R\n" +
+	"latencyAvg\x12\x1f\n" +
+	"\vlatency_min\x18\f \x01(\x01R\n" +
+	"latencyMin\x12\x1f\n" +
+	"\vlatency_max\x18\r \x01(\x01R\n" +
+	"latencyMax\x12+\n" +
+	"\x11transaction_count\x18\x0e \x01(\x05R\x10transactionCount\x12#\n" +
+	"\rsuccessful_tx\x18\x0f \x01(\x05R\fsuccessfulTx\x12\x1b\n" +
+	"\tfailed_tx\x18\x10 \x01(\x05R\bfailedTx\x12?\n" +
+	"\venvironment\x18\x11 \x01(\v2\x1d.hcp.benchmark.v1.EnvironmentR\venvironment\x12\x12\n" +
+	"\x04tags\x18\x12 \x03(\tR\x04tags\x12\x1a\n" +
+	"\bpriority\x18\x13 \x01(\x05R\bpriority\x12;\n" +
+	"\vupdate_mask\x18\x14 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12.\n" +
+	"\x13expected_updated_at\x18\x15 \x01(\tR\x11expectedUpdatedAt\x12!\n" +
+	"\fcancelled_by\x18\x16 \x01(\tR\vcancelledBy\"T\n" +
 	"\x17UpdateBenchmarkResponse\x129\n" +
 	"\tbenchmark\x18\x01 \x01(\v2\x1b.hcp.benchmark.v1.BenchmarkR\tbenchmark\"(\n" +
 	"\x16DeleteBenchmarkRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id2\x82\x04\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"2\n" +
+	" RecomputeBenchmarkResultsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"^\n" +
+	"!RecomputeBenchmarkResultsResponse\x129\n" +
+	"\tbenchmark\x18\x01 \x01(\v2\x1b.hcp.benchmark.v1.BenchmarkR\tbenchmark\",\n" +
+	"\x18CompareBenchmarksRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\"\xbd\x01\n" +
+	"\x19CompareBenchmarksResponse\x12;\n" +
+	"\n" +
+	"benchmarks\x18\x01 \x03(\v2\x1b.hcp.benchmark.v1.BenchmarkR\n" +
+	"benchmarks\x12<\n" +
+	"\ametrics\x18\x02 \x03(\v2\".hcp.benchmark.v1.MetricComparisonR\ametrics\x12%\n" +
+	"\x0erecomputed_ids\x18\x03 \x03(\tR\rrecomputedIds\"\xd6\x01\n" +
+	"\x10MetricComparison\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\bcategory\x18\x02 \x01(\tR\bcategory\x12\x12\n" +
+	"\x04unit\x18\x03 \x01(\tR\x04unit\x12(\n" +
+	"\x10higher_is_better\x18\x04 \x01(\bR\x0ehigherIsBetter\x127\n" +
+	"\x06values\x18\x05 \x03(\v2\x1f.hcp.benchmark.v1.ComparedValueR\x06values\x12\x1b\n" +
+	"\twinner_id\x18\x06 \x01(\tR\bwinnerId\"\xae\x01\n" +
+	"\rComparedValue\x12!\n" +
+	"\fbenchmark_id\x18\x01 \x01(\tR\vbenchmarkId\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value\x12%\n" +
+	"\x0eabsolute_delta\x18\x03 \x01(\x01R\rabsoluteDelta\x12%\n" +
+	"\x0erelative_delta\x18\x04 \x01(\x01R\rrelativeDelta\x12\x16\n" +
+	"\x06winner\x18\x05 \x01(\bR\x06winner\"R\n" +
+	"\x0eBenchmarkGroup\x12\x1b\n" +
+	"\trun_group\x18\x01 \x01(\tR\brunGroup\x12#\n" +
+	"\rbenchmark_ids\x18\x02 \x03(\tR\fbenchmarkIds\"\xc6\x02\n" +
+	"\x1dCompareBenchmarkGroupsRequest\x12<\n" +
+	"\bbaseline\x18\x01 \x01(\v2 .hcp.benchmark.v1.BenchmarkGroupR\bbaseline\x12>\n" +
+	"\tcandidate\x18\x02 \x01(\v2 .hcp.benchmark.v1.BenchmarkGroupR\tcandidate\x12)\n" +
+	"\x10confidence_level\x18\x03 \x01(\x01R\x0fconfidenceLevel\x12\x14\n" +
+	"\x05alpha\x18\x04 \x01(\x01R\x05alpha\x121\n" +
+	"\x14bootstrap_iterations\x18\x05 \x01(\x05R\x13bootstrapIterations\x12\x1f\n" +
+	"\vmax_samples\x18\x06 \x01(\x05R\n" +
+	"maxSamples\x12\x12\n" +
+	"\x04seed\x18\a \x01(\x03R\x04seed\"V\n" +
+	"\x12ConfidenceInterval\x12\x14\n" +
+	"\x05lower\x18\x01 \x01(\x01R\x05lower\x12\x14\n" +
+	"\x05upper\x18\x02 \x01(\x01R\x05upper\x12\x14\n" +
+	"\x05level\x18\x03 \x01(\x01R\x05level\"\x91\x02\n" +
+	"\x0fGroupStatistics\x12#\n" +
+	"\rbenchmark_ids\x18\x01 \x03(\tR\fbenchmarkIds\x12\x1f\n" +
+	"\vsample_size\x18\x02 \x01(\x05R\n" +
+	"sampleSize\x12\x12\n" +
+	"\x04mean\x18\x03 \x01(\x01R\x04mean\x12=\n" +
+	"\amean_ci\x18\x04 \x01(\v2$.hcp.benchmark.v1.ConfidenceIntervalR\x06meanCi\x12\x16\n" +
+	"\x06median\x18\x05 \x01(\x01R\x06median\x12\x10\n" +
+	"\x03p99\x18\x06 \x01(\x01R\x03p99\x12;\n" +
+	"\x06p99_ci\x18\a \x01(\v2$.hcp.benchmark.v1.ConfidenceIntervalR\x05p99Ci\"i\n" +
+	"\x11MannWhitneyResult\x12\f\n" +
+	"\x01u\x18\x01 \x01(\x01R\x01u\x12\f\n" +
+	"\x01z\x18\x02 \x01(\x01R\x01z\x12\x17\n" +
+	"\ap_value\x18\x03 \x01(\x01R\x06pValue\x12\x1f\n" +
+	"\veffect_size\x18\x04 \x01(\x01R\n" +
+	"effectSize\"\x82\x02\n" +
+	"\x1eCompareBenchmarkGroupsResponse\x12=\n" +
+	"\bbaseline\x18\x01 \x01(\v2!.hcp.benchmark.v1.GroupStatisticsR\bbaseline\x12?\n" +
+	"\tcandidate\x18\x02 \x01(\v2!.hcp.benchmark.v1.GroupStatisticsR\tcandidate\x12F\n" +
+	"\fmann_whitney\x18\x03 \x01(\v2#.hcp.benchmark.v1.MannWhitneyResultR\vmannWhitney\x12\x18\n" +
+	"\averdict\x18\x04 \x01(\tR\averdict\"X\n" +
+	"\x15CloneBenchmarkRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
+	"\trun_group\x18\x03 \x01(\tR\brunGroup\"S\n" +
+	"\x16CloneBenchmarkResponse\x129\n" +
+	"\tbenchmark\x18\x01 \x01(\v2\x1b.hcp.benchmark.v1.BenchmarkR\tbenchmark\"c\n" +
+	"\x16CancelBenchmarkRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\fcancelled_by\x18\x02 \x01(\tR\vcancelledBy\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"T\n" +
+	"\x17CancelBenchmarkResponse\x129\n" +
+	"\tbenchmark\x18\x01 \x01(\v2\x1b.hcp.benchmark.v1.BenchmarkR\tbenchmark\"4\n" +
+	"\x18GetBenchmarkQueueRequest\x12\x18\n" +
+	"\acluster\x18\x01 \x01(\tR\acluster\"\x94\x01\n" +
+	"\x19GetBenchmarkQueueResponse\x12;\n" +
+	"\n" +
+	"benchmarks\x18\x01 \x03(\v2\x1b.hcp.benchmark.v1.BenchmarkR\n" +
+	"benchmarks\x12:\n" +
+	"\bclusters\x18\x02 \x03(\v2\x1e.hcp.benchmark.v1.ClusterQueueR\bclusters\"\x7f\n" +
+	"\fClusterQueue\x12\x18\n" +
+	"\acluster\x18\x01 \x01(\tR\acluster\x12\x16\n" +
+	"\x06queued\x18\x02 \x01(\x05R\x06queued\x12\x16\n" +
+	"\x06active\x18\x03 \x01(\x05R\x06active\x12%\n" +
+	"\x0emax_concurrent\x18\x04 \x01(\x05R\rmaxConcurrent\"\xbb\x01\n" +
+	"\x15GetLeaderboardRequest\x12\x1e\n" +
+	"\n" +
+	"algorithms\x18\x01 \x03(\tR\n" +
+	"algorithms\x12\x1d\n" +
+	"\n" +
+	"node_count\x18\x02 \x01(\x05R\tnodeCount\x12#\n" +
+	"\rcreated_after\x18\x03 \x01(\tR\fcreatedAfter\x12%\n" +
+	"\x0ecreated_before\x18\x04 \x01(\tR\rcreatedBefore\x12\x17\n" +
+	"\arank_by\x18\x05 \x01(\tR\x06rankBy\"\x93\x01\n" +
+	"\x16GetLeaderboardResponse\x12\x17\n" +
+	"\arank_by\x18\x01 \x01(\tR\x06rankBy\x12=\n" +
+	"\abuckets\x18\x02 \x03(\v2#.hcp.benchmark.v1.LeaderboardBucketR\abuckets\x12!\n" +
+	"\fgenerated_at\x18\x03 \x01(\tR\vgeneratedAt\"p\n" +
+	"\x11LeaderboardBucket\x12\x1d\n" +
+	"\n" +
+	"node_count\x18\x01 \x01(\x05R\tnodeCount\x12<\n" +
+	"\aentries\x18\x02 \x03(\v2\".hcp.benchmark.v1.LeaderboardEntryR\aentries\"\x96\x02\n" +
+	"\x10LeaderboardEntry\x12\x12\n" +
+	"\x04rank\x18\x01 \x01(\x05R\x04rank\x12\x1c\n" +
+	"\talgorithm\x18\x02 \x01(\tR\talgorithm\x12\x12\n" +
+	"\x04runs\x18\x03 \x01(\x05R\x04runs\x12\x19\n" +
+	"\bbest_tps\x18\x04 \x01(\x01R\abestTps\x12\x1d\n" +
+	"\n" +
+	"median_tps\x18\x05 \x01(\x01R\tmedianTps\x12(\n" +
+	"\x10best_latency_p99\x18\x06 \x01(\x01R\x0ebestLatencyP99\x12,\n" +
+	"\x12median_latency_p99\x18\a \x01(\x01R\x10medianLatencyP99\x12*\n" +
+	"\x11best_benchmark_id\x18\b \x01(\tR\x0fbestBenchmarkId\"\xe9\x01\n" +
+	"\x0fGetTrendRequest\x12\x1c\n" +
+	"\talgorithm\x18\x01 \x01(\tR\talgorithm\x12\x16\n" +
+	"\x06metric\x18\x02 \x01(\tR\x06metric\x12\x19\n" +
+	"\bgroup_by\x18\x03 \x01(\tR\agroupBy\x12\x1a\n" +
+	"\binterval\x18\x04 \x01(\tR\binterval\x12\x1d\n" +
+	"\n" +
+	"node_count\x18\x05 \x01(\x05R\tnodeCount\x12#\n" +
+	"\rcreated_after\x18\x06 \x01(\tR\fcreatedAfter\x12%\n" +
+	"\x0ecreated_before\x18\a \x01(\tR\rcreatedBefore\"\xd8\x01\n" +
+	"\x10GetTrendResponse\x12\x1c\n" +
+	"\talgorithm\x18\x01 \x01(\tR\talgorithm\x12\x16\n" +
+	"\x06metric\x18\x02 \x01(\tR\x06metric\x12\x19\n" +
+	"\bgroup_by\x18\x03 \x01(\tR\agroupBy\x12\x1a\n" +
+	"\binterval\x18\x04 \x01(\tR\binterval\x124\n" +
+	"\x06points\x18\x05 \x03(\v2\x1c.hcp.benchmark.v1.TrendPointR\x06points\x12!\n" +
+	"\fgenerated_at\x18\x06 \x01(\tR\vgeneratedAt\"\xa8\x01\n" +
+	"\n" +
+	"TrendPoint\x12\x14\n" +
+	"\x05label\x18\x01 \x01(\tR\x05label\x12 \n" +
+	"\ffirst_run_at\x18\x02 \x01(\tR\n" +
+	"firstRunAt\x12\x12\n" +
+	"\x04runs\x18\x03 \x01(\x05R\x04runs\x12\x12\n" +
+	"\x04mean\x18\x04 \x01(\x01R\x04mean\x12\x16\n" +
+	"\x06median\x18\x05 \x01(\x01R\x06median\x12\x10\n" +
+	"\x03min\x18\x06 \x01(\x01R\x03min\x12\x10\n" +
+	"\x03max\x18\a \x01(\x01R\x03max\"\xbc\x05\n" +
+	"\x11BenchmarkTemplate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12!\n" +
+	"\fname_pattern\x18\x04 \x01(\tR\vnamePattern\x12\x1c\n" +
+	"\talgorithm\x18\x05 \x01(\tR\talgorithm\x12\x1d\n" +
+	"\n" +
+	"node_count\x18\x06 \x01(\x05R\tnodeCount\x12\x1a\n" +
+	"\bduration\x18\a \x01(\x05R\bduration\x12\x1d\n" +
+	"\n" +
+	"target_tps\x18\b \x01(\x05R\ttargetTps\x12\x1b\n" +
+	"\trun_group\x18\t \x01(\tR\brunGroup\x12c\n" +
+	"\x10consensus_params\x18\n" +
+	" \x03(\v28.hcp.benchmark.v1.BenchmarkTemplate.ConsensusParamsEntryR\x0fconsensusParams\x12L\n" +
+	"\x10consensus_config\x18\v \x01(\v2!.hcp.benchmark.v1.ConsensusConfigR\x0fconsensusConfig\x12'\n" +
+	"\x0fwarmup_duration\x18\f \x01(\x05R\x0ewarmupDuration\x12+\n" +
+	"\x11cooldown_duration\x18\r \x01(\x05R\x10cooldownDuration\x12\x1e\n" +
+	"\n" +
+	"assertions\x18\x0e \x03(\tR\n" +
+	"assertions\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x14 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x15 \x01(\tR\tupdatedAt\x1aB\n" +
+	"\x14ConsensusParamsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x88\x05\n" +
+	"\x1eCreateBenchmarkTemplateRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12!\n" +
+	"\fname_pattern\x18\x03 \x01(\tR\vnamePattern\x12\x1c\n" +
+	"\talgorithm\x18\x04 \x01(\tR\talgorithm\x12\x1d\n" +
+	"\n" +
+	"node_count\x18\x05 \x01(\x05R\tnodeCount\x12\x1a\n" +
+	"\bduration\x18\x06 \x01(\x05R\bduration\x12\x1d\n" +
+	"\n" +
+	"target_tps\x18\a \x01(\x05R\ttargetTps\x12\x1b\n" +
+	"\trun_group\x18\b \x01(\tR\brunGroup\x12p\n" +
+	"\x10consensus_params\x18\t \x03(\v2E.hcp.benchmark.v1.CreateBenchmarkTemplateRequest.ConsensusParamsEntryR\x0fconsensusParams\x12L\n" +
+	"\x10consensus_config\x18\n" +
+	" \x01(\v2!.hcp.benchmark.v1.ConsensusConfigR\x0fconsensusConfig\x12'\n" +
+	"\x0fwarmup_duration\x18\v \x01(\x05R\x0ewarmupDuration\x12+\n" +
+	"\x11cooldown_duration\x18\f \x01(\x05R\x10cooldownDuration\x12\x1e\n" +
+	"\n" +
+	"assertions\x18\r \x03(\tR\n" +
+	"assertions\x1aB\n" +
+	"\x14ConsensusParamsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"b\n" +
+	"\x1fCreateBenchmarkTemplateResponse\x12?\n" +
+	"\btemplate\x18\x01 \x01(\v2#.hcp.benchmark.v1.BenchmarkTemplateR\btemplate\"-\n" +
+	"\x1bGetBenchmarkTemplateRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"_\n" +
+	"\x1cGetBenchmarkTemplateResponse\x12?\n" +
+	"\btemplate\x18\x01 \x01(\v2#.hcp.benchmark.v1.BenchmarkTemplateR\btemplate\"a\n" +
+	"\x1dListBenchmarkTemplatesRequest\x12@\n" +
+	"\n" +
+	"pagination\x18\x01 \x01(\v2 .hcp.common.v1.PaginationRequestR\n" +
+	"pagination\"\xa6\x01\n" +
+	"\x1eListBenchmarkTemplatesResponse\x12A\n" +
+	"\ttemplates\x18\x01 \x03(\v2#.hcp.benchmark.v1.BenchmarkTemplateR\ttemplates\x12A\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2!.hcp.common.v1.PaginationResponseR\n" +
+	"pagination\"0\n" +
+	"\x1eDeleteBenchmarkTemplateRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xfc\x01\n" +
+	"\rBenchmarkNode\x12\x17\n" +
+	"\anode_id\x18\x01 \x01(\tR\x06nodeId\x12\x1b\n" +
+	"\tnode_name\x18\x02 \x01(\tR\bnodeName\x12\x18\n" +
+	"\aaddress\x18\x03 \x01(\tR\aaddress\x12\x16\n" +
+	"\x06region\x18\x04 \x01(\tR\x06region\x12\x12\n" +
+	"\x04role\x18\x05 \x01(\tR\x04role\x12!\n" +
+	"\ffinal_status\x18\x06 \x01(\tR\vfinalStatus\x12\x16\n" +
+	"\x06faulty\x18\a \x01(\bR\x06faulty\x12\x1b\n" +
+	"\tjoined_at\x18\b \x01(\tR\bjoinedAt\x12\x17\n" +
+	"\aleft_at\x18\t \x01(\tR\x06leftAt\"\x9b\x01\n" +
+	"\x13BenchmarkNodeReport\x12\x17\n" +
+	"\anode_id\x18\x01 \x01(\tR\x06nodeId\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\x12!\n" +
+	"\ffinal_status\x18\x03 \x01(\tR\vfinalStatus\x12\x1b\n" +
+	"\tjoined_at\x18\x04 \x01(\tR\bjoinedAt\x12\x17\n" +
+	"\aleft_at\x18\x05 \x01(\tR\x06leftAt\"}\n" +
+	"\x1bRecordBenchmarkNodesRequest\x12!\n" +
+	"\fbenchmark_id\x18\x01 \x01(\tR\vbenchmarkId\x12;\n" +
+	"\x05nodes\x18\x02 \x03(\v2%.hcp.benchmark.v1.BenchmarkNodeReportR\x05nodes\"U\n" +
+	"\x1cRecordBenchmarkNodesResponse\x125\n" +
+	"\x05nodes\x18\x01 \x03(\v2\x1f.hcp.benchmark.v1.BenchmarkNodeR\x05nodes\"s\n" +
+	"\x19ListBenchmarkNodesRequest\x12!\n" +
+	"\fbenchmark_id\x18\x01 \x01(\tR\vbenchmarkId\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\x12\x1f\n" +
+	"\vfaulty_only\x18\x03 \x01(\bR\n" +
+	"faultyOnly\"S\n" +
+	"\x1aListBenchmarkNodesResponse\x125\n" +
+	"\x05nodes\x18\x01 \x03(\v2\x1f.hcp.benchmark.v1.BenchmarkNodeR\x05nodes\"m\n" +
+	"\x1cExportBenchmarkReportRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\x12%\n" +
+	"\x0ebucket_seconds\x18\x03 \x01(\rR\rbucketSeconds\"x\n" +
+	"\x1dExportBenchmarkReportResponse\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\fR\acontent\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x1a\n" +
+	"\bfilename\x18\x03 \x01(\tR\bfilename\"o\n" +
+	"\x15WatchBenchmarkRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vinterval_ms\x18\x02 \x01(\rR\n" +
+	"intervalMs\x12%\n" +
+	"\x0ewindow_seconds\x18\x03 \x01(\rR\rwindowSeconds\"\x8a\x04\n" +
+	"\x11BenchmarkProgress\x12!\n" +
+	"\fbenchmark_id\x18\x01 \x01(\tR\vbenchmarkId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1c\n" +
+	"\ttimestamp\x18\x03 \x01(\tR\ttimestamp\x12'\n" +
+	"\x0felapsed_seconds\x18\x04 \x01(\x01R\x0eelapsedSeconds\x12%\n" +
+	"\x0ewindow_seconds\x18\x05 \x01(\x01R\rwindowSeconds\x12\x1f\n" +
+	"\vrolling_tps\x18\x06 \x01(\x01R\n" +
+	"rollingTps\x12\x1f\n" +
+	"\vlatency_p50\x18\a \x01(\x01R\n" +
+	"latencyP50\x12\x1f\n" +
+	"\vlatency_p99\x18\b \x01(\x01R\n" +
+	"latencyP99\x12#\n" +
+	"\rpending_count\x18\t \x01(\x03R\fpendingCount\x12'\n" +
+	"\x0fconfirmed_count\x18\n" +
+	" \x01(\x03R\x0econfirmedCount\x12!\n" +
+	"\ffailed_count\x18\v \x01(\x03R\vfailedCount\x122\n" +
+	"\x05nodes\x18\f \x03(\v2\x1c.hcp.benchmark.v1.NodeHealthR\x05nodes\x12D\n" +
+	"\vtransitions\x18\r \x03(\v2\".hcp.benchmark.v1.StatusTransitionR\vtransitions\"\xe0\x01\n" +
+	"\n" +
+	"NodeHealth\x12\x17\n" +
+	"\anode_id\x18\x01 \x01(\tR\x06nodeId\x12\x16\n" +
+	"\x06health\x18\x02 \x01(\tR\x06health\x12 \n" +
+	"\flast_seen_at\x18\x03 \x01(\tR\n" +
+	"lastSeenAt\x12C\n" +
+	"\ametrics\x18\x04 \x03(\v2).hcp.benchmark.v1.NodeHealth.MetricsEntryR\ametrics\x1a:\n" +
+	"\fMetricsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\"F\n" +
+	"\x10StatusTransition\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\x12\x0e\n" +
+	"\x02at\x18\x03 \x01(\tR\x02at\"\xaf\a\n" +
+	"\x11BenchmarkSchedule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1b\n" +
+	"\tcron_expr\x18\x04 \x01(\tR\bcronExpr\x12\x1a\n" +
+	"\btimezone\x18\x05 \x01(\tR\btimezone\x12\x18\n" +
+	"\aenabled\x18\x06 \x01(\bR\aenabled\x12\x1f\n" +
+	"\vtemplate_id\x18\a \x01(\tR\n" +
+	"templateId\x12!\n" +
+	"\fname_pattern\x18\b \x01(\tR\vnamePattern\x12\x1c\n" +
+	"\talgorithm\x18\t \x01(\tR\talgorithm\x12\x1d\n" +
+	"\n" +
+	"node_count\x18\n" +
+	" \x01(\x05R\tnodeCount\x12\x1a\n" +
+	"\bduration\x18\v \x01(\x05R\bduration\x12\x1d\n" +
+	"\n" +
+	"target_tps\x18\f \x01(\x05R\ttargetTps\x12L\n" +
+	"\x10consensus_config\x18\r \x01(\v2!.hcp.benchmark.v1.ConsensusConfigR\x0fconsensusConfig\x12c\n" +
+	"\x10consensus_params\x18\x0e \x03(\v28.hcp.benchmark.v1.BenchmarkSchedule.ConsensusParamsEntryR\x0fconsensusParams\x12\x1b\n" +
+	"\trun_group\x18\x0f \x01(\tR\brunGroup\x12\x12\n" +
+	"\x04tags\x18\x10 \x03(\tR\x04tags\x12\x1e\n" +
+	"\vnext_run_at\x18\x11 \x01(\tR\tnextRunAt\x12\x1e\n" +
+	"\vlast_run_at\x18\x12 \x01(\tR\tlastRunAt\x12*\n" +
+	"\x11last_benchmark_id\x18\x13 \x01(\tR\x0flastBenchmarkId\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\x14 \x01(\tR\tlastError\x12'\n" +
+	"\x0fwarmup_duration\x18\x15 \x01(\x05R\x0ewarmupDuration\x12+\n" +
+	"\x11cooldown_duration\x18\x16 \x01(\x05R\x10cooldownDuration\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x1e \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x1f \x01(\tR\tupdatedAt\x1aB\n" +
+	"\x14ConsensusParamsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xf2\x05\n" +
+	"\x1eCreateBenchmarkScheduleRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1b\n" +
+	"\tcron_expr\x18\x03 \x01(\tR\bcronExpr\x12\x1a\n" +
+	"\btimezone\x18\x04 \x01(\tR\btimezone\x12\x1a\n" +
+	"\bdisabled\x18\x05 \x01(\bR\bdisabled\x12\x1f\n" +
+	"\vtemplate_id\x18\x06 \x01(\tR\n" +
+	"templateId\x12!\n" +
+	"\fname_pattern\x18\a \x01(\tR\vnamePattern\x12\x1c\n" +
+	"\talgorithm\x18\b \x01(\tR\talgorithm\x12\x1d\n" +
+	"\n" +
+	"node_count\x18\t \x01(\x05R\tnodeCount\x12\x1a\n" +
+	"\bduration\x18\n" +
+	" \x01(\x05R\bduration\x12\x1d\n" +
+	"\n" +
+	"target_tps\x18\v \x01(\x05R\ttargetTps\x12L\n" +
+	"\x10consensus_config\x18\f \x01(\v2!.hcp.benchmark.v1.ConsensusConfigR\x0fconsensusConfig\x12p\n" +
+	"\x10consensus_params\x18\r \x03(\v2E.hcp.benchmark.v1.CreateBenchmarkScheduleRequest.ConsensusParamsEntryR\x0fconsensusParams\x12\x1b\n" +
+	"\trun_group\x18\x0e \x01(\tR\brunGroup\x12\x12\n" +
+	"\x04tags\x18\x0f \x03(\tR\x04tags\x12'\n" +
+	"\x0fwarmup_duration\x18\x10 \x01(\x05R\x0ewarmupDuration\x12+\n" +
+	"\x11cooldown_duration\x18\x11 \x01(\x05R\x10cooldownDuration\x1aB\n" +
+	"\x14ConsensusParamsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"b\n" +
+	"\x1fCreateBenchmarkScheduleResponse\x12?\n" +
+	"\bschedule\x18\x01 \x01(\v2#.hcp.benchmark.v1.BenchmarkScheduleR\bschedule\"-\n" +
+	"\x1bGetBenchmarkScheduleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"_\n" +
+	"\x1cGetBenchmarkScheduleResponse\x12?\n" +
+	"\bschedule\x18\x01 \x01(\v2#.hcp.benchmark.v1.BenchmarkScheduleR\bschedule\"a\n" +
+	"\x1dListBenchmarkSchedulesRequest\x12@\n" +
+	"\n" +
+	"pagination\x18\x01 \x01(\v2 .hcp.common.v1.PaginationRequestR\n" +
+	"pagination\"\xa6\x01\n" +
+	"\x1eListBenchmarkSchedulesResponse\x12A\n" +
+	"\tschedules\x18\x01 \x03(\v2#.hcp.benchmark.v1.BenchmarkScheduleR\tschedules\x12A\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2!.hcp.common.v1.PaginationResponseR\n" +
+	"pagination\"\xa7\x02\n" +
+	"\x1eUpdateBenchmarkScheduleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1b\n" +
+	"\tcron_expr\x18\x04 \x01(\tR\bcronExpr\x12\x1a\n" +
+	"\btimezone\x18\x05 \x01(\tR\btimezone\x12\x18\n" +
+	"\aenabled\x18\x06 \x01(\bR\aenabled\x12\x1b\n" +
+	"\trun_group\x18\a \x01(\tR\brunGroup\x12\x12\n" +
+	"\x04tags\x18\b \x03(\tR\x04tags\x12;\n" +
+	"\vupdate_mask\x18\x14 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"b\n" +
+	"\x1fUpdateBenchmarkScheduleResponse\x12?\n" +
+	"\bschedule\x18\x01 \x01(\v2#.hcp.benchmark.v1.BenchmarkScheduleR\bschedule\"0\n" +
+	"\x1eDeleteBenchmarkScheduleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id2\xeb\x16\n" +
 	"\x10BenchmarkService\x12f\n" +
 	"\x0fCreateBenchmark\x12(.hcp.benchmark.v1.CreateBenchmarkRequest\x1a).hcp.benchmark.v1.CreateBenchmarkResponse\x12]\n" +
 	"\fGetBenchmark\x12%.hcp.benchmark.v1.GetBenchmarkRequest\x1a&.hcp.benchmark.v1.GetBenchmarkResponse\x12c\n" +
 	"\x0eListBenchmarks\x12'.hcp.benchmark.v1.ListBenchmarksRequest\x1a(.hcp.benchmark.v1.ListBenchmarksResponse\x12f\n" +
 	"\x0fUpdateBenchmark\x12(.hcp.benchmark.v1.UpdateBenchmarkRequest\x1a).hcp.benchmark.v1.UpdateBenchmarkResponse\x12Z\n" +
-	"\x0fDeleteBenchmark\x12(.hcp.benchmark.v1.DeleteBenchmarkRequest\x1a\x1d.hcp.common.v1.StatusResponseB;Z9github.com/fffeng99999/hcp-server/api/generated/benchmarkb\x06proto3"
+	"\x0fDeleteBenchmark\x12(.hcp.benchmark.v1.DeleteBenchmarkRequest\x1a\x1d.hcp.common.v1.StatusResponse\x12\x84\x01\n" +
+	"\x19RecomputeBenchmarkResults\x122.hcp.benchmark.v1.RecomputeBenchmarkResultsRequest\x1a3.hcp.benchmark.v1.RecomputeBenchmarkResultsResponse\x12l\n" +
+	"\x11CompareBenchmarks\x12*.hcp.benchmark.v1.CompareBenchmarksRequest\x1a+.hcp.benchmark.v1.CompareBenchmarksResponse\x12{\n" +
+	"\x16CompareBenchmarkGroups\x12/.hcp.benchmark.v1.CompareBenchmarkGroupsRequest\x1a0.hcp.benchmark.v1.CompareBenchmarkGroupsResponse\x12c\n" +
+	"\x0eCloneBenchmark\x12'.hcp.benchmark.v1.CloneBenchmarkRequest\x1a(.hcp.benchmark.v1.CloneBenchmarkResponse\x12f\n" +
+	"\x0fCancelBenchmark\x12(.hcp.benchmark.v1.CancelBenchmarkRequest\x1a).hcp.benchmark.v1.CancelBenchmarkResponse\x12l\n" +
+	"\x11GetBenchmarkQueue\x12*.hcp.benchmark.v1.GetBenchmarkQueueRequest\x1a+.hcp.benchmark.v1.GetBenchmarkQueueResponse\x12c\n" +
+	"\x0eGetLeaderboard\x12'.hcp.benchmark.v1.GetLeaderboardRequest\x1a(.hcp.benchmark.v1.GetLeaderboardResponse\x12Q\n" +
+	"\bGetTrend\x12!.hcp.benchmark.v1.GetTrendRequest\x1a\".hcp.benchmark.v1.GetTrendResponse\x12u\n" +
+	"\x14RecordBenchmarkNodes\x12-.hcp.benchmark.v1.RecordBenchmarkNodesRequest\x1a..hcp.benchmark.v1.RecordBenchmarkNodesResponse\x12o\n" +
+	"\x12ListBenchmarkNodes\x12+.hcp.benchmark.v1.ListBenchmarkNodesRequest\x1a,.hcp.benchmark.v1.ListBenchmarkNodesResponse\x12x\n" +
+	"\x15ExportBenchmarkReport\x12..hcp.benchmark.v1.ExportBenchmarkReportRequest\x1a/.hcp.benchmark.v1.ExportBenchmarkReportResponse\x12`\n" +
+	"\x0eWatchBenchmark\x12'.hcp.benchmark.v1.WatchBenchmarkRequest\x1a#.hcp.benchmark.v1.BenchmarkProgress0\x01\x12~\n" +
+	"\x17CreateBenchmarkTemplate\x120.hcp.benchmark.v1.CreateBenchmarkTemplateRequest\x1a1.hcp.benchmark.v1.CreateBenchmarkTemplateResponse\x12u\n" +
+	"\x14GetBenchmarkTemplate\x12-.hcp.benchmark.v1.GetBenchmarkTemplateRequest\x1a..hcp.benchmark.v1.GetBenchmarkTemplateResponse\x12{\n" +
+	"\x16ListBenchmarkTemplates\x12/.hcp.benchmark.v1.ListBenchmarkTemplatesRequest\x1a0.hcp.benchmark.v1.ListBenchmarkTemplatesResponse\x12j\n" +
+	"\x17DeleteBenchmarkTemplate\x120.hcp.benchmark.v1.DeleteBenchmarkTemplateRequest\x1a\x1d.hcp.common.v1.StatusResponse\x12~\n" +
+	"\x17CreateBenchmarkSchedule\x120.hcp.benchmark.v1.CreateBenchmarkScheduleRequest\x1a1.hcp.benchmark.v1.CreateBenchmarkScheduleResponse\x12u\n" +
+	"\x14GetBenchmarkSchedule\x12-.hcp.benchmark.v1.GetBenchmarkScheduleRequest\x1a..hcp.benchmark.v1.GetBenchmarkScheduleResponse\x12{\n" +
+	"\x16ListBenchmarkSchedules\x12/.hcp.benchmark.v1.ListBenchmarkSchedulesRequest\x1a0.hcp.benchmark.v1.ListBenchmarkSchedulesResponse\x12~\n" +
+	"\x17UpdateBenchmarkSchedule\x120.hcp.benchmark.v1.UpdateBenchmarkScheduleRequest\x1a1.hcp.benchmark.v1.UpdateBenchmarkScheduleResponse\x12j\n" +
+	"\x17DeleteBenchmarkSchedule\x120.hcp.benchmark.v1.DeleteBenchmarkScheduleRequest\x1a\x1d.hcp.common.v1.StatusResponseB;Z9github.com/fffeng99999/hcp-server/api/generated/benchmarkb\x06proto3"
 
 var (
 	file_api_proto_benchmark_proto_rawDescOnce sync.Once
@@ -683,44 +6699,225 @@ func file_api_proto_benchmark_proto_rawDescGZIP() []byte {
 	return file_api_proto_benchmark_proto_rawDescData
 }
 
-var file_api_proto_benchmark_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_api_proto_benchmark_proto_msgTypes = make([]protoimpl.MessageInfo, 85)
 var file_api_proto_benchmark_proto_goTypes = []any{
-	(*Benchmark)(nil),                 // 0: hcp.benchmark.v1.Benchmark
-	(*CreateBenchmarkRequest)(nil),    // 1: hcp.benchmark.v1.CreateBenchmarkRequest
-	(*CreateBenchmarkResponse)(nil),   // 2: hcp.benchmark.v1.CreateBenchmarkResponse
-	(*GetBenchmarkRequest)(nil),       // 3: hcp.benchmark.v1.GetBenchmarkRequest
-	(*GetBenchmarkResponse)(nil),      // 4: hcp.benchmark.v1.GetBenchmarkResponse
-	(*ListBenchmarksRequest)(nil),     // 5: hcp.benchmark.v1.ListBenchmarksRequest
-	(*ListBenchmarksResponse)(nil),    // 6: hcp.benchmark.v1.ListBenchmarksResponse
-	(*UpdateBenchmarkRequest)(nil),    // 7: hcp.benchmark.v1.UpdateBenchmarkRequest
-	(*UpdateBenchmarkResponse)(nil),   // 8: hcp.benchmark.v1.UpdateBenchmarkResponse
-	(*DeleteBenchmarkRequest)(nil),    // 9: hcp.benchmark.v1.DeleteBenchmarkRequest
-	(*common.PaginationRequest)(nil),  // 10: hcp.common.v1.PaginationRequest
-	(*common.PaginationResponse)(nil), // 11: hcp.common.v1.PaginationResponse
-	(*common.StatusResponse)(nil),     // 12: hcp.common.v1.StatusResponse
+	(*Benchmark)(nil),                         // 0: hcp.benchmark.v1.Benchmark
+	(*AssertionResult)(nil),                   // 1: hcp.benchmark.v1.AssertionResult
+	(*ConsensusConfig)(nil),                   // 2: hcp.benchmark.v1.ConsensusConfig
+	(*NetworkEmulation)(nil),                  // 3: hcp.benchmark.v1.NetworkEmulation
+	(*Environment)(nil),                       // 4: hcp.benchmark.v1.Environment
+	(*BenchmarkResults)(nil),                  // 5: hcp.benchmark.v1.BenchmarkResults
+	(*LatencyStats)(nil),                      // 6: hcp.benchmark.v1.LatencyStats
+	(*TransactionCounts)(nil),                 // 7: hcp.benchmark.v1.TransactionCounts
+	(*BlockStats)(nil),                        // 8: hcp.benchmark.v1.BlockStats
+	(*ResourceUsage)(nil),                     // 9: hcp.benchmark.v1.ResourceUsage
+	(*ConsensusStats)(nil),                    // 10: hcp.benchmark.v1.ConsensusStats
+	(*CreateBenchmarkRequest)(nil),            // 11: hcp.benchmark.v1.CreateBenchmarkRequest
+	(*CreateBenchmarkResponse)(nil),           // 12: hcp.benchmark.v1.CreateBenchmarkResponse
+	(*GetBenchmarkRequest)(nil),               // 13: hcp.benchmark.v1.GetBenchmarkRequest
+	(*GetBenchmarkResponse)(nil),              // 14: hcp.benchmark.v1.GetBenchmarkResponse
+	(*ListBenchmarksRequest)(nil),             // 15: hcp.benchmark.v1.ListBenchmarksRequest
+	(*ListBenchmarksResponse)(nil),            // 16: hcp.benchmark.v1.ListBenchmarksResponse
+	(*UpdateBenchmarkRequest)(nil),            // 17: hcp.benchmark.v1.UpdateBenchmarkRequest
+	(*UpdateBenchmarkResponse)(nil),           // 18: hcp.benchmark.v1.UpdateBenchmarkResponse
+	(*DeleteBenchmarkRequest)(nil),            // 19: hcp.benchmark.v1.DeleteBenchmarkRequest
+	(*RecomputeBenchmarkResultsRequest)(nil),  // 20: hcp.benchmark.v1.RecomputeBenchmarkResultsRequest
+	(*RecomputeBenchmarkResultsResponse)(nil), // 21: hcp.benchmark.v1.RecomputeBenchmarkResultsResponse
+	(*CompareBenchmarksRequest)(nil),          // 22: hcp.benchmark.v1.CompareBenchmarksRequest
+	(*CompareBenchmarksResponse)(nil),         // 23: hcp.benchmark.v1.CompareBenchmarksResponse
+	(*MetricComparison)(nil),                  // 24: hcp.benchmark.v1.MetricComparison
+	(*ComparedValue)(nil),                     // 25: hcp.benchmark.v1.ComparedValue
+	(*BenchmarkGroup)(nil),                    // 26: hcp.benchmark.v1.BenchmarkGroup
+	(*CompareBenchmarkGroupsRequest)(nil),     // 27: hcp.benchmark.v1.CompareBenchmarkGroupsRequest
+	(*ConfidenceInterval)(nil),                // 28: hcp.benchmark.v1.ConfidenceInterval
+	(*GroupStatistics)(nil),                   // 29: hcp.benchmark.v1.GroupStatistics
+	(*MannWhitneyResult)(nil),                 // 30: hcp.benchmark.v1.MannWhitneyResult
+	(*CompareBenchmarkGroupsResponse)(nil),    // 31: hcp.benchmark.v1.CompareBenchmarkGroupsResponse
+	(*CloneBenchmarkRequest)(nil),             // 32: hcp.benchmark.v1.CloneBenchmarkRequest
+	(*CloneBenchmarkResponse)(nil),            // 33: hcp.benchmark.v1.CloneBenchmarkResponse
+	(*CancelBenchmarkRequest)(nil),            // 34: hcp.benchmark.v1.CancelBenchmarkRequest
+	(*CancelBenchmarkResponse)(nil),           // 35: hcp.benchmark.v1.CancelBenchmarkResponse
+	(*GetBenchmarkQueueRequest)(nil),          // 36: hcp.benchmark.v1.GetBenchmarkQueueRequest
+	(*GetBenchmarkQueueResponse)(nil),         // 37: hcp.benchmark.v1.GetBenchmarkQueueResponse
+	(*ClusterQueue)(nil),                      // 38: hcp.benchmark.v1.ClusterQueue
+	(*GetLeaderboardRequest)(nil),             // 39: hcp.benchmark.v1.GetLeaderboardRequest
+	(*GetLeaderboardResponse)(nil),            // 40: hcp.benchmark.v1.GetLeaderboardResponse
+	(*LeaderboardBucket)(nil),                 // 41: hcp.benchmark.v1.LeaderboardBucket
+	(*LeaderboardEntry)(nil),                  // 42: hcp.benchmark.v1.LeaderboardEntry
+	(*GetTrendRequest)(nil),                   // 43: hcp.benchmark.v1.GetTrendRequest
+	(*GetTrendResponse)(nil),                  // 44: hcp.benchmark.v1.GetTrendResponse
+	(*TrendPoint)(nil),                        // 45: hcp.benchmark.v1.TrendPoint
+	(*BenchmarkTemplate)(nil),                 // 46: hcp.benchmark.v1.BenchmarkTemplate
+	(*CreateBenchmarkTemplateRequest)(nil),    // 47: hcp.benchmark.v1.CreateBenchmarkTemplateRequest
+	(*CreateBenchmarkTemplateResponse)(nil),   // 48: hcp.benchmark.v1.CreateBenchmarkTemplateResponse
+	(*GetBenchmarkTemplateRequest)(nil),       // 49: hcp.benchmark.v1.GetBenchmarkTemplateRequest
+	(*GetBenchmarkTemplateResponse)(nil),      // 50: hcp.benchmark.v1.GetBenchmarkTemplateResponse
+	(*ListBenchmarkTemplatesRequest)(nil),     // 51: hcp.benchmark.v1.ListBenchmarkTemplatesRequest
+	(*ListBenchmarkTemplatesResponse)(nil),    // 52: hcp.benchmark.v1.ListBenchmarkTemplatesResponse
+	(*DeleteBenchmarkTemplateRequest)(nil),    // 53: hcp.benchmark.v1.DeleteBenchmarkTemplateRequest
+	(*BenchmarkNode)(nil),                     // 54: hcp.benchmark.v1.BenchmarkNode
+	(*BenchmarkNodeReport)(nil),               // 55: hcp.benchmark.v1.BenchmarkNodeReport
+	(*RecordBenchmarkNodesRequest)(nil),       // 56: hcp.benchmark.v1.RecordBenchmarkNodesRequest
+	(*RecordBenchmarkNodesResponse)(nil),      // 57: hcp.benchmark.v1.RecordBenchmarkNodesResponse
+	(*ListBenchmarkNodesRequest)(nil),         // 58: hcp.benchmark.v1.ListBenchmarkNodesRequest
+	(*ListBenchmarkNodesResponse)(nil),        // 59: hcp.benchmark.v1.ListBenchmarkNodesResponse
+	(*ExportBenchmarkReportRequest)(nil),      // 60: hcp.benchmark.v1.ExportBenchmarkReportRequest
+	(*ExportBenchmarkReportResponse)(nil),     // 61: hcp.benchmark.v1.ExportBenchmarkReportResponse
+	(*WatchBenchmarkRequest)(nil),             // 62: hcp.benchmark.v1.WatchBenchmarkRequest
+	(*BenchmarkProgress)(nil),                 // 63: hcp.benchmark.v1.BenchmarkProgress
+	(*NodeHealth)(nil),                        // 64: hcp.benchmark.v1.NodeHealth
+	(*StatusTransition)(nil),                  // 65: hcp.benchmark.v1.StatusTransition
+	(*BenchmarkSchedule)(nil),                 // 66: hcp.benchmark.v1.BenchmarkSchedule
+	(*CreateBenchmarkScheduleRequest)(nil),    // 67: hcp.benchmark.v1.CreateBenchmarkScheduleRequest
+	(*CreateBenchmarkScheduleResponse)(nil),   // 68: hcp.benchmark.v1.CreateBenchmarkScheduleResponse
+	(*GetBenchmarkScheduleRequest)(nil),       // 69: hcp.benchmark.v1.GetBenchmarkScheduleRequest
+	(*GetBenchmarkScheduleResponse)(nil),      // 70: hcp.benchmark.v1.GetBenchmarkScheduleResponse
+	(*ListBenchmarkSchedulesRequest)(nil),     // 71: hcp.benchmark.v1.ListBenchmarkSchedulesRequest
+	(*ListBenchmarkSchedulesResponse)(nil),    // 72: hcp.benchmark.v1.ListBenchmarkSchedulesResponse
+	(*UpdateBenchmarkScheduleRequest)(nil),    // 73: hcp.benchmark.v1.UpdateBenchmarkScheduleRequest
+	(*UpdateBenchmarkScheduleResponse)(nil),   // 74: hcp.benchmark.v1.UpdateBenchmarkScheduleResponse
+	(*DeleteBenchmarkScheduleRequest)(nil),    // 75: hcp.benchmark.v1.DeleteBenchmarkScheduleRequest
+	nil,                                       // 76: hcp.benchmark.v1.Benchmark.ConsensusParamsEntry
+	nil,                                       // 77: hcp.benchmark.v1.Environment.ExtraEntry
+	nil,                                       // 78: hcp.benchmark.v1.CreateBenchmarkRequest.ConsensusParamsEntry
+	nil,                                       // 79: hcp.benchmark.v1.ListBenchmarksRequest.ParametersEntry
+	nil,                                       // 80: hcp.benchmark.v1.BenchmarkTemplate.ConsensusParamsEntry
+	nil,                                       // 81: hcp.benchmark.v1.CreateBenchmarkTemplateRequest.ConsensusParamsEntry
+	nil,                                       // 82: hcp.benchmark.v1.NodeHealth.MetricsEntry
+	nil,                                       // 83: hcp.benchmark.v1.BenchmarkSchedule.ConsensusParamsEntry
+	nil,                                       // 84: hcp.benchmark.v1.CreateBenchmarkScheduleRequest.ConsensusParamsEntry
+	(*common.PaginationRequest)(nil),          // 85: hcp.common.v1.PaginationRequest
+	(*common.PaginationResponse)(nil),         // 86: hcp.common.v1.PaginationResponse
+	(*fieldmaskpb.FieldMask)(nil),             // 87: google.protobuf.FieldMask
+	(*common.StatusResponse)(nil),             // 88: hcp.common.v1.StatusResponse
 }
 var file_api_proto_benchmark_proto_depIdxs = []int32{
-	0,  // 0: hcp.benchmark.v1.CreateBenchmarkResponse.benchmark:type_name -> hcp.benchmark.v1.Benchmark
-	0,  // 1: hcp.benchmark.v1.GetBenchmarkResponse.benchmark:type_name -> hcp.benchmark.v1.Benchmark
-	10, // 2: hcp.benchmark.v1.ListBenchmarksRequest.pagination:type_name -> hcp.common.v1.PaginationRequest
-	0,  // 3: hcp.benchmark.v1.ListBenchmarksResponse.benchmarks:type_name -> hcp.benchmark.v1.Benchmark
-	11, // 4: hcp.benchmark.v1.ListBenchmarksResponse.pagination:type_name -> hcp.common.v1.PaginationResponse
-	0,  // 5: hcp.benchmark.v1.UpdateBenchmarkResponse.benchmark:type_name -> hcp.benchmark.v1.Benchmark
-	1,  // 6: hcp.benchmark.v1.BenchmarkService.CreateBenchmark:input_type -> hcp.benchmark.v1.CreateBenchmarkRequest
-	3,  // 7: hcp.benchmark.v1.BenchmarkService.GetBenchmark:input_type -> hcp.benchmark.v1.GetBenchmarkRequest
-	5,  // 8: hcp.benchmark.v1.BenchmarkService.ListBenchmarks:input_type -> hcp.benchmark.v1.ListBenchmarksRequest
-	7,  // 9: hcp.benchmark.v1.BenchmarkService.UpdateBenchmark:input_type -> hcp.benchmark.v1.UpdateBenchmarkRequest
-	9,  // 10: hcp.benchmark.v1.BenchmarkService.DeleteBenchmark:input_type -> hcp.benchmark.v1.DeleteBenchmarkRequest
-	2,  // 11: hcp.benchmark.v1.BenchmarkService.CreateBenchmark:output_type -> hcp.benchmark.v1.CreateBenchmarkResponse
-	4,  // 12: hcp.benchmark.v1.BenchmarkService.GetBenchmark:output_type -> hcp.benchmark.v1.GetBenchmarkResponse
-	6,  // 13: hcp.benchmark.v1.BenchmarkService.ListBenchmarks:output_type -> hcp.benchmark.v1.ListBenchmarksResponse
-	8,  // 14: hcp.benchmark.v1.BenchmarkService.UpdateBenchmark:output_type -> hcp.benchmark.v1.UpdateBenchmarkResponse
-	12, // 15: hcp.benchmark.v1.BenchmarkService.DeleteBenchmark:output_type -> hcp.common.v1.StatusResponse
-	11, // [11:16] is the sub-list for method output_type
-	6,  // [6:11] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	5,  // 0: hcp.benchmark.v1.Benchmark.results:type_name -> hcp.benchmark.v1.BenchmarkResults
+	76, // 1: hcp.benchmark.v1.Benchmark.consensus_params:type_name -> hcp.benchmark.v1.Benchmark.ConsensusParamsEntry
+	2,  // 2: hcp.benchmark.v1.Benchmark.consensus_config:type_name -> hcp.benchmark.v1.ConsensusConfig
+	4,  // 3: hcp.benchmark.v1.Benchmark.environment:type_name -> hcp.benchmark.v1.Environment
+	54, // 4: hcp.benchmark.v1.Benchmark.nodes:type_name -> hcp.benchmark.v1.BenchmarkNode
+	1,  // 5: hcp.benchmark.v1.Benchmark.assertion_results:type_name -> hcp.benchmark.v1.AssertionResult
+	3,  // 6: hcp.benchmark.v1.ConsensusConfig.network:type_name -> hcp.benchmark.v1.NetworkEmulation
+	77, // 7: hcp.benchmark.v1.Environment.extra:type_name -> hcp.benchmark.v1.Environment.ExtraEntry
+	6,  // 8: hcp.benchmark.v1.BenchmarkResults.latency:type_name -> hcp.benchmark.v1.LatencyStats
+	7,  // 9: hcp.benchmark.v1.BenchmarkResults.transactions:type_name -> hcp.benchmark.v1.TransactionCounts
+	8,  // 10: hcp.benchmark.v1.BenchmarkResults.blocks:type_name -> hcp.benchmark.v1.BlockStats
+	9,  // 11: hcp.benchmark.v1.BenchmarkResults.resources:type_name -> hcp.benchmark.v1.ResourceUsage
+	10, // 12: hcp.benchmark.v1.BenchmarkResults.consensus:type_name -> hcp.benchmark.v1.ConsensusStats
+	78, // 13: hcp.benchmark.v1.CreateBenchmarkRequest.consensus_params:type_name -> hcp.benchmark.v1.CreateBenchmarkRequest.ConsensusParamsEntry
+	2,  // 14: hcp.benchmark.v1.CreateBenchmarkRequest.consensus_config:type_name -> hcp.benchmark.v1.ConsensusConfig
+	4,  // 15: hcp.benchmark.v1.CreateBenchmarkRequest.environment:type_name -> hcp.benchmark.v1.Environment
+	0,  // 16: hcp.benchmark.v1.CreateBenchmarkResponse.benchmark:type_name -> hcp.benchmark.v1.Benchmark
+	0,  // 17: hcp.benchmark.v1.GetBenchmarkResponse.benchmark:type_name -> hcp.benchmark.v1.Benchmark
+	85, // 18: hcp.benchmark.v1.ListBenchmarksRequest.pagination:type_name -> hcp.common.v1.PaginationRequest
+	79, // 19: hcp.benchmark.v1.ListBenchmarksRequest.parameters:type_name -> hcp.benchmark.v1.ListBenchmarksRequest.ParametersEntry
+	0,  // 20: hcp.benchmark.v1.ListBenchmarksResponse.benchmarks:type_name -> hcp.benchmark.v1.Benchmark
+	86, // 21: hcp.benchmark.v1.ListBenchmarksResponse.pagination:type_name -> hcp.common.v1.PaginationResponse
+	4,  // 22: hcp.benchmark.v1.UpdateBenchmarkRequest.environment:type_name -> hcp.benchmark.v1.Environment
+	87, // 23: hcp.benchmark.v1.UpdateBenchmarkRequest.update_mask:type_name -> google.protobuf.FieldMask
+	0,  // 24: hcp.benchmark.v1.UpdateBenchmarkResponse.benchmark:type_name -> hcp.benchmark.v1.Benchmark
+	0,  // 25: hcp.benchmark.v1.RecomputeBenchmarkResultsResponse.benchmark:type_name -> hcp.benchmark.v1.Benchmark
+	0,  // 26: hcp.benchmark.v1.CompareBenchmarksResponse.benchmarks:type_name -> hcp.benchmark.v1.Benchmark
+	24, // 27: hcp.benchmark.v1.CompareBenchmarksResponse.metrics:type_name -> hcp.benchmark.v1.MetricComparison
+	25, // 28: hcp.benchmark.v1.MetricComparison.values:type_name -> hcp.benchmark.v1.ComparedValue
+	26, // 29: hcp.benchmark.v1.CompareBenchmarkGroupsRequest.baseline:type_name -> hcp.benchmark.v1.BenchmarkGroup
+	26, // 30: hcp.benchmark.v1.CompareBenchmarkGroupsRequest.candidate:type_name -> hcp.benchmark.v1.BenchmarkGroup
+	28, // 31: hcp.benchmark.v1.GroupStatistics.mean_ci:type_name -> hcp.benchmark.v1.ConfidenceInterval
+	28, // 32: hcp.benchmark.v1.GroupStatistics.p99_ci:type_name -> hcp.benchmark.v1.ConfidenceInterval
+	29, // 33: hcp.benchmark.v1.CompareBenchmarkGroupsResponse.baseline:type_name -> hcp.benchmark.v1.GroupStatistics
+	29, // 34: hcp.benchmark.v1.CompareBenchmarkGroupsResponse.candidate:type_name -> hcp.benchmark.v1.GroupStatistics
+	30, // 35: hcp.benchmark.v1.CompareBenchmarkGroupsResponse.mann_whitney:type_name -> hcp.benchmark.v1.MannWhitneyResult
+	0,  // 36: hcp.benchmark.v1.CloneBenchmarkResponse.benchmark:type_name -> hcp.benchmark.v1.Benchmark
+	0,  // 37: hcp.benchmark.v1.CancelBenchmarkResponse.benchmark:type_name -> hcp.benchmark.v1.Benchmark
+	0,  // 38: hcp.benchmark.v1.GetBenchmarkQueueResponse.benchmarks:type_name -> hcp.benchmark.v1.Benchmark
+	38, // 39: hcp.benchmark.v1.GetBenchmarkQueueResponse.clusters:type_name -> hcp.benchmark.v1.ClusterQueue
+	41, // 40: hcp.benchmark.v1.GetLeaderboardResponse.buckets:type_name -> hcp.benchmark.v1.LeaderboardBucket
+	42, // 41: hcp.benchmark.v1.LeaderboardBucket.entries:type_name -> hcp.benchmark.v1.LeaderboardEntry
+	45, // 42: hcp.benchmark.v1.GetTrendResponse.points:type_name -> hcp.benchmark.v1.TrendPoint
+	80, // 43: hcp.benchmark.v1.BenchmarkTemplate.consensus_params:type_name -> hcp.benchmark.v1.BenchmarkTemplate.ConsensusParamsEntry
+	2,  // 44: hcp.benchmark.v1.BenchmarkTemplate.consensus_config:type_name -> hcp.benchmark.v1.ConsensusConfig
+	81, // 45: hcp.benchmark.v1.CreateBenchmarkTemplateRequest.consensus_params:type_name -> hcp.benchmark.v1.CreateBenchmarkTemplateRequest.ConsensusParamsEntry
+	2,  // 46: hcp.benchmark.v1.CreateBenchmarkTemplateRequest.consensus_config:type_name -> hcp.benchmark.v1.ConsensusConfig
+	46, // 47: hcp.benchmark.v1.CreateBenchmarkTemplateResponse.template:type_name -> hcp.benchmark.v1.BenchmarkTemplate
+	46, // 48: hcp.benchmark.v1.GetBenchmarkTemplateResponse.template:type_name -> hcp.benchmark.v1.BenchmarkTemplate
+	85, // 49: hcp.benchmark.v1.ListBenchmarkTemplatesRequest.pagination:type_name -> hcp.common.v1.PaginationRequest
+	46, // 50: hcp.benchmark.v1.ListBenchmarkTemplatesResponse.templates:type_name -> hcp.benchmark.v1.BenchmarkTemplate
+	86, // 51: hcp.benchmark.v1.ListBenchmarkTemplatesResponse.pagination:type_name -> hcp.common.v1.PaginationResponse
+	55, // 52: hcp.benchmark.v1.RecordBenchmarkNodesRequest.nodes:type_name -> hcp.benchmark.v1.BenchmarkNodeReport
+	54, // 53: hcp.benchmark.v1.RecordBenchmarkNodesResponse.nodes:type_name -> hcp.benchmark.v1.BenchmarkNode
+	54, // 54: hcp.benchmark.v1.ListBenchmarkNodesResponse.nodes:type_name -> hcp.benchmark.v1.BenchmarkNode
+	64, // 55: hcp.benchmark.v1.BenchmarkProgress.nodes:type_name -> hcp.benchmark.v1.NodeHealth
+	65, // 56: hcp.benchmark.v1.BenchmarkProgress.transitions:type_name -> hcp.benchmark.v1.StatusTransition
+	82, // 57: hcp.benchmark.v1.NodeHealth.metrics:type_name -> hcp.benchmark.v1.NodeHealth.MetricsEntry
+	2,  // 58: hcp.benchmark.v1.BenchmarkSchedule.consensus_config:type_name -> hcp.benchmark.v1.ConsensusConfig
+	83, // 59: hcp.benchmark.v1.BenchmarkSchedule.consensus_params:type_name -> hcp.benchmark.v1.BenchmarkSchedule.ConsensusParamsEntry
+	2,  // 60: hcp.benchmark.v1.CreateBenchmarkScheduleRequest.consensus_config:type_name -> hcp.benchmark.v1.ConsensusConfig
+	84, // 61: hcp.benchmark.v1.CreateBenchmarkScheduleRequest.consensus_params:type_name -> hcp.benchmark.v1.CreateBenchmarkScheduleRequest.ConsensusParamsEntry
+	66, // 62: hcp.benchmark.v1.CreateBenchmarkScheduleResponse.schedule:type_name -> hcp.benchmark.v1.BenchmarkSchedule
+	66, // 63: hcp.benchmark.v1.GetBenchmarkScheduleResponse.schedule:type_name -> hcp.benchmark.v1.BenchmarkSchedule
+	85, // 64: hcp.benchmark.v1.ListBenchmarkSchedulesRequest.pagination:type_name -> hcp.common.v1.PaginationRequest
+	66, // 65: hcp.benchmark.v1.ListBenchmarkSchedulesResponse.schedules:type_name -> hcp.benchmark.v1.BenchmarkSchedule
+	86, // 66: hcp.benchmark.v1.ListBenchmarkSchedulesResponse.pagination:type_name -> hcp.common.v1.PaginationResponse
+	87, // 67: hcp.benchmark.v1.UpdateBenchmarkScheduleRequest.update_mask:type_name -> google.protobuf.FieldMask
+	66, // 68: hcp.benchmark.v1.UpdateBenchmarkScheduleResponse.schedule:type_name -> hcp.benchmark.v1.BenchmarkSchedule
+	11, // 69: hcp.benchmark.v1.BenchmarkService.CreateBenchmark:input_type -> hcp.benchmark.v1.CreateBenchmarkRequest
+	13, // 70: hcp.benchmark.v1.BenchmarkService.GetBenchmark:input_type -> hcp.benchmark.v1.GetBenchmarkRequest
+	15, // 71: hcp.benchmark.v1.BenchmarkService.ListBenchmarks:input_type -> hcp.benchmark.v1.ListBenchmarksRequest
+	17, // 72: hcp.benchmark.v1.BenchmarkService.UpdateBenchmark:input_type -> hcp.benchmark.v1.UpdateBenchmarkRequest
+	19, // 73: hcp.benchmark.v1.BenchmarkService.DeleteBenchmark:input_type -> hcp.benchmark.v1.DeleteBenchmarkRequest
+	20, // 74: hcp.benchmark.v1.BenchmarkService.RecomputeBenchmarkResults:input_type -> hcp.benchmark.v1.RecomputeBenchmarkResultsRequest
+	22, // 75: hcp.benchmark.v1.BenchmarkService.CompareBenchmarks:input_type -> hcp.benchmark.v1.CompareBenchmarksRequest
+	27, // 76: hcp.benchmark.v1.BenchmarkService.CompareBenchmarkGroups:input_type -> hcp.benchmark.v1.CompareBenchmarkGroupsRequest
+	32, // 77: hcp.benchmark.v1.BenchmarkService.CloneBenchmark:input_type -> hcp.benchmark.v1.CloneBenchmarkRequest
+	34, // 78: hcp.benchmark.v1.BenchmarkService.CancelBenchmark:input_type -> hcp.benchmark.v1.CancelBenchmarkRequest
+	36, // 79: hcp.benchmark.v1.BenchmarkService.GetBenchmarkQueue:input_type -> hcp.benchmark.v1.GetBenchmarkQueueRequest
+	39, // 80: hcp.benchmark.v1.BenchmarkService.GetLeaderboard:input_type -> hcp.benchmark.v1.GetLeaderboardRequest
+	43, // 81: hcp.benchmark.v1.BenchmarkService.GetTrend:input_type -> hcp.benchmark.v1.GetTrendRequest
+	56, // 82: hcp.benchmark.v1.BenchmarkService.RecordBenchmarkNodes:input_type -> hcp.benchmark.v1.RecordBenchmarkNodesRequest
+	58, // 83: hcp.benchmark.v1.BenchmarkService.ListBenchmarkNodes:input_type -> hcp.benchmark.v1.ListBenchmarkNodesRequest
+	60, // 84: hcp.benchmark.v1.BenchmarkService.ExportBenchmarkReport:input_type -> hcp.benchmark.v1.ExportBenchmarkReportRequest
+	62, // 85: hcp.benchmark.v1.BenchmarkService.WatchBenchmark:input_type -> hcp.benchmark.v1.WatchBenchmarkRequest
+	47, // 86: hcp.benchmark.v1.BenchmarkService.CreateBenchmarkTemplate:input_type -> hcp.benchmark.v1.CreateBenchmarkTemplateRequest
+	49, // 87: hcp.benchmark.v1.BenchmarkService.GetBenchmarkTemplate:input_type -> hcp.benchmark.v1.GetBenchmarkTemplateRequest
+	51, // 88: hcp.benchmark.v1.BenchmarkService.ListBenchmarkTemplates:input_type -> hcp.benchmark.v1.ListBenchmarkTemplatesRequest
+	53, // 89: hcp.benchmark.v1.BenchmarkService.DeleteBenchmarkTemplate:input_type -> hcp.benchmark.v1.DeleteBenchmarkTemplateRequest
+	67, // 90: hcp.benchmark.v1.BenchmarkService.CreateBenchmarkSchedule:input_type -> hcp.benchmark.v1.CreateBenchmarkScheduleRequest
+	69, // 91: hcp.benchmark.v1.BenchmarkService.GetBenchmarkSchedule:input_type -> hcp.benchmark.v1.GetBenchmarkScheduleRequest
+	71, // 92: hcp.benchmark.v1.BenchmarkService.ListBenchmarkSchedules:input_type -> hcp.benchmark.v1.ListBenchmarkSchedulesRequest
+	73, // 93: hcp.benchmark.v1.BenchmarkService.UpdateBenchmarkSchedule:input_type -> hcp.benchmark.v1.UpdateBenchmarkScheduleRequest
+	75, // 94: hcp.benchmark.v1.BenchmarkService.DeleteBenchmarkSchedule:input_type -> hcp.benchmark.v1.DeleteBenchmarkScheduleRequest
+	12, // 95: hcp.benchmark.v1.BenchmarkService.CreateBenchmark:output_type -> hcp.benchmark.v1.CreateBenchmarkResponse
+	14, // 96: hcp.benchmark.v1.BenchmarkService.GetBenchmark:output_type -> hcp.benchmark.v1.GetBenchmarkResponse
+	16, // 97: hcp.benchmark.v1.BenchmarkService.ListBenchmarks:output_type -> hcp.benchmark.v1.ListBenchmarksResponse
+	18, // 98: hcp.benchmark.v1.BenchmarkService.UpdateBenchmark:output_type -> hcp.benchmark.v1.UpdateBenchmarkResponse
+	88, // 99: hcp.benchmark.v1.BenchmarkService.DeleteBenchmark:output_type -> hcp.common.v1.StatusResponse
+	21, // 100: hcp.benchmark.v1.BenchmarkService.RecomputeBenchmarkResults:output_type -> hcp.benchmark.v1.RecomputeBenchmarkResultsResponse
+	23, // 101: hcp.benchmark.v1.BenchmarkService.CompareBenchmarks:output_type -> hcp.benchmark.v1.CompareBenchmarksResponse
+	31, // 102: hcp.benchmark.v1.BenchmarkService.CompareBenchmarkGroups:output_type -> hcp.benchmark.v1.CompareBenchmarkGroupsResponse
+	33, // 103: hcp.benchmark.v1.BenchmarkService.CloneBenchmark:output_type -> hcp.benchmark.v1.CloneBenchmarkResponse
+	35, // 104: hcp.benchmark.v1.BenchmarkService.CancelBenchmark:output_type -> hcp.benchmark.v1.CancelBenchmarkResponse
+	37, // 105: hcp.benchmark.v1.BenchmarkService.GetBenchmarkQueue:output_type -> hcp.benchmark.v1.GetBenchmarkQueueResponse
+	40, // 106: hcp.benchmark.v1.BenchmarkService.GetLeaderboard:output_type -> hcp.benchmark.v1.GetLeaderboardResponse
+	44, // 107: hcp.benchmark.v1.BenchmarkService.GetTrend:output_type -> hcp.benchmark.v1.GetTrendResponse
+	57, // 108: hcp.benchmark.v1.BenchmarkService.RecordBenchmarkNodes:output_type -> hcp.benchmark.v1.RecordBenchmarkNodesResponse
+	59, // 109: hcp.benchmark.v1.BenchmarkService.ListBenchmarkNodes:output_type -> hcp.benchmark.v1.ListBenchmarkNodesResponse
+	61, // 110: hcp.benchmark.v1.BenchmarkService.ExportBenchmarkReport:output_type -> hcp.benchmark.v1.ExportBenchmarkReportResponse
+	63, // 111: hcp.benchmark.v1.BenchmarkService.WatchBenchmark:output_type -> hcp.benchmark.v1.BenchmarkProgress
+	48, // 112: hcp.benchmark.v1.BenchmarkService.CreateBenchmarkTemplate:output_type -> hcp.benchmark.v1.CreateBenchmarkTemplateResponse
+	50, // 113: hcp.benchmark.v1.BenchmarkService.GetBenchmarkTemplate:output_type -> hcp.benchmark.v1.GetBenchmarkTemplateResponse
+	52, // 114: hcp.benchmark.v1.BenchmarkService.ListBenchmarkTemplates:output_type -> hcp.benchmark.v1.ListBenchmarkTemplatesResponse
+	88, // 115: hcp.benchmark.v1.BenchmarkService.DeleteBenchmarkTemplate:output_type -> hcp.common.v1.StatusResponse
+	68, // 116: hcp.benchmark.v1.BenchmarkService.CreateBenchmarkSchedule:output_type -> hcp.benchmark.v1.CreateBenchmarkScheduleResponse
+	70, // 117: hcp.benchmark.v1.BenchmarkService.GetBenchmarkSchedule:output_type -> hcp.benchmark.v1.GetBenchmarkScheduleResponse
+	72, // 118: hcp.benchmark.v1.BenchmarkService.ListBenchmarkSchedules:output_type -> hcp.benchmark.v1.ListBenchmarkSchedulesResponse
+	74, // 119: hcp.benchmark.v1.BenchmarkService.UpdateBenchmarkSchedule:output_type -> hcp.benchmark.v1.UpdateBenchmarkScheduleResponse
+	88, // 120: hcp.benchmark.v1.BenchmarkService.DeleteBenchmarkSchedule:output_type -> hcp.common.v1.StatusResponse
+	95, // [95:121] is the sub-list for method output_type
+	69, // [69:95] is the sub-list for method input_type
+	69, // [69:69] is the sub-list for extension type_name
+	69, // [69:69] is the sub-list for extension extendee
+	0,  // [0:69] is the sub-list for field type_name
 }
 
 func init() { file_api_proto_benchmark_proto_init() }
@@ -734,7 +6931,7 @@ func file_api_proto_benchmark_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_benchmark_proto_rawDesc), len(file_api_proto_benchmark_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   85,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
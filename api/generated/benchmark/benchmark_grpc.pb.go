@@ -20,11 +20,32 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	BenchmarkService_CreateBenchmark_FullMethodName = "/hcp.benchmark.v1.BenchmarkService/CreateBenchmark"
-	BenchmarkService_GetBenchmark_FullMethodName    = "/hcp.benchmark.v1.BenchmarkService/GetBenchmark"
-	BenchmarkService_ListBenchmarks_FullMethodName  = "/hcp.benchmark.v1.BenchmarkService/ListBenchmarks"
-	BenchmarkService_UpdateBenchmark_FullMethodName = "/hcp.benchmark.v1.BenchmarkService/UpdateBenchmark"
-	BenchmarkService_DeleteBenchmark_FullMethodName = "/hcp.benchmark.v1.BenchmarkService/DeleteBenchmark"
+	BenchmarkService_CreateBenchmark_FullMethodName           = "/hcp.benchmark.v1.BenchmarkService/CreateBenchmark"
+	BenchmarkService_GetBenchmark_FullMethodName              = "/hcp.benchmark.v1.BenchmarkService/GetBenchmark"
+	BenchmarkService_ListBenchmarks_FullMethodName            = "/hcp.benchmark.v1.BenchmarkService/ListBenchmarks"
+	BenchmarkService_UpdateBenchmark_FullMethodName           = "/hcp.benchmark.v1.BenchmarkService/UpdateBenchmark"
+	BenchmarkService_DeleteBenchmark_FullMethodName           = "/hcp.benchmark.v1.BenchmarkService/DeleteBenchmark"
+	BenchmarkService_RecomputeBenchmarkResults_FullMethodName = "/hcp.benchmark.v1.BenchmarkService/RecomputeBenchmarkResults"
+	BenchmarkService_CompareBenchmarks_FullMethodName         = "/hcp.benchmark.v1.BenchmarkService/CompareBenchmarks"
+	BenchmarkService_CompareBenchmarkGroups_FullMethodName    = "/hcp.benchmark.v1.BenchmarkService/CompareBenchmarkGroups"
+	BenchmarkService_CloneBenchmark_FullMethodName            = "/hcp.benchmark.v1.BenchmarkService/CloneBenchmark"
+	BenchmarkService_CancelBenchmark_FullMethodName           = "/hcp.benchmark.v1.BenchmarkService/CancelBenchmark"
+	BenchmarkService_GetBenchmarkQueue_FullMethodName         = "/hcp.benchmark.v1.BenchmarkService/GetBenchmarkQueue"
+	BenchmarkService_GetLeaderboard_FullMethodName            = "/hcp.benchmark.v1.BenchmarkService/GetLeaderboard"
+	BenchmarkService_GetTrend_FullMethodName                  = "/hcp.benchmark.v1.BenchmarkService/GetTrend"
+	BenchmarkService_RecordBenchmarkNodes_FullMethodName      = "/hcp.benchmark.v1.BenchmarkService/RecordBenchmarkNodes"
+	BenchmarkService_ListBenchmarkNodes_FullMethodName        = "/hcp.benchmark.v1.BenchmarkService/ListBenchmarkNodes"
+	BenchmarkService_ExportBenchmarkReport_FullMethodName     = "/hcp.benchmark.v1.BenchmarkService/ExportBenchmarkReport"
+	BenchmarkService_WatchBenchmark_FullMethodName            = "/hcp.benchmark.v1.BenchmarkService/WatchBenchmark"
+	BenchmarkService_CreateBenchmarkTemplate_FullMethodName   = "/hcp.benchmark.v1.BenchmarkService/CreateBenchmarkTemplate"
+	BenchmarkService_GetBenchmarkTemplate_FullMethodName      = "/hcp.benchmark.v1.BenchmarkService/GetBenchmarkTemplate"
+	BenchmarkService_ListBenchmarkTemplates_FullMethodName    = "/hcp.benchmark.v1.BenchmarkService/ListBenchmarkTemplates"
+	BenchmarkService_DeleteBenchmarkTemplate_FullMethodName   = "/hcp.benchmark.v1.BenchmarkService/DeleteBenchmarkTemplate"
+	BenchmarkService_CreateBenchmarkSchedule_FullMethodName   = "/hcp.benchmark.v1.BenchmarkService/CreateBenchmarkSchedule"
+	BenchmarkService_GetBenchmarkSchedule_FullMethodName      = "/hcp.benchmark.v1.BenchmarkService/GetBenchmarkSchedule"
+	BenchmarkService_ListBenchmarkSchedules_FullMethodName    = "/hcp.benchmark.v1.BenchmarkService/ListBenchmarkSchedules"
+	BenchmarkService_UpdateBenchmarkSchedule_FullMethodName   = "/hcp.benchmark.v1.BenchmarkService/UpdateBenchmarkSchedule"
+	BenchmarkService_DeleteBenchmarkSchedule_FullMethodName   = "/hcp.benchmark.v1.BenchmarkService/DeleteBenchmarkSchedule"
 )
 
 // BenchmarkServiceClient is the client API for BenchmarkService service.
@@ -36,6 +57,27 @@ type BenchmarkServiceClient interface {
 	ListBenchmarks(ctx context.Context, in *ListBenchmarksRequest, opts ...grpc.CallOption) (*ListBenchmarksResponse, error)
 	UpdateBenchmark(ctx context.Context, in *UpdateBenchmarkRequest, opts ...grpc.CallOption) (*UpdateBenchmarkResponse, error)
 	DeleteBenchmark(ctx context.Context, in *DeleteBenchmarkRequest, opts ...grpc.CallOption) (*common.StatusResponse, error)
+	RecomputeBenchmarkResults(ctx context.Context, in *RecomputeBenchmarkResultsRequest, opts ...grpc.CallOption) (*RecomputeBenchmarkResultsResponse, error)
+	CompareBenchmarks(ctx context.Context, in *CompareBenchmarksRequest, opts ...grpc.CallOption) (*CompareBenchmarksResponse, error)
+	CompareBenchmarkGroups(ctx context.Context, in *CompareBenchmarkGroupsRequest, opts ...grpc.CallOption) (*CompareBenchmarkGroupsResponse, error)
+	CloneBenchmark(ctx context.Context, in *CloneBenchmarkRequest, opts ...grpc.CallOption) (*CloneBenchmarkResponse, error)
+	CancelBenchmark(ctx context.Context, in *CancelBenchmarkRequest, opts ...grpc.CallOption) (*CancelBenchmarkResponse, error)
+	GetBenchmarkQueue(ctx context.Context, in *GetBenchmarkQueueRequest, opts ...grpc.CallOption) (*GetBenchmarkQueueResponse, error)
+	GetLeaderboard(ctx context.Context, in *GetLeaderboardRequest, opts ...grpc.CallOption) (*GetLeaderboardResponse, error)
+	GetTrend(ctx context.Context, in *GetTrendRequest, opts ...grpc.CallOption) (*GetTrendResponse, error)
+	RecordBenchmarkNodes(ctx context.Context, in *RecordBenchmarkNodesRequest, opts ...grpc.CallOption) (*RecordBenchmarkNodesResponse, error)
+	ListBenchmarkNodes(ctx context.Context, in *ListBenchmarkNodesRequest, opts ...grpc.CallOption) (*ListBenchmarkNodesResponse, error)
+	ExportBenchmarkReport(ctx context.Context, in *ExportBenchmarkReportRequest, opts ...grpc.CallOption) (*ExportBenchmarkReportResponse, error)
+	WatchBenchmark(ctx context.Context, in *WatchBenchmarkRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BenchmarkProgress], error)
+	CreateBenchmarkTemplate(ctx context.Context, in *CreateBenchmarkTemplateRequest, opts ...grpc.CallOption) (*CreateBenchmarkTemplateResponse, error)
+	GetBenchmarkTemplate(ctx context.Context, in *GetBenchmarkTemplateRequest, opts ...grpc.CallOption) (*GetBenchmarkTemplateResponse, error)
+	ListBenchmarkTemplates(ctx context.Context, in *ListBenchmarkTemplatesRequest, opts ...grpc.CallOption) (*ListBenchmarkTemplatesResponse, error)
+	DeleteBenchmarkTemplate(ctx context.Context, in *DeleteBenchmarkTemplateRequest, opts ...grpc.CallOption) (*common.StatusResponse, error)
+	CreateBenchmarkSchedule(ctx context.Context, in *CreateBenchmarkScheduleRequest, opts ...grpc.CallOption) (*CreateBenchmarkScheduleResponse, error)
+	GetBenchmarkSchedule(ctx context.Context, in *GetBenchmarkScheduleRequest, opts ...grpc.CallOption) (*GetBenchmarkScheduleResponse, error)
+	ListBenchmarkSchedules(ctx context.Context, in *ListBenchmarkSchedulesRequest, opts ...grpc.CallOption) (*ListBenchmarkSchedulesResponse, error)
+	UpdateBenchmarkSchedule(ctx context.Context, in *UpdateBenchmarkScheduleRequest, opts ...grpc.CallOption) (*UpdateBenchmarkScheduleResponse, error)
+	DeleteBenchmarkSchedule(ctx context.Context, in *DeleteBenchmarkScheduleRequest, opts ...grpc.CallOption) (*common.StatusResponse, error)
 }
 
 type benchmarkServiceClient struct {
@@ -96,6 +138,225 @@ func (c *benchmarkServiceClient) DeleteBenchmark(ctx context.Context, in *Delete
 	return out, nil
 }
 
+func (c *benchmarkServiceClient) RecomputeBenchmarkResults(ctx context.Context, in *RecomputeBenchmarkResultsRequest, opts ...grpc.CallOption) (*RecomputeBenchmarkResultsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecomputeBenchmarkResultsResponse)
+	err := c.cc.Invoke(ctx, BenchmarkService_RecomputeBenchmarkResults_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *benchmarkServiceClient) CompareBenchmarks(ctx context.Context, in *CompareBenchmarksRequest, opts ...grpc.CallOption) (*CompareBenchmarksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompareBenchmarksResponse)
+	err := c.cc.Invoke(ctx, BenchmarkService_CompareBenchmarks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *benchmarkServiceClient) CompareBenchmarkGroups(ctx context.Context, in *CompareBenchmarkGroupsRequest, opts ...grpc.CallOption) (*CompareBenchmarkGroupsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompareBenchmarkGroupsResponse)
+	err := c.cc.Invoke(ctx, BenchmarkService_CompareBenchmarkGroups_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *benchmarkServiceClient) CloneBenchmark(ctx context.Context, in *CloneBenchmarkRequest, opts ...grpc.CallOption) (*CloneBenchmarkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CloneBenchmarkResponse)
+	err := c.cc.Invoke(ctx, BenchmarkService_CloneBenchmark_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *benchmarkServiceClient) CancelBenchmark(ctx context.Context, in *CancelBenchmarkRequest, opts ...grpc.CallOption) (*CancelBenchmarkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelBenchmarkResponse)
+	err := c.cc.Invoke(ctx, BenchmarkService_CancelBenchmark_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *benchmarkServiceClient) GetBenchmarkQueue(ctx context.Context, in *GetBenchmarkQueueRequest, opts ...grpc.CallOption) (*GetBenchmarkQueueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBenchmarkQueueResponse)
+	err := c.cc.Invoke(ctx, BenchmarkService_GetBenchmarkQueue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *benchmarkServiceClient) GetLeaderboard(ctx context.Context, in *GetLeaderboardRequest, opts ...grpc.CallOption) (*GetLeaderboardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLeaderboardResponse)
+	err := c.cc.Invoke(ctx, BenchmarkService_GetLeaderboard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *benchmarkServiceClient) GetTrend(ctx context.Context, in *GetTrendRequest, opts ...grpc.CallOption) (*GetTrendResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTrendResponse)
+	err := c.cc.Invoke(ctx, BenchmarkService_GetTrend_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *benchmarkServiceClient) RecordBenchmarkNodes(ctx context.Context, in *RecordBenchmarkNodesRequest, opts ...grpc.CallOption) (*RecordBenchmarkNodesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordBenchmarkNodesResponse)
+	err := c.cc.Invoke(ctx, BenchmarkService_RecordBenchmarkNodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *benchmarkServiceClient) ListBenchmarkNodes(ctx context.Context, in *ListBenchmarkNodesRequest, opts ...grpc.CallOption) (*ListBenchmarkNodesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBenchmarkNodesResponse)
+	err := c.cc.Invoke(ctx, BenchmarkService_ListBenchmarkNodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *benchmarkServiceClient) ExportBenchmarkReport(ctx context.Context, in *ExportBenchmarkReportRequest, opts ...grpc.CallOption) (*ExportBenchmarkReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportBenchmarkReportResponse)
+	err := c.cc.Invoke(ctx, BenchmarkService_ExportBenchmarkReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *benchmarkServiceClient) WatchBenchmark(ctx context.Context, in *WatchBenchmarkRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BenchmarkProgress], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &BenchmarkService_ServiceDesc.Streams[0], BenchmarkService_WatchBenchmark_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchBenchmarkRequest, BenchmarkProgress]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BenchmarkService_WatchBenchmarkClient = grpc.ServerStreamingClient[BenchmarkProgress]
+
+func (c *benchmarkServiceClient) CreateBenchmarkTemplate(ctx context.Context, in *CreateBenchmarkTemplateRequest, opts ...grpc.CallOption) (*CreateBenchmarkTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateBenchmarkTemplateResponse)
+	err := c.cc.Invoke(ctx, BenchmarkService_CreateBenchmarkTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *benchmarkServiceClient) GetBenchmarkTemplate(ctx context.Context, in *GetBenchmarkTemplateRequest, opts ...grpc.CallOption) (*GetBenchmarkTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBenchmarkTemplateResponse)
+	err := c.cc.Invoke(ctx, BenchmarkService_GetBenchmarkTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *benchmarkServiceClient) ListBenchmarkTemplates(ctx context.Context, in *ListBenchmarkTemplatesRequest, opts ...grpc.CallOption) (*ListBenchmarkTemplatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBenchmarkTemplatesResponse)
+	err := c.cc.Invoke(ctx, BenchmarkService_ListBenchmarkTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *benchmarkServiceClient) DeleteBenchmarkTemplate(ctx context.Context, in *DeleteBenchmarkTemplateRequest, opts ...grpc.CallOption) (*common.StatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(common.StatusResponse)
+	err := c.cc.Invoke(ctx, BenchmarkService_DeleteBenchmarkTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *benchmarkServiceClient) CreateBenchmarkSchedule(ctx context.Context, in *CreateBenchmarkScheduleRequest, opts ...grpc.CallOption) (*CreateBenchmarkScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateBenchmarkScheduleResponse)
+	err := c.cc.Invoke(ctx, BenchmarkService_CreateBenchmarkSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *benchmarkServiceClient) GetBenchmarkSchedule(ctx context.Context, in *GetBenchmarkScheduleRequest, opts ...grpc.CallOption) (*GetBenchmarkScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBenchmarkScheduleResponse)
+	err := c.cc.Invoke(ctx, BenchmarkService_GetBenchmarkSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *benchmarkServiceClient) ListBenchmarkSchedules(ctx context.Context, in *ListBenchmarkSchedulesRequest, opts ...grpc.CallOption) (*ListBenchmarkSchedulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBenchmarkSchedulesResponse)
+	err := c.cc.Invoke(ctx, BenchmarkService_ListBenchmarkSchedules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *benchmarkServiceClient) UpdateBenchmarkSchedule(ctx context.Context, in *UpdateBenchmarkScheduleRequest, opts ...grpc.CallOption) (*UpdateBenchmarkScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateBenchmarkScheduleResponse)
+	err := c.cc.Invoke(ctx, BenchmarkService_UpdateBenchmarkSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *benchmarkServiceClient) DeleteBenchmarkSchedule(ctx context.Context, in *DeleteBenchmarkScheduleRequest, opts ...grpc.CallOption) (*common.StatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(common.StatusResponse)
+	err := c.cc.Invoke(ctx, BenchmarkService_DeleteBenchmarkSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BenchmarkServiceServer is the server API for BenchmarkService service.
 // All implementations must embed UnimplementedBenchmarkServiceServer
 // for forward compatibility.
@@ -105,6 +366,27 @@ type BenchmarkServiceServer interface {
 	ListBenchmarks(context.Context, *ListBenchmarksRequest) (*ListBenchmarksResponse, error)
 	UpdateBenchmark(context.Context, *UpdateBenchmarkRequest) (*UpdateBenchmarkResponse, error)
 	DeleteBenchmark(context.Context, *DeleteBenchmarkRequest) (*common.StatusResponse, error)
+	RecomputeBenchmarkResults(context.Context, *RecomputeBenchmarkResultsRequest) (*RecomputeBenchmarkResultsResponse, error)
+	CompareBenchmarks(context.Context, *CompareBenchmarksRequest) (*CompareBenchmarksResponse, error)
+	CompareBenchmarkGroups(context.Context, *CompareBenchmarkGroupsRequest) (*CompareBenchmarkGroupsResponse, error)
+	CloneBenchmark(context.Context, *CloneBenchmarkRequest) (*CloneBenchmarkResponse, error)
+	CancelBenchmark(context.Context, *CancelBenchmarkRequest) (*CancelBenchmarkResponse, error)
+	GetBenchmarkQueue(context.Context, *GetBenchmarkQueueRequest) (*GetBenchmarkQueueResponse, error)
+	GetLeaderboard(context.Context, *GetLeaderboardRequest) (*GetLeaderboardResponse, error)
+	GetTrend(context.Context, *GetTrendRequest) (*GetTrendResponse, error)
+	RecordBenchmarkNodes(context.Context, *RecordBenchmarkNodesRequest) (*RecordBenchmarkNodesResponse, error)
+	ListBenchmarkNodes(context.Context, *ListBenchmarkNodesRequest) (*ListBenchmarkNodesResponse, error)
+	ExportBenchmarkReport(context.Context, *ExportBenchmarkReportRequest) (*ExportBenchmarkReportResponse, error)
+	WatchBenchmark(*WatchBenchmarkRequest, grpc.ServerStreamingServer[BenchmarkProgress]) error
+	CreateBenchmarkTemplate(context.Context, *CreateBenchmarkTemplateRequest) (*CreateBenchmarkTemplateResponse, error)
+	GetBenchmarkTemplate(context.Context, *GetBenchmarkTemplateRequest) (*GetBenchmarkTemplateResponse, error)
+	ListBenchmarkTemplates(context.Context, *ListBenchmarkTemplatesRequest) (*ListBenchmarkTemplatesResponse, error)
+	DeleteBenchmarkTemplate(context.Context, *DeleteBenchmarkTemplateRequest) (*common.StatusResponse, error)
+	CreateBenchmarkSchedule(context.Context, *CreateBenchmarkScheduleRequest) (*CreateBenchmarkScheduleResponse, error)
+	GetBenchmarkSchedule(context.Context, *GetBenchmarkScheduleRequest) (*GetBenchmarkScheduleResponse, error)
+	ListBenchmarkSchedules(context.Context, *ListBenchmarkSchedulesRequest) (*ListBenchmarkSchedulesResponse, error)
+	UpdateBenchmarkSchedule(context.Context, *UpdateBenchmarkScheduleRequest) (*UpdateBenchmarkScheduleResponse, error)
+	DeleteBenchmarkSchedule(context.Context, *DeleteBenchmarkScheduleRequest) (*common.StatusResponse, error)
 	mustEmbedUnimplementedBenchmarkServiceServer()
 }
 
@@ -130,6 +412,69 @@ func (UnimplementedBenchmarkServiceServer) UpdateBenchmark(context.Context, *Upd
 func (UnimplementedBenchmarkServiceServer) DeleteBenchmark(context.Context, *DeleteBenchmarkRequest) (*common.StatusResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteBenchmark not implemented")
 }
+func (UnimplementedBenchmarkServiceServer) RecomputeBenchmarkResults(context.Context, *RecomputeBenchmarkResultsRequest) (*RecomputeBenchmarkResultsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RecomputeBenchmarkResults not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) CompareBenchmarks(context.Context, *CompareBenchmarksRequest) (*CompareBenchmarksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CompareBenchmarks not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) CompareBenchmarkGroups(context.Context, *CompareBenchmarkGroupsRequest) (*CompareBenchmarkGroupsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CompareBenchmarkGroups not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) CloneBenchmark(context.Context, *CloneBenchmarkRequest) (*CloneBenchmarkResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CloneBenchmark not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) CancelBenchmark(context.Context, *CancelBenchmarkRequest) (*CancelBenchmarkResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelBenchmark not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) GetBenchmarkQueue(context.Context, *GetBenchmarkQueueRequest) (*GetBenchmarkQueueResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetBenchmarkQueue not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) GetLeaderboard(context.Context, *GetLeaderboardRequest) (*GetLeaderboardResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetLeaderboard not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) GetTrend(context.Context, *GetTrendRequest) (*GetTrendResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTrend not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) RecordBenchmarkNodes(context.Context, *RecordBenchmarkNodesRequest) (*RecordBenchmarkNodesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RecordBenchmarkNodes not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) ListBenchmarkNodes(context.Context, *ListBenchmarkNodesRequest) (*ListBenchmarkNodesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListBenchmarkNodes not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) ExportBenchmarkReport(context.Context, *ExportBenchmarkReportRequest) (*ExportBenchmarkReportResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportBenchmarkReport not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) WatchBenchmark(*WatchBenchmarkRequest, grpc.ServerStreamingServer[BenchmarkProgress]) error {
+	return status.Error(codes.Unimplemented, "method WatchBenchmark not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) CreateBenchmarkTemplate(context.Context, *CreateBenchmarkTemplateRequest) (*CreateBenchmarkTemplateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateBenchmarkTemplate not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) GetBenchmarkTemplate(context.Context, *GetBenchmarkTemplateRequest) (*GetBenchmarkTemplateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetBenchmarkTemplate not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) ListBenchmarkTemplates(context.Context, *ListBenchmarkTemplatesRequest) (*ListBenchmarkTemplatesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListBenchmarkTemplates not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) DeleteBenchmarkTemplate(context.Context, *DeleteBenchmarkTemplateRequest) (*common.StatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteBenchmarkTemplate not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) CreateBenchmarkSchedule(context.Context, *CreateBenchmarkScheduleRequest) (*CreateBenchmarkScheduleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateBenchmarkSchedule not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) GetBenchmarkSchedule(context.Context, *GetBenchmarkScheduleRequest) (*GetBenchmarkScheduleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetBenchmarkSchedule not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) ListBenchmarkSchedules(context.Context, *ListBenchmarkSchedulesRequest) (*ListBenchmarkSchedulesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListBenchmarkSchedules not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) UpdateBenchmarkSchedule(context.Context, *UpdateBenchmarkScheduleRequest) (*UpdateBenchmarkScheduleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateBenchmarkSchedule not implemented")
+}
+func (UnimplementedBenchmarkServiceServer) DeleteBenchmarkSchedule(context.Context, *DeleteBenchmarkScheduleRequest) (*common.StatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteBenchmarkSchedule not implemented")
+}
 func (UnimplementedBenchmarkServiceServer) mustEmbedUnimplementedBenchmarkServiceServer() {}
 func (UnimplementedBenchmarkServiceServer) testEmbeddedByValue()                          {}
 
@@ -241,6 +586,377 @@ func _BenchmarkService_DeleteBenchmark_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BenchmarkService_RecomputeBenchmarkResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecomputeBenchmarkResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BenchmarkServiceServer).RecomputeBenchmarkResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BenchmarkService_RecomputeBenchmarkResults_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BenchmarkServiceServer).RecomputeBenchmarkResults(ctx, req.(*RecomputeBenchmarkResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BenchmarkService_CompareBenchmarks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareBenchmarksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BenchmarkServiceServer).CompareBenchmarks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BenchmarkService_CompareBenchmarks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BenchmarkServiceServer).CompareBenchmarks(ctx, req.(*CompareBenchmarksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BenchmarkService_CompareBenchmarkGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareBenchmarkGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BenchmarkServiceServer).CompareBenchmarkGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BenchmarkService_CompareBenchmarkGroups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BenchmarkServiceServer).CompareBenchmarkGroups(ctx, req.(*CompareBenchmarkGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BenchmarkService_CloneBenchmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneBenchmarkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BenchmarkServiceServer).CloneBenchmark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BenchmarkService_CloneBenchmark_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BenchmarkServiceServer).CloneBenchmark(ctx, req.(*CloneBenchmarkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BenchmarkService_CancelBenchmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelBenchmarkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BenchmarkServiceServer).CancelBenchmark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BenchmarkService_CancelBenchmark_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BenchmarkServiceServer).CancelBenchmark(ctx, req.(*CancelBenchmarkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BenchmarkService_GetBenchmarkQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBenchmarkQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BenchmarkServiceServer).GetBenchmarkQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BenchmarkService_GetBenchmarkQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BenchmarkServiceServer).GetBenchmarkQueue(ctx, req.(*GetBenchmarkQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BenchmarkService_GetLeaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLeaderboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BenchmarkServiceServer).GetLeaderboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BenchmarkService_GetLeaderboard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BenchmarkServiceServer).GetLeaderboard(ctx, req.(*GetLeaderboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BenchmarkService_GetTrend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTrendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BenchmarkServiceServer).GetTrend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BenchmarkService_GetTrend_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BenchmarkServiceServer).GetTrend(ctx, req.(*GetTrendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BenchmarkService_RecordBenchmarkNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordBenchmarkNodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BenchmarkServiceServer).RecordBenchmarkNodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BenchmarkService_RecordBenchmarkNodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BenchmarkServiceServer).RecordBenchmarkNodes(ctx, req.(*RecordBenchmarkNodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BenchmarkService_ListBenchmarkNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBenchmarkNodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BenchmarkServiceServer).ListBenchmarkNodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BenchmarkService_ListBenchmarkNodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BenchmarkServiceServer).ListBenchmarkNodes(ctx, req.(*ListBenchmarkNodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BenchmarkService_ExportBenchmarkReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportBenchmarkReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BenchmarkServiceServer).ExportBenchmarkReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BenchmarkService_ExportBenchmarkReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BenchmarkServiceServer).ExportBenchmarkReport(ctx, req.(*ExportBenchmarkReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BenchmarkService_WatchBenchmark_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchBenchmarkRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BenchmarkServiceServer).WatchBenchmark(m, &grpc.GenericServerStream[WatchBenchmarkRequest, BenchmarkProgress]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BenchmarkService_WatchBenchmarkServer = grpc.ServerStreamingServer[BenchmarkProgress]
+
+func _BenchmarkService_CreateBenchmarkTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBenchmarkTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BenchmarkServiceServer).CreateBenchmarkTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BenchmarkService_CreateBenchmarkTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BenchmarkServiceServer).CreateBenchmarkTemplate(ctx, req.(*CreateBenchmarkTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BenchmarkService_GetBenchmarkTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBenchmarkTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BenchmarkServiceServer).GetBenchmarkTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BenchmarkService_GetBenchmarkTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BenchmarkServiceServer).GetBenchmarkTemplate(ctx, req.(*GetBenchmarkTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BenchmarkService_ListBenchmarkTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBenchmarkTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BenchmarkServiceServer).ListBenchmarkTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BenchmarkService_ListBenchmarkTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BenchmarkServiceServer).ListBenchmarkTemplates(ctx, req.(*ListBenchmarkTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BenchmarkService_DeleteBenchmarkTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBenchmarkTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BenchmarkServiceServer).DeleteBenchmarkTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BenchmarkService_DeleteBenchmarkTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BenchmarkServiceServer).DeleteBenchmarkTemplate(ctx, req.(*DeleteBenchmarkTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BenchmarkService_CreateBenchmarkSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBenchmarkScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BenchmarkServiceServer).CreateBenchmarkSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BenchmarkService_CreateBenchmarkSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BenchmarkServiceServer).CreateBenchmarkSchedule(ctx, req.(*CreateBenchmarkScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BenchmarkService_GetBenchmarkSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBenchmarkScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BenchmarkServiceServer).GetBenchmarkSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BenchmarkService_GetBenchmarkSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BenchmarkServiceServer).GetBenchmarkSchedule(ctx, req.(*GetBenchmarkScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BenchmarkService_ListBenchmarkSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBenchmarkSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BenchmarkServiceServer).ListBenchmarkSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BenchmarkService_ListBenchmarkSchedules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BenchmarkServiceServer).ListBenchmarkSchedules(ctx, req.(*ListBenchmarkSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BenchmarkService_UpdateBenchmarkSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBenchmarkScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BenchmarkServiceServer).UpdateBenchmarkSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BenchmarkService_UpdateBenchmarkSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BenchmarkServiceServer).UpdateBenchmarkSchedule(ctx, req.(*UpdateBenchmarkScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BenchmarkService_DeleteBenchmarkSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBenchmarkScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BenchmarkServiceServer).DeleteBenchmarkSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BenchmarkService_DeleteBenchmarkSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BenchmarkServiceServer).DeleteBenchmarkSchedule(ctx, req.(*DeleteBenchmarkScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BenchmarkService_ServiceDesc is the grpc.ServiceDesc for BenchmarkService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -268,7 +984,93 @@ var BenchmarkService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteBenchmark",
 			Handler:    _BenchmarkService_DeleteBenchmark_Handler,
 		},
+		{
+			MethodName: "RecomputeBenchmarkResults",
+			Handler:    _BenchmarkService_RecomputeBenchmarkResults_Handler,
+		},
+		{
+			MethodName: "CompareBenchmarks",
+			Handler:    _BenchmarkService_CompareBenchmarks_Handler,
+		},
+		{
+			MethodName: "CompareBenchmarkGroups",
+			Handler:    _BenchmarkService_CompareBenchmarkGroups_Handler,
+		},
+		{
+			MethodName: "CloneBenchmark",
+			Handler:    _BenchmarkService_CloneBenchmark_Handler,
+		},
+		{
+			MethodName: "CancelBenchmark",
+			Handler:    _BenchmarkService_CancelBenchmark_Handler,
+		},
+		{
+			MethodName: "GetBenchmarkQueue",
+			Handler:    _BenchmarkService_GetBenchmarkQueue_Handler,
+		},
+		{
+			MethodName: "GetLeaderboard",
+			Handler:    _BenchmarkService_GetLeaderboard_Handler,
+		},
+		{
+			MethodName: "GetTrend",
+			Handler:    _BenchmarkService_GetTrend_Handler,
+		},
+		{
+			MethodName: "RecordBenchmarkNodes",
+			Handler:    _BenchmarkService_RecordBenchmarkNodes_Handler,
+		},
+		{
+			MethodName: "ListBenchmarkNodes",
+			Handler:    _BenchmarkService_ListBenchmarkNodes_Handler,
+		},
+		{
+			MethodName: "ExportBenchmarkReport",
+			Handler:    _BenchmarkService_ExportBenchmarkReport_Handler,
+		},
+		{
+			MethodName: "CreateBenchmarkTemplate",
+			Handler:    _BenchmarkService_CreateBenchmarkTemplate_Handler,
+		},
+		{
+			MethodName: "GetBenchmarkTemplate",
+			Handler:    _BenchmarkService_GetBenchmarkTemplate_Handler,
+		},
+		{
+			MethodName: "ListBenchmarkTemplates",
+			Handler:    _BenchmarkService_ListBenchmarkTemplates_Handler,
+		},
+		{
+			MethodName: "DeleteBenchmarkTemplate",
+			Handler:    _BenchmarkService_DeleteBenchmarkTemplate_Handler,
+		},
+		{
+			MethodName: "CreateBenchmarkSchedule",
+			Handler:    _BenchmarkService_CreateBenchmarkSchedule_Handler,
+		},
+		{
+			MethodName: "GetBenchmarkSchedule",
+			Handler:    _BenchmarkService_GetBenchmarkSchedule_Handler,
+		},
+		{
+			MethodName: "ListBenchmarkSchedules",
+			Handler:    _BenchmarkService_ListBenchmarkSchedules_Handler,
+		},
+		{
+			MethodName: "UpdateBenchmarkSchedule",
+			Handler:    _BenchmarkService_UpdateBenchmarkSchedule_Handler,
+		},
+		{
+			MethodName: "DeleteBenchmarkSchedule",
+			Handler:    _BenchmarkService_DeleteBenchmarkSchedule_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchBenchmark",
+			Handler:       _BenchmarkService_WatchBenchmark_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/proto/benchmark.proto",
 }
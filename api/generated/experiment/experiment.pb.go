@@ -0,0 +1,911 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v3.12.4
+// source: api/proto/experiment.proto
+
+package experiment
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ExperimentMatrix is a parameter sweep: one benchmark is run for every
+// algorithm x node count x target TPS combination, repetitions times.
+type ExperimentMatrix struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Algorithms    []string               `protobuf:"bytes,1,rep,name=algorithms,proto3" json:"algorithms,omitempty"`
+	NodeCounts    []int32                `protobuf:"varint,2,rep,packed,name=node_counts,json=nodeCounts,proto3" json:"node_counts,omitempty"`
+	TargetTps     []int32                `protobuf:"varint,3,rep,packed,name=target_tps,json=targetTps,proto3" json:"target_tps,omitempty"`
+	Repetitions   int32                  `protobuf:"varint,4,opt,name=repetitions,proto3" json:"repetitions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExperimentMatrix) Reset() {
+	*x = ExperimentMatrix{}
+	mi := &file_api_proto_experiment_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExperimentMatrix) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExperimentMatrix) ProtoMessage() {}
+
+func (x *ExperimentMatrix) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_experiment_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExperimentMatrix.ProtoReflect.Descriptor instead.
+func (*ExperimentMatrix) Descriptor() ([]byte, []int) {
+	return file_api_proto_experiment_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ExperimentMatrix) GetAlgorithms() []string {
+	if x != nil {
+		return x.Algorithms
+	}
+	return nil
+}
+
+func (x *ExperimentMatrix) GetNodeCounts() []int32 {
+	if x != nil {
+		return x.NodeCounts
+	}
+	return nil
+}
+
+func (x *ExperimentMatrix) GetTargetTps() []int32 {
+	if x != nil {
+		return x.TargetTps
+	}
+	return nil
+}
+
+func (x *ExperimentMatrix) GetRepetitions() int32 {
+	if x != nil {
+		return x.Repetitions
+	}
+	return 0
+}
+
+type ExperimentProgress struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Total     int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Queued    int32                  `protobuf:"varint,2,opt,name=queued,proto3" json:"queued,omitempty"`
+	Active    int32                  `protobuf:"varint,3,opt,name=active,proto3" json:"active,omitempty"`
+	Completed int32                  `protobuf:"varint,4,opt,name=completed,proto3" json:"completed,omitempty"`
+	Failed    int32                  `protobuf:"varint,5,opt,name=failed,proto3" json:"failed,omitempty"`
+	Cancelled int32                  `protobuf:"varint,6,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+	// Share of runs that reached a terminal state, 0-100.
+	Percent       float64 `protobuf:"fixed64,7,opt,name=percent,proto3" json:"percent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExperimentProgress) Reset() {
+	*x = ExperimentProgress{}
+	mi := &file_api_proto_experiment_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExperimentProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExperimentProgress) ProtoMessage() {}
+
+func (x *ExperimentProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_experiment_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExperimentProgress.ProtoReflect.Descriptor instead.
+func (*ExperimentProgress) Descriptor() ([]byte, []int) {
+	return file_api_proto_experiment_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ExperimentProgress) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ExperimentProgress) GetQueued() int32 {
+	if x != nil {
+		return x.Queued
+	}
+	return 0
+}
+
+func (x *ExperimentProgress) GetActive() int32 {
+	if x != nil {
+		return x.Active
+	}
+	return 0
+}
+
+func (x *ExperimentProgress) GetCompleted() int32 {
+	if x != nil {
+		return x.Completed
+	}
+	return 0
+}
+
+func (x *ExperimentProgress) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *ExperimentProgress) GetCancelled() int32 {
+	if x != nil {
+		return x.Cancelled
+	}
+	return 0
+}
+
+func (x *ExperimentProgress) GetPercent() float64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+type Experiment struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Matrix      *ExperimentMatrix      `protobuf:"bytes,4,opt,name=matrix,proto3" json:"matrix,omitempty"`
+	// Per-run duration in seconds.
+	Duration int32               `protobuf:"varint,5,opt,name=duration,proto3" json:"duration,omitempty"`
+	Status   string              `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	Progress *ExperimentProgress `protobuf:"bytes,7,opt,name=progress,proto3" json:"progress,omitempty"`
+	// Per-run warmup and cooldown in seconds.
+	WarmupDuration   int32  `protobuf:"varint,8,opt,name=warmup_duration,json=warmupDuration,proto3" json:"warmup_duration,omitempty"`
+	CooldownDuration int32  `protobuf:"varint,9,opt,name=cooldown_duration,json=cooldownDuration,proto3" json:"cooldown_duration,omitempty"`
+	CreatedAt        string `protobuf:"bytes,20,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        string `protobuf:"bytes,21,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Experiment) Reset() {
+	*x = Experiment{}
+	mi := &file_api_proto_experiment_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Experiment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Experiment) ProtoMessage() {}
+
+func (x *Experiment) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_experiment_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Experiment.ProtoReflect.Descriptor instead.
+func (*Experiment) Descriptor() ([]byte, []int) {
+	return file_api_proto_experiment_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Experiment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Experiment) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Experiment) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Experiment) GetMatrix() *ExperimentMatrix {
+	if x != nil {
+		return x.Matrix
+	}
+	return nil
+}
+
+func (x *Experiment) GetDuration() int32 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *Experiment) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Experiment) GetProgress() *ExperimentProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+func (x *Experiment) GetWarmupDuration() int32 {
+	if x != nil {
+		return x.WarmupDuration
+	}
+	return 0
+}
+
+func (x *Experiment) GetCooldownDuration() int32 {
+	if x != nil {
+		return x.CooldownDuration
+	}
+	return 0
+}
+
+func (x *Experiment) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Experiment) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type CreateExperimentRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Name             string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description      string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Matrix           *ExperimentMatrix      `protobuf:"bytes,3,opt,name=matrix,proto3" json:"matrix,omitempty"`
+	Duration         int32                  `protobuf:"varint,4,opt,name=duration,proto3" json:"duration,omitempty"`
+	WarmupDuration   int32                  `protobuf:"varint,5,opt,name=warmup_duration,json=warmupDuration,proto3" json:"warmup_duration,omitempty"`
+	CooldownDuration int32                  `protobuf:"varint,6,opt,name=cooldown_duration,json=cooldownDuration,proto3" json:"cooldown_duration,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateExperimentRequest) Reset() {
+	*x = CreateExperimentRequest{}
+	mi := &file_api_proto_experiment_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateExperimentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateExperimentRequest) ProtoMessage() {}
+
+func (x *CreateExperimentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_experiment_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateExperimentRequest.ProtoReflect.Descriptor instead.
+func (*CreateExperimentRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_experiment_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateExperimentRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateExperimentRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateExperimentRequest) GetMatrix() *ExperimentMatrix {
+	if x != nil {
+		return x.Matrix
+	}
+	return nil
+}
+
+func (x *CreateExperimentRequest) GetDuration() int32 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *CreateExperimentRequest) GetWarmupDuration() int32 {
+	if x != nil {
+		return x.WarmupDuration
+	}
+	return 0
+}
+
+func (x *CreateExperimentRequest) GetCooldownDuration() int32 {
+	if x != nil {
+		return x.CooldownDuration
+	}
+	return 0
+}
+
+type CreateExperimentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Experiment    *Experiment            `protobuf:"bytes,1,opt,name=experiment,proto3" json:"experiment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateExperimentResponse) Reset() {
+	*x = CreateExperimentResponse{}
+	mi := &file_api_proto_experiment_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateExperimentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateExperimentResponse) ProtoMessage() {}
+
+func (x *CreateExperimentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_experiment_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateExperimentResponse.ProtoReflect.Descriptor instead.
+func (*CreateExperimentResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_experiment_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateExperimentResponse) GetExperiment() *Experiment {
+	if x != nil {
+		return x.Experiment
+	}
+	return nil
+}
+
+type GetExperimentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExperimentRequest) Reset() {
+	*x = GetExperimentRequest{}
+	mi := &file_api_proto_experiment_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExperimentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExperimentRequest) ProtoMessage() {}
+
+func (x *GetExperimentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_experiment_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExperimentRequest.ProtoReflect.Descriptor instead.
+func (*GetExperimentRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_experiment_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetExperimentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetExperimentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Experiment    *Experiment            `protobuf:"bytes,1,opt,name=experiment,proto3" json:"experiment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExperimentResponse) Reset() {
+	*x = GetExperimentResponse{}
+	mi := &file_api_proto_experiment_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExperimentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExperimentResponse) ProtoMessage() {}
+
+func (x *GetExperimentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_experiment_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExperimentResponse.ProtoReflect.Descriptor instead.
+func (*GetExperimentResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_experiment_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetExperimentResponse) GetExperiment() *Experiment {
+	if x != nil {
+		return x.Experiment
+	}
+	return nil
+}
+
+type GetExperimentGridRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Axes to group by: algorithm, node_count, target_tps. Defaults to all.
+	Axes          []string `protobuf:"bytes,2,rep,name=axes,proto3" json:"axes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExperimentGridRequest) Reset() {
+	*x = GetExperimentGridRequest{}
+	mi := &file_api_proto_experiment_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExperimentGridRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExperimentGridRequest) ProtoMessage() {}
+
+func (x *GetExperimentGridRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_experiment_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExperimentGridRequest.ProtoReflect.Descriptor instead.
+func (*GetExperimentGridRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_experiment_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetExperimentGridRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetExperimentGridRequest) GetAxes() []string {
+	if x != nil {
+		return x.Axes
+	}
+	return nil
+}
+
+// GridCell aggregates the completed runs of one combination of the grouped
+// axes. Axes that were not grouped on are left empty.
+type GridCell struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Algorithm     string                 `protobuf:"bytes,1,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	NodeCount     int32                  `protobuf:"varint,2,opt,name=node_count,json=nodeCount,proto3" json:"node_count,omitempty"`
+	TargetTps     int32                  `protobuf:"varint,3,opt,name=target_tps,json=targetTps,proto3" json:"target_tps,omitempty"`
+	Runs          int32                  `protobuf:"varint,4,opt,name=runs,proto3" json:"runs,omitempty"`
+	CompletedRuns int32                  `protobuf:"varint,5,opt,name=completed_runs,json=completedRuns,proto3" json:"completed_runs,omitempty"`
+	TpsMean       float64                `protobuf:"fixed64,6,opt,name=tps_mean,json=tpsMean,proto3" json:"tps_mean,omitempty"`
+	TpsStddev     float64                `protobuf:"fixed64,7,opt,name=tps_stddev,json=tpsStddev,proto3" json:"tps_stddev,omitempty"`
+	TpsMin        float64                `protobuf:"fixed64,8,opt,name=tps_min,json=tpsMin,proto3" json:"tps_min,omitempty"`
+	TpsMax        float64                `protobuf:"fixed64,9,opt,name=tps_max,json=tpsMax,proto3" json:"tps_max,omitempty"`
+	// Means across the completed runs, in milliseconds.
+	LatencyP50    float64 `protobuf:"fixed64,10,opt,name=latency_p50,json=latencyP50,proto3" json:"latency_p50,omitempty"`
+	LatencyP99    float64 `protobuf:"fixed64,11,opt,name=latency_p99,json=latencyP99,proto3" json:"latency_p99,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GridCell) Reset() {
+	*x = GridCell{}
+	mi := &file_api_proto_experiment_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GridCell) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GridCell) ProtoMessage() {}
+
+func (x *GridCell) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_experiment_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GridCell.ProtoReflect.Descriptor instead.
+func (*GridCell) Descriptor() ([]byte, []int) {
+	return file_api_proto_experiment_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GridCell) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *GridCell) GetNodeCount() int32 {
+	if x != nil {
+		return x.NodeCount
+	}
+	return 0
+}
+
+func (x *GridCell) GetTargetTps() int32 {
+	if x != nil {
+		return x.TargetTps
+	}
+	return 0
+}
+
+func (x *GridCell) GetRuns() int32 {
+	if x != nil {
+		return x.Runs
+	}
+	return 0
+}
+
+func (x *GridCell) GetCompletedRuns() int32 {
+	if x != nil {
+		return x.CompletedRuns
+	}
+	return 0
+}
+
+func (x *GridCell) GetTpsMean() float64 {
+	if x != nil {
+		return x.TpsMean
+	}
+	return 0
+}
+
+func (x *GridCell) GetTpsStddev() float64 {
+	if x != nil {
+		return x.TpsStddev
+	}
+	return 0
+}
+
+func (x *GridCell) GetTpsMin() float64 {
+	if x != nil {
+		return x.TpsMin
+	}
+	return 0
+}
+
+func (x *GridCell) GetTpsMax() float64 {
+	if x != nil {
+		return x.TpsMax
+	}
+	return 0
+}
+
+func (x *GridCell) GetLatencyP50() float64 {
+	if x != nil {
+		return x.LatencyP50
+	}
+	return 0
+}
+
+func (x *GridCell) GetLatencyP99() float64 {
+	if x != nil {
+		return x.LatencyP99
+	}
+	return 0
+}
+
+type GetExperimentGridResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Axes          []string               `protobuf:"bytes,1,rep,name=axes,proto3" json:"axes,omitempty"`
+	Cells         []*GridCell            `protobuf:"bytes,2,rep,name=cells,proto3" json:"cells,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExperimentGridResponse) Reset() {
+	*x = GetExperimentGridResponse{}
+	mi := &file_api_proto_experiment_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExperimentGridResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExperimentGridResponse) ProtoMessage() {}
+
+func (x *GetExperimentGridResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_experiment_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExperimentGridResponse.ProtoReflect.Descriptor instead.
+func (*GetExperimentGridResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_experiment_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetExperimentGridResponse) GetAxes() []string {
+	if x != nil {
+		return x.Axes
+	}
+	return nil
+}
+
+func (x *GetExperimentGridResponse) GetCells() []*GridCell {
+	if x != nil {
+		return x.Cells
+	}
+	return nil
+}
+
+var File_api_proto_experiment_proto protoreflect.FileDescriptor
+
+const file_api_proto_experiment_proto_rawDesc = "" +
+	"\n" +
+	"\x1aapi/proto/experiment.proto\x12\x11hcp.experiment.v1\"\x94\x01\n" +
+	"\x10ExperimentMatrix\x12\x1e\n" +
+	"\n" +
+	"algorithms\x18\x01 \x03(\tR\n" +
+	"algorithms\x12\x1f\n" +
+	"\vnode_counts\x18\x02 \x03(\x05R\n" +
+	"nodeCounts\x12\x1d\n" +
+	"\n" +
+	"target_tps\x18\x03 \x03(\x05R\ttargetTps\x12 \n" +
+	"\vrepetitions\x18\x04 \x01(\x05R\vrepetitions\"\xc8\x01\n" +
+	"\x12ExperimentProgress\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x12\x16\n" +
+	"\x06queued\x18\x02 \x01(\x05R\x06queued\x12\x16\n" +
+	"\x06active\x18\x03 \x01(\x05R\x06active\x12\x1c\n" +
+	"\tcompleted\x18\x04 \x01(\x05R\tcompleted\x12\x16\n" +
+	"\x06failed\x18\x05 \x01(\x05R\x06failed\x12\x1c\n" +
+	"\tcancelled\x18\x06 \x01(\x05R\tcancelled\x12\x18\n" +
+	"\apercent\x18\a \x01(\x01R\apercent\"\x9a\x03\n" +
+	"\n" +
+	"Experiment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12;\n" +
+	"\x06matrix\x18\x04 \x01(\v2#.hcp.experiment.v1.ExperimentMatrixR\x06matrix\x12\x1a\n" +
+	"\bduration\x18\x05 \x01(\x05R\bduration\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12A\n" +
+	"\bprogress\x18\a \x01(\v2%.hcp.experiment.v1.ExperimentProgressR\bprogress\x12'\n" +
+	"\x0fwarmup_duration\x18\b \x01(\x05R\x0ewarmupDuration\x12+\n" +
+	"\x11cooldown_duration\x18\t \x01(\x05R\x10cooldownDuration\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x14 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x15 \x01(\tR\tupdatedAt\"\xfe\x01\n" +
+	"\x17CreateExperimentRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12;\n" +
+	"\x06matrix\x18\x03 \x01(\v2#.hcp.experiment.v1.ExperimentMatrixR\x06matrix\x12\x1a\n" +
+	"\bduration\x18\x04 \x01(\x05R\bduration\x12'\n" +
+	"\x0fwarmup_duration\x18\x05 \x01(\x05R\x0ewarmupDuration\x12+\n" +
+	"\x11cooldown_duration\x18\x06 \x01(\x05R\x10cooldownDuration\"Y\n" +
+	"\x18CreateExperimentResponse\x12=\n" +
+	"\n" +
+	"experiment\x18\x01 \x01(\v2\x1d.hcp.experiment.v1.ExperimentR\n" +
+	"experiment\"&\n" +
+	"\x14GetExperimentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"V\n" +
+	"\x15GetExperimentResponse\x12=\n" +
+	"\n" +
+	"experiment\x18\x01 \x01(\v2\x1d.hcp.experiment.v1.ExperimentR\n" +
+	"experiment\">\n" +
+	"\x18GetExperimentGridRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04axes\x18\x02 \x03(\tR\x04axes\"\xcf\x02\n" +
+	"\bGridCell\x12\x1c\n" +
+	"\talgorithm\x18\x01 \x01(\tR\talgorithm\x12\x1d\n" +
+	"\n" +
+	"node_count\x18\x02 \x01(\x05R\tnodeCount\x12\x1d\n" +
+	"\n" +
+	"target_tps\x18\x03 \x01(\x05R\ttargetTps\x12\x12\n" +
+	"\x04runs\x18\x04 \x01(\x05R\x04runs\x12%\n" +
+	"\x0ecompleted_runs\x18\x05 \x01(\x05R\rcompletedRuns\x12\x19\n" +
+	"\btps_mean\x18\x06 \x01(\x01R\atpsMean\x12\x1d\n" +
+	"\n" +
+	"tps_stddev\x18\a \x01(\x01R\ttpsStddev\x12\x17\n" +
+	"\atps_min\x18\b \x01(\x01R\x06tpsMin\x12\x17\n" +
+	"\atps_max\x18\t \x01(\x01R\x06tpsMax\x12\x1f\n" +
+	"\vlatency_p50\x18\n" +
+	" \x01(\x01R\n" +
+	"latencyP50\x12\x1f\n" +
+	"\vlatency_p99\x18\v \x01(\x01R\n" +
+	"latencyP99\"b\n" +
+	"\x19GetExperimentGridResponse\x12\x12\n" +
+	"\x04axes\x18\x01 \x03(\tR\x04axes\x121\n" +
+	"\x05cells\x18\x02 \x03(\v2\x1b.hcp.experiment.v1.GridCellR\x05cells2\xd4\x02\n" +
+	"\x11ExperimentService\x12k\n" +
+	"\x10CreateExperiment\x12*.hcp.experiment.v1.CreateExperimentRequest\x1a+.hcp.experiment.v1.CreateExperimentResponse\x12b\n" +
+	"\rGetExperiment\x12'.hcp.experiment.v1.GetExperimentRequest\x1a(.hcp.experiment.v1.GetExperimentResponse\x12n\n" +
+	"\x11GetExperimentGrid\x12+.hcp.experiment.v1.GetExperimentGridRequest\x1a,.hcp.experiment.v1.GetExperimentGridResponseB<Z:github.com/fffeng99999/hcp-server/api/generated/experimentb\x06proto3"
+
+var (
+	file_api_proto_experiment_proto_rawDescOnce sync.Once
+	file_api_proto_experiment_proto_rawDescData []byte
+)
+
+func file_api_proto_experiment_proto_rawDescGZIP() []byte {
+	file_api_proto_experiment_proto_rawDescOnce.Do(func() {
+		file_api_proto_experiment_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_proto_experiment_proto_rawDesc), len(file_api_proto_experiment_proto_rawDesc)))
+	})
+	return file_api_proto_experiment_proto_rawDescData
+}
+
+var file_api_proto_experiment_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_api_proto_experiment_proto_goTypes = []any{
+	(*ExperimentMatrix)(nil),          // 0: hcp.experiment.v1.ExperimentMatrix
+	(*ExperimentProgress)(nil),        // 1: hcp.experiment.v1.ExperimentProgress
+	(*Experiment)(nil),                // 2: hcp.experiment.v1.Experiment
+	(*CreateExperimentRequest)(nil),   // 3: hcp.experiment.v1.CreateExperimentRequest
+	(*CreateExperimentResponse)(nil),  // 4: hcp.experiment.v1.CreateExperimentResponse
+	(*GetExperimentRequest)(nil),      // 5: hcp.experiment.v1.GetExperimentRequest
+	(*GetExperimentResponse)(nil),     // 6: hcp.experiment.v1.GetExperimentResponse
+	(*GetExperimentGridRequest)(nil),  // 7: hcp.experiment.v1.GetExperimentGridRequest
+	(*GridCell)(nil),                  // 8: hcp.experiment.v1.GridCell
+	(*GetExperimentGridResponse)(nil), // 9: hcp.experiment.v1.GetExperimentGridResponse
+}
+var file_api_proto_experiment_proto_depIdxs = []int32{
+	0, // 0: hcp.experiment.v1.Experiment.matrix:type_name -> hcp.experiment.v1.ExperimentMatrix
+	1, // 1: hcp.experiment.v1.Experiment.progress:type_name -> hcp.experiment.v1.ExperimentProgress
+	0, // 2: hcp.experiment.v1.CreateExperimentRequest.matrix:type_name -> hcp.experiment.v1.ExperimentMatrix
+	2, // 3: hcp.experiment.v1.CreateExperimentResponse.experiment:type_name -> hcp.experiment.v1.Experiment
+	2, // 4: hcp.experiment.v1.GetExperimentResponse.experiment:type_name -> hcp.experiment.v1.Experiment
+	8, // 5: hcp.experiment.v1.GetExperimentGridResponse.cells:type_name -> hcp.experiment.v1.GridCell
+	3, // 6: hcp.experiment.v1.ExperimentService.CreateExperiment:input_type -> hcp.experiment.v1.CreateExperimentRequest
+	5, // 7: hcp.experiment.v1.ExperimentService.GetExperiment:input_type -> hcp.experiment.v1.GetExperimentRequest
+	7, // 8: hcp.experiment.v1.ExperimentService.GetExperimentGrid:input_type -> hcp.experiment.v1.GetExperimentGridRequest
+	4, // 9: hcp.experiment.v1.ExperimentService.CreateExperiment:output_type -> hcp.experiment.v1.CreateExperimentResponse
+	6, // 10: hcp.experiment.v1.ExperimentService.GetExperiment:output_type -> hcp.experiment.v1.GetExperimentResponse
+	9, // 11: hcp.experiment.v1.ExperimentService.GetExperimentGrid:output_type -> hcp.experiment.v1.GetExperimentGridResponse
+	9, // [9:12] is the sub-list for method output_type
+	6, // [6:9] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_api_proto_experiment_proto_init() }
+func file_api_proto_experiment_proto_init() {
+	if File_api_proto_experiment_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_experiment_proto_rawDesc), len(file_api_proto_experiment_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_proto_experiment_proto_goTypes,
+		DependencyIndexes: file_api_proto_experiment_proto_depIdxs,
+		MessageInfos:      file_api_proto_experiment_proto_msgTypes,
+	}.Build()
+	File_api_proto_experiment_proto = out.File
+	file_api_proto_experiment_proto_goTypes = nil
+	file_api_proto_experiment_proto_depIdxs = nil
+}
@@ -0,0 +1,197 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.1
+// - protoc             v3.12.4
+// source: api/proto/experiment.proto
+
+package experiment
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ExperimentService_CreateExperiment_FullMethodName  = "/hcp.experiment.v1.ExperimentService/CreateExperiment"
+	ExperimentService_GetExperiment_FullMethodName     = "/hcp.experiment.v1.ExperimentService/GetExperiment"
+	ExperimentService_GetExperimentGrid_FullMethodName = "/hcp.experiment.v1.ExperimentService/GetExperimentGrid"
+)
+
+// ExperimentServiceClient is the client API for ExperimentService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ExperimentServiceClient interface {
+	CreateExperiment(ctx context.Context, in *CreateExperimentRequest, opts ...grpc.CallOption) (*CreateExperimentResponse, error)
+	GetExperiment(ctx context.Context, in *GetExperimentRequest, opts ...grpc.CallOption) (*GetExperimentResponse, error)
+	GetExperimentGrid(ctx context.Context, in *GetExperimentGridRequest, opts ...grpc.CallOption) (*GetExperimentGridResponse, error)
+}
+
+type experimentServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewExperimentServiceClient(cc grpc.ClientConnInterface) ExperimentServiceClient {
+	return &experimentServiceClient{cc}
+}
+
+func (c *experimentServiceClient) CreateExperiment(ctx context.Context, in *CreateExperimentRequest, opts ...grpc.CallOption) (*CreateExperimentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateExperimentResponse)
+	err := c.cc.Invoke(ctx, ExperimentService_CreateExperiment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *experimentServiceClient) GetExperiment(ctx context.Context, in *GetExperimentRequest, opts ...grpc.CallOption) (*GetExperimentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetExperimentResponse)
+	err := c.cc.Invoke(ctx, ExperimentService_GetExperiment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *experimentServiceClient) GetExperimentGrid(ctx context.Context, in *GetExperimentGridRequest, opts ...grpc.CallOption) (*GetExperimentGridResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetExperimentGridResponse)
+	err := c.cc.Invoke(ctx, ExperimentService_GetExperimentGrid_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ExperimentServiceServer is the server API for ExperimentService service.
+// All implementations must embed UnimplementedExperimentServiceServer
+// for forward compatibility.
+type ExperimentServiceServer interface {
+	CreateExperiment(context.Context, *CreateExperimentRequest) (*CreateExperimentResponse, error)
+	GetExperiment(context.Context, *GetExperimentRequest) (*GetExperimentResponse, error)
+	GetExperimentGrid(context.Context, *GetExperimentGridRequest) (*GetExperimentGridResponse, error)
+	mustEmbedUnimplementedExperimentServiceServer()
+}
+
+// UnimplementedExperimentServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedExperimentServiceServer struct{}
+
+func (UnimplementedExperimentServiceServer) CreateExperiment(context.Context, *CreateExperimentRequest) (*CreateExperimentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateExperiment not implemented")
+}
+func (UnimplementedExperimentServiceServer) GetExperiment(context.Context, *GetExperimentRequest) (*GetExperimentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetExperiment not implemented")
+}
+func (UnimplementedExperimentServiceServer) GetExperimentGrid(context.Context, *GetExperimentGridRequest) (*GetExperimentGridResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetExperimentGrid not implemented")
+}
+func (UnimplementedExperimentServiceServer) mustEmbedUnimplementedExperimentServiceServer() {}
+func (UnimplementedExperimentServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeExperimentServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ExperimentServiceServer will
+// result in compilation errors.
+type UnsafeExperimentServiceServer interface {
+	mustEmbedUnimplementedExperimentServiceServer()
+}
+
+func RegisterExperimentServiceServer(s grpc.ServiceRegistrar, srv ExperimentServiceServer) {
+	// If the following call panics, it indicates UnimplementedExperimentServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ExperimentService_ServiceDesc, srv)
+}
+
+func _ExperimentService_CreateExperiment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateExperimentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExperimentServiceServer).CreateExperiment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExperimentService_CreateExperiment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExperimentServiceServer).CreateExperiment(ctx, req.(*CreateExperimentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExperimentService_GetExperiment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExperimentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExperimentServiceServer).GetExperiment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExperimentService_GetExperiment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExperimentServiceServer).GetExperiment(ctx, req.(*GetExperimentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExperimentService_GetExperimentGrid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExperimentGridRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExperimentServiceServer).GetExperimentGrid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExperimentService_GetExperimentGrid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExperimentServiceServer).GetExperimentGrid(ctx, req.(*GetExperimentGridRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ExperimentService_ServiceDesc is the grpc.ServiceDesc for ExperimentService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ExperimentService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "hcp.experiment.v1.ExperimentService",
+	HandlerType: (*ExperimentServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateExperiment",
+			Handler:    _ExperimentService_CreateExperiment_Handler,
+		},
+		{
+			MethodName: "GetExperiment",
+			Handler:    _ExperimentService_GetExperiment_Handler,
+		},
+		{
+			MethodName: "GetExperimentGrid",
+			Handler:    _ExperimentService_GetExperimentGrid_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/proto/experiment.proto",
+}
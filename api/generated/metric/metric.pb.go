@@ -371,12 +371,15 @@ func (x *GetNodeMetricsResponse) GetPagination() *common.PaginationResponse {
 }
 
 type GetBenchmarkMetricsRequest struct {
-	state         protoimpl.MessageState    `protogen:"open.v1"`
-	BenchmarkId   string                    `protobuf:"bytes,1,opt,name=benchmark_id,json=benchmarkId,proto3" json:"benchmark_id,omitempty"`
-	MetricName    string                    `protobuf:"bytes,2,opt,name=metric_name,json=metricName,proto3" json:"metric_name,omitempty"` // Optional filter
-	Pagination    *common.PaginationRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state       protoimpl.MessageState    `protogen:"open.v1"`
+	BenchmarkId string                    `protobuf:"bytes,1,opt,name=benchmark_id,json=benchmarkId,proto3" json:"benchmark_id,omitempty"`
+	MetricName  string                    `protobuf:"bytes,2,opt,name=metric_name,json=metricName,proto3" json:"metric_name,omitempty"` // Optional filter
+	Pagination  *common.PaginationRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// Lists metrics reported during warmup and cooldown too. By default only
+	// the measurement window is listed.
+	IncludeUnmeasured bool `protobuf:"varint,4,opt,name=include_unmeasured,json=includeUnmeasured,proto3" json:"include_unmeasured,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *GetBenchmarkMetricsRequest) Reset() {
@@ -430,6 +433,13 @@ func (x *GetBenchmarkMetricsRequest) GetPagination() *common.PaginationRequest {
 	return nil
 }
 
+func (x *GetBenchmarkMetricsRequest) GetIncludeUnmeasured() bool {
+	if x != nil {
+		return x.IncludeUnmeasured
+	}
+	return false
+}
+
 type GetBenchmarkMetricsResponse struct {
 	state         protoimpl.MessageState     `protogen:"open.v1"`
 	Metrics       []*Metric                  `protobuf:"bytes,1,rep,name=metrics,proto3" json:"metrics,omitempty"`
@@ -524,14 +534,15 @@ const file_api_proto_metric_proto_rawDesc = "" +
 	"\ametrics\x18\x01 \x03(\v2\x15.hcp.metric.v1.MetricR\ametrics\x12A\n" +
 	"\n" +
 	"pagination\x18\x02 \x01(\v2!.hcp.common.v1.PaginationResponseR\n" +
-	"pagination\"\xa2\x01\n" +
+	"pagination\"\xd1\x01\n" +
 	"\x1aGetBenchmarkMetricsRequest\x12!\n" +
 	"\fbenchmark_id\x18\x01 \x01(\tR\vbenchmarkId\x12\x1f\n" +
 	"\vmetric_name\x18\x02 \x01(\tR\n" +
 	"metricName\x12@\n" +
 	"\n" +
 	"pagination\x18\x03 \x01(\v2 .hcp.common.v1.PaginationRequestR\n" +
-	"pagination\"\x91\x01\n" +
+	"pagination\x12-\n" +
+	"\x12include_unmeasured\x18\x04 \x01(\bR\x11includeUnmeasured\"\x91\x01\n" +
 	"\x1bGetBenchmarkMetricsResponse\x12/\n" +
 	"\ametrics\x18\x01 \x03(\v2\x15.hcp.metric.v1.MetricR\ametrics\x12A\n" +
 	"\n" +
@@ -195,11 +195,22 @@ func (x *Transaction) GetBenchmarkId() string {
 }
 
 type CreateTransactionRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	FromAddress   string                 `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
-	ToAddress     string                 `protobuf:"bytes,2,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
-	Amount        int64                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	BenchmarkId   string                 `protobuf:"bytes,4,opt,name=benchmark_id,json=benchmarkId,proto3" json:"benchmark_id,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Addresses are 0x followed by 40 hex digits; mixed-case ones must carry a
+	// valid EIP-55 checksum. They are stored checksummed.
+	FromAddress string `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	ToAddress   string `protobuf:"bytes,2,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	Amount      int64  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	BenchmarkId string `protobuf:"bytes,4,opt,name=benchmark_id,json=benchmarkId,proto3" json:"benchmark_id,omitempty"`
+	// Keccak-256 of abi.encode(bytes16 benchmark_id, from, to, amount, nonce,
+	// gas_price, gas_limit).
+	// When empty the server computes it; otherwise it must match.
+	Hash string `protobuf:"bytes,5,opt,name=hash,proto3" json:"hash,omitempty"`
+	// When unset the server assigns the sender's next unused nonce in the
+	// benchmark and computes the hash, which must then be left empty.
+	Nonce         *int64 `protobuf:"varint,6,opt,name=nonce,proto3,oneof" json:"nonce,omitempty"`
+	GasPrice      int64  `protobuf:"varint,7,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
+	GasLimit      int64  `protobuf:"varint,8,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -262,6 +273,34 @@ func (x *CreateTransactionRequest) GetBenchmarkId() string {
 	return ""
 }
 
+func (x *CreateTransactionRequest) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *CreateTransactionRequest) GetNonce() int64 {
+	if x != nil && x.Nonce != nil {
+		return *x.Nonce
+	}
+	return 0
+}
+
+func (x *CreateTransactionRequest) GetGasPrice() int64 {
+	if x != nil {
+		return x.GasPrice
+	}
+	return 0
+}
+
+func (x *CreateTransactionRequest) GetGasLimit() int64 {
+	if x != nil {
+		return x.GasLimit
+	}
+	return 0
+}
+
 type CreateTransactionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Transaction   *Transaction           `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
@@ -523,10 +562,13 @@ func (x *ListTransactionsResponse) GetPagination() *common.PaginationResponse {
 }
 
 type GetTransactionStatsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	BenchmarkId   string                 `protobuf:"bytes,1,opt,name=benchmark_id,json=benchmarkId,proto3" json:"benchmark_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	BenchmarkId string                 `protobuf:"bytes,1,opt,name=benchmark_id,json=benchmarkId,proto3" json:"benchmark_id,omitempty"`
+	// Counts transactions submitted during warmup and cooldown too. By default
+	// only the measurement window counts.
+	IncludeUnmeasured bool `protobuf:"varint,2,opt,name=include_unmeasured,json=includeUnmeasured,proto3" json:"include_unmeasured,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *GetTransactionStatsRequest) Reset() {
@@ -566,6 +608,13 @@ func (x *GetTransactionStatsRequest) GetBenchmarkId() string {
 	return ""
 }
 
+func (x *GetTransactionStatsRequest) GetIncludeUnmeasured() bool {
+	if x != nil {
+		return x.IncludeUnmeasured
+	}
+	return false
+}
+
 type GetTransactionStatsResponse struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
 	TotalTransactions int64                  `protobuf:"varint,1,opt,name=total_transactions,json=totalTransactions,proto3" json:"total_transactions,omitempty"`
@@ -574,8 +623,13 @@ type GetTransactionStatsResponse struct {
 	FailedCount       int64                  `protobuf:"varint,4,opt,name=failed_count,json=failedCount,proto3" json:"failed_count,omitempty"`
 	AvgLatencyMs      float64                `protobuf:"fixed64,5,opt,name=avg_latency_ms,json=avgLatencyMs,proto3" json:"avg_latency_ms,omitempty"`
 	Tps               float64                `protobuf:"fixed64,6,opt,name=tps,proto3" json:"tps,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// Nonce gaps of the benchmark, over all of its transactions. A sender's
+	// missing nonces are those below its highest one that were never used.
+	SenderCount          int64 `protobuf:"varint,7,opt,name=sender_count,json=senderCount,proto3" json:"sender_count,omitempty"`
+	SendersWithNonceGaps int64 `protobuf:"varint,8,opt,name=senders_with_nonce_gaps,json=sendersWithNonceGaps,proto3" json:"senders_with_nonce_gaps,omitempty"`
+	MissingNonceCount    int64 `protobuf:"varint,9,opt,name=missing_nonce_count,json=missingNonceCount,proto3" json:"missing_nonce_count,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *GetTransactionStatsResponse) Reset() {
@@ -650,73 +704,956 @@ func (x *GetTransactionStatsResponse) GetTps() float64 {
 	return 0
 }
 
-var File_api_proto_transaction_proto protoreflect.FileDescriptor
+func (x *GetTransactionStatsResponse) GetSenderCount() int64 {
+	if x != nil {
+		return x.SenderCount
+	}
+	return 0
+}
 
-const file_api_proto_transaction_proto_rawDesc = "" +
-	"\n" +
-	"\x1bapi/proto/transaction.proto\x12\x12hcp.transaction.v1\x1a\x16api/proto/common.proto\"\x9a\x04\n" +
-	"\vTransaction\x12\x12\n" +
-	"\x04hash\x18\x01 \x01(\tR\x04hash\x12!\n" +
-	"\ffrom_address\x18\x02 \x01(\tR\vfromAddress\x12\x1d\n" +
-	"\n" +
-	"to_address\x18\x03 \x01(\tR\ttoAddress\x12\x16\n" +
-	"\x06amount\x18\x04 \x01(\x03R\x06amount\x12\x1b\n" +
-	"\tgas_price\x18\x05 \x01(\x03R\bgasPrice\x12\x1b\n" +
-	"\tgas_limit\x18\x06 \x01(\x03R\bgasLimit\x12\x19\n" +
-	"\bgas_used\x18\a \x01(\x03R\agasUsed\x12\x14\n" +
-	"\x05nonce\x18\b \x01(\x03R\x05nonce\x12!\n" +
-	"\fblock_number\x18\t \x01(\x03R\vblockNumber\x12\x1d\n" +
-	"\n" +
-	"block_hash\x18\n" +
-	" \x01(\tR\tblockHash\x12+\n" +
-	"\x11transaction_index\x18\v \x01(\x05R\x10transactionIndex\x12\x16\n" +
-	"\x06status\x18\f \x01(\tR\x06status\x12#\n" +
-	"\rerror_message\x18\r \x01(\tR\ferrorMessage\x12!\n" +
-	"\fsubmitted_at\x18\x0e \x01(\tR\vsubmittedAt\x12!\n" +
-	"\fconfirmed_at\x18\x0f \x01(\tR\vconfirmedAt\x12\x1d\n" +
-	"\n" +
-	"latency_ms\x18\x10 \x01(\x01R\tlatencyMs\x12!\n" +
-	"\fbenchmark_id\x18\x11 \x01(\tR\vbenchmarkId\"\x97\x01\n" +
-	"\x18CreateTransactionRequest\x12!\n" +
-	"\ffrom_address\x18\x01 \x01(\tR\vfromAddress\x12\x1d\n" +
-	"\n" +
-	"to_address\x18\x02 \x01(\tR\ttoAddress\x12\x16\n" +
-	"\x06amount\x18\x03 \x01(\x03R\x06amount\x12!\n" +
-	"\fbenchmark_id\x18\x04 \x01(\tR\vbenchmarkId\"^\n" +
-	"\x19CreateTransactionResponse\x12A\n" +
-	"\vtransaction\x18\x01 \x01(\v2\x1f.hcp.transaction.v1.TransactionR\vtransaction\"+\n" +
-	"\x15GetTransactionRequest\x12\x12\n" +
-	"\x04hash\x18\x01 \x01(\tR\x04hash\"[\n" +
-	"\x16GetTransactionResponse\x12A\n" +
-	"\vtransaction\x18\x01 \x01(\v2\x1f.hcp.transaction.v1.TransactionR\vtransaction\"\xd8\x01\n" +
-	"\x17ListTransactionsRequest\x12!\n" +
-	"\fbenchmark_id\x18\x01 \x01(\tR\vbenchmarkId\x12!\n" +
-	"\ffrom_address\x18\x02 \x01(\tR\vfromAddress\x12\x1d\n" +
-	"\n" +
-	"to_address\x18\x03 \x01(\tR\ttoAddress\x12\x16\n" +
-	"\x06status\x18\x04 \x01(\tR\x06status\x12@\n" +
-	"\n" +
-	"pagination\x18\x05 \x01(\v2 .hcp.common.v1.PaginationRequestR\n" +
-	"pagination\"\xa2\x01\n" +
-	"\x18ListTransactionsResponse\x12C\n" +
-	"\ftransactions\x18\x01 \x03(\v2\x1f.hcp.transaction.v1.TransactionR\ftransactions\x12A\n" +
-	"\n" +
-	"pagination\x18\x02 \x01(\v2!.hcp.common.v1.PaginationResponseR\n" +
-	"pagination\"?\n" +
-	"\x1aGetTransactionStatsRequest\x12!\n" +
-	"\fbenchmark_id\x18\x01 \x01(\tR\vbenchmarkId\"\xf5\x01\n" +
-	"\x1bGetTransactionStatsResponse\x12-\n" +
-	"\x12total_transactions\x18\x01 \x01(\x03R\x11totalTransactions\x12#\n" +
-	"\rpending_count\x18\x02 \x01(\x03R\fpendingCount\x12'\n" +
-	"\x0fconfirmed_count\x18\x03 \x01(\x03R\x0econfirmedCount\x12!\n" +
-	"\ffailed_count\x18\x04 \x01(\x03R\vfailedCount\x12$\n" +
-	"\x0eavg_latency_ms\x18\x05 \x01(\x01R\favgLatencyMs\x12\x10\n" +
-	"\x03tps\x18\x06 \x01(\x01R\x03tps2\xd6\x03\n" +
+func (x *GetTransactionStatsResponse) GetSendersWithNonceGaps() int64 {
+	if x != nil {
+		return x.SendersWithNonceGaps
+	}
+	return 0
+}
+
+func (x *GetTransactionStatsResponse) GetMissingNonceCount() int64 {
+	if x != nil {
+		return x.MissingNonceCount
+	}
+	return 0
+}
+
+type ConfirmTransactionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Hash  string                 `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// The transaction's submitted_at, which locates its partition. When empty
+	// the server looks it up by hash within benchmark_id, which is then
+	// required.
+	SubmittedAt      string `protobuf:"bytes,2,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`
+	BlockNumber      int64  `protobuf:"varint,3,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	BlockHash        string `protobuf:"bytes,4,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	TransactionIndex int32  `protobuf:"varint,5,opt,name=transaction_index,json=transactionIndex,proto3" json:"transaction_index,omitempty"`
+	GasUsed          int64  `protobuf:"varint,6,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// RFC 3339; defaults to when the server receives the request. latency_ms
+	// is measured from submitted_at to this.
+	ConfirmedAt string `protobuf:"bytes,7,opt,name=confirmed_at,json=confirmedAt,proto3" json:"confirmed_at,omitempty"`
+	// When set, only a transaction of this benchmark is settled.
+	BenchmarkId   string `protobuf:"bytes,8,opt,name=benchmark_id,json=benchmarkId,proto3" json:"benchmark_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmTransactionRequest) Reset() {
+	*x = ConfirmTransactionRequest{}
+	mi := &file_api_proto_transaction_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmTransactionRequest) ProtoMessage() {}
+
+func (x *ConfirmTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_transaction_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmTransactionRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_transaction_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ConfirmTransactionRequest) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *ConfirmTransactionRequest) GetSubmittedAt() string {
+	if x != nil {
+		return x.SubmittedAt
+	}
+	return ""
+}
+
+func (x *ConfirmTransactionRequest) GetBlockNumber() int64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *ConfirmTransactionRequest) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
+func (x *ConfirmTransactionRequest) GetTransactionIndex() int32 {
+	if x != nil {
+		return x.TransactionIndex
+	}
+	return 0
+}
+
+func (x *ConfirmTransactionRequest) GetGasUsed() int64 {
+	if x != nil {
+		return x.GasUsed
+	}
+	return 0
+}
+
+func (x *ConfirmTransactionRequest) GetConfirmedAt() string {
+	if x != nil {
+		return x.ConfirmedAt
+	}
+	return ""
+}
+
+func (x *ConfirmTransactionRequest) GetBenchmarkId() string {
+	if x != nil {
+		return x.BenchmarkId
+	}
+	return ""
+}
+
+type ConfirmTransactionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transaction   *Transaction           `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmTransactionResponse) Reset() {
+	*x = ConfirmTransactionResponse{}
+	mi := &file_api_proto_transaction_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmTransactionResponse) ProtoMessage() {}
+
+func (x *ConfirmTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_transaction_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmTransactionResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_transaction_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ConfirmTransactionResponse) GetTransaction() *Transaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+type FailTransactionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Hash  string                 `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// As on ConfirmTransactionRequest.
+	SubmittedAt  string `protobuf:"bytes,2,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`
+	ErrorMessage string `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	// Block fields are set for transactions that were included but reverted.
+	BlockNumber      int64  `protobuf:"varint,4,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	BlockHash        string `protobuf:"bytes,5,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	TransactionIndex int32  `protobuf:"varint,6,opt,name=transaction_index,json=transactionIndex,proto3" json:"transaction_index,omitempty"`
+	GasUsed          int64  `protobuf:"varint,7,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// As on ConfirmTransactionRequest.
+	BenchmarkId   string `protobuf:"bytes,8,opt,name=benchmark_id,json=benchmarkId,proto3" json:"benchmark_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FailTransactionRequest) Reset() {
+	*x = FailTransactionRequest{}
+	mi := &file_api_proto_transaction_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FailTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FailTransactionRequest) ProtoMessage() {}
+
+func (x *FailTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_transaction_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FailTransactionRequest.ProtoReflect.Descriptor instead.
+func (*FailTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_transaction_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *FailTransactionRequest) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *FailTransactionRequest) GetSubmittedAt() string {
+	if x != nil {
+		return x.SubmittedAt
+	}
+	return ""
+}
+
+func (x *FailTransactionRequest) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *FailTransactionRequest) GetBlockNumber() int64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *FailTransactionRequest) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
+func (x *FailTransactionRequest) GetTransactionIndex() int32 {
+	if x != nil {
+		return x.TransactionIndex
+	}
+	return 0
+}
+
+func (x *FailTransactionRequest) GetGasUsed() int64 {
+	if x != nil {
+		return x.GasUsed
+	}
+	return 0
+}
+
+func (x *FailTransactionRequest) GetBenchmarkId() string {
+	if x != nil {
+		return x.BenchmarkId
+	}
+	return ""
+}
+
+type FailTransactionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transaction   *Transaction           `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FailTransactionResponse) Reset() {
+	*x = FailTransactionResponse{}
+	mi := &file_api_proto_transaction_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FailTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FailTransactionResponse) ProtoMessage() {}
+
+func (x *FailTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_transaction_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FailTransactionResponse.ProtoReflect.Descriptor instead.
+func (*FailTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_transaction_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *FailTransactionResponse) GetTransaction() *Transaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+type ConfirmTransactionsRequest struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Confirmations []*ConfirmTransactionRequest `protobuf:"bytes,1,rep,name=confirmations,proto3" json:"confirmations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmTransactionsRequest) Reset() {
+	*x = ConfirmTransactionsRequest{}
+	mi := &file_api_proto_transaction_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmTransactionsRequest) ProtoMessage() {}
+
+func (x *ConfirmTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_transaction_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_transaction_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ConfirmTransactionsRequest) GetConfirmations() []*ConfirmTransactionRequest {
+	if x != nil {
+		return x.Confirmations
+	}
+	return nil
+}
+
+type FailTransactionsRequest struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Failures      []*FailTransactionRequest `protobuf:"bytes,1,rep,name=failures,proto3" json:"failures,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FailTransactionsRequest) Reset() {
+	*x = FailTransactionsRequest{}
+	mi := &file_api_proto_transaction_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FailTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FailTransactionsRequest) ProtoMessage() {}
+
+func (x *FailTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_transaction_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FailTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*FailTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_transaction_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *FailTransactionsRequest) GetFailures() []*FailTransactionRequest {
+	if x != nil {
+		return x.Failures
+	}
+	return nil
+}
+
+type SettlementResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Hash  string                 `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// Set when the transaction was updated.
+	Transaction *Transaction `protobuf:"bytes,2,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	// Why the entry was not applied, e.g. the transaction is unknown or no
+	// longer pending.
+	Error         string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SettlementResult) Reset() {
+	*x = SettlementResult{}
+	mi := &file_api_proto_transaction_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SettlementResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SettlementResult) ProtoMessage() {}
+
+func (x *SettlementResult) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_transaction_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SettlementResult.ProtoReflect.Descriptor instead.
+func (*SettlementResult) Descriptor() ([]byte, []int) {
+	return file_api_proto_transaction_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SettlementResult) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *SettlementResult) GetTransaction() *Transaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+func (x *SettlementResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type SettleTransactionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// One result per request entry, in order.
+	Results       []*SettlementResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	SettledCount  int32               `protobuf:"varint,2,opt,name=settled_count,json=settledCount,proto3" json:"settled_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SettleTransactionsResponse) Reset() {
+	*x = SettleTransactionsResponse{}
+	mi := &file_api_proto_transaction_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SettleTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SettleTransactionsResponse) ProtoMessage() {}
+
+func (x *SettleTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_transaction_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SettleTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*SettleTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_transaction_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SettleTransactionsResponse) GetResults() []*SettlementResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *SettleTransactionsResponse) GetSettledCount() int32 {
+	if x != nil {
+		return x.SettledCount
+	}
+	return 0
+}
+
+type IngestTransactionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Client-chosen batch number echoed in the batch's acknowledgement.
+	Sequence    int64  `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	BenchmarkId string `protobuf:"bytes,2,opt,name=benchmark_id,json=benchmarkId,proto3" json:"benchmark_id,omitempty"`
+	// At most 10000 per batch.
+	Transactions  []*IngestTransaction `protobuf:"bytes,3,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IngestTransactionsRequest) Reset() {
+	*x = IngestTransactionsRequest{}
+	mi := &file_api_proto_transaction_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IngestTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IngestTransactionsRequest) ProtoMessage() {}
+
+func (x *IngestTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_transaction_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IngestTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*IngestTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_transaction_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *IngestTransactionsRequest) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *IngestTransactionsRequest) GetBenchmarkId() string {
+	if x != nil {
+		return x.BenchmarkId
+	}
+	return ""
+}
+
+func (x *IngestTransactionsRequest) GetTransactions() []*IngestTransaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+type IngestTransaction struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Validated like CreateTransactionRequest: the hash is computed when empty
+	// and verified otherwise, and addresses are checked and checksummed.
+	Hash        string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	FromAddress string `protobuf:"bytes,2,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	ToAddress   string `protobuf:"bytes,3,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	Amount      int64  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	GasPrice    int64  `protobuf:"varint,5,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
+	GasLimit    int64  `protobuf:"varint,6,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	Nonce       int64  `protobuf:"varint,7,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// RFC 3339 with sub-second precision; defaults to when the server
+	// received the batch.
+	SubmittedAt   string `protobuf:"bytes,8,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IngestTransaction) Reset() {
+	*x = IngestTransaction{}
+	mi := &file_api_proto_transaction_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IngestTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IngestTransaction) ProtoMessage() {}
+
+func (x *IngestTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_transaction_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IngestTransaction.ProtoReflect.Descriptor instead.
+func (*IngestTransaction) Descriptor() ([]byte, []int) {
+	return file_api_proto_transaction_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *IngestTransaction) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *IngestTransaction) GetFromAddress() string {
+	if x != nil {
+		return x.FromAddress
+	}
+	return ""
+}
+
+func (x *IngestTransaction) GetToAddress() string {
+	if x != nil {
+		return x.ToAddress
+	}
+	return ""
+}
+
+func (x *IngestTransaction) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *IngestTransaction) GetGasPrice() int64 {
+	if x != nil {
+		return x.GasPrice
+	}
+	return 0
+}
+
+func (x *IngestTransaction) GetGasLimit() int64 {
+	if x != nil {
+		return x.GasLimit
+	}
+	return 0
+}
+
+func (x *IngestTransaction) GetNonce() int64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+func (x *IngestTransaction) GetSubmittedAt() string {
+	if x != nil {
+		return x.SubmittedAt
+	}
+	return ""
+}
+
+type RejectedTransaction struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Position of the transaction in its batch.
+	Index         int32  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Hash          string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectedTransaction) Reset() {
+	*x = RejectedTransaction{}
+	mi := &file_api_proto_transaction_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectedTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectedTransaction) ProtoMessage() {}
+
+func (x *RejectedTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_transaction_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectedTransaction.ProtoReflect.Descriptor instead.
+func (*RejectedTransaction) Descriptor() ([]byte, []int) {
+	return file_api_proto_transaction_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RejectedTransaction) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *RejectedTransaction) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *RejectedTransaction) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// IngestAck reports the outcome of one batch. Acks arrive in the order the
+// batches were sent.
+type IngestAck struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sequence      int64                  `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	AcceptedCount int32                  `protobuf:"varint,2,opt,name=accepted_count,json=acceptedCount,proto3" json:"accepted_count,omitempty"`
+	Rejected      []*RejectedTransaction `protobuf:"bytes,3,rep,name=rejected,proto3" json:"rejected,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IngestAck) Reset() {
+	*x = IngestAck{}
+	mi := &file_api_proto_transaction_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IngestAck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IngestAck) ProtoMessage() {}
+
+func (x *IngestAck) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_transaction_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IngestAck.ProtoReflect.Descriptor instead.
+func (*IngestAck) Descriptor() ([]byte, []int) {
+	return file_api_proto_transaction_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *IngestAck) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *IngestAck) GetAcceptedCount() int32 {
+	if x != nil {
+		return x.AcceptedCount
+	}
+	return 0
+}
+
+func (x *IngestAck) GetRejected() []*RejectedTransaction {
+	if x != nil {
+		return x.Rejected
+	}
+	return nil
+}
+
+var File_api_proto_transaction_proto protoreflect.FileDescriptor
+
+const file_api_proto_transaction_proto_rawDesc = "" +
+	"\n" +
+	"\x1bapi/proto/transaction.proto\x12\x12hcp.transaction.v1\x1a\x16api/proto/common.proto\"\x9a\x04\n" +
+	"\vTransaction\x12\x12\n" +
+	"\x04hash\x18\x01 \x01(\tR\x04hash\x12!\n" +
+	"\ffrom_address\x18\x02 \x01(\tR\vfromAddress\x12\x1d\n" +
+	"\n" +
+	"to_address\x18\x03 \x01(\tR\ttoAddress\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x03R\x06amount\x12\x1b\n" +
+	"\tgas_price\x18\x05 \x01(\x03R\bgasPrice\x12\x1b\n" +
+	"\tgas_limit\x18\x06 \x01(\x03R\bgasLimit\x12\x19\n" +
+	"\bgas_used\x18\a \x01(\x03R\agasUsed\x12\x14\n" +
+	"\x05nonce\x18\b \x01(\x03R\x05nonce\x12!\n" +
+	"\fblock_number\x18\t \x01(\x03R\vblockNumber\x12\x1d\n" +
+	"\n" +
+	"block_hash\x18\n" +
+	" \x01(\tR\tblockHash\x12+\n" +
+	"\x11transaction_index\x18\v \x01(\x05R\x10transactionIndex\x12\x16\n" +
+	"\x06status\x18\f \x01(\tR\x06status\x12#\n" +
+	"\rerror_message\x18\r \x01(\tR\ferrorMessage\x12!\n" +
+	"\fsubmitted_at\x18\x0e \x01(\tR\vsubmittedAt\x12!\n" +
+	"\fconfirmed_at\x18\x0f \x01(\tR\vconfirmedAt\x12\x1d\n" +
+	"\n" +
+	"latency_ms\x18\x10 \x01(\x01R\tlatencyMs\x12!\n" +
+	"\fbenchmark_id\x18\x11 \x01(\tR\vbenchmarkId\"\x9e\x02\n" +
+	"\x18CreateTransactionRequest\x12!\n" +
+	"\ffrom_address\x18\x01 \x01(\tR\vfromAddress\x12\x1d\n" +
+	"\n" +
+	"to_address\x18\x02 \x01(\tR\ttoAddress\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x03R\x06amount\x12!\n" +
+	"\fbenchmark_id\x18\x04 \x01(\tR\vbenchmarkId\x12\x12\n" +
+	"\x04hash\x18\x05 \x01(\tR\x04hash\x12\x19\n" +
+	"\x05nonce\x18\x06 \x01(\x03H\x00R\x05nonce\x88\x01\x01\x12\x1b\n" +
+	"\tgas_price\x18\a \x01(\x03R\bgasPrice\x12\x1b\n" +
+	"\tgas_limit\x18\b \x01(\x03R\bgasLimitB\b\n" +
+	"\x06_nonceJ\x04\b\t\x10\n" +
+	"R\fassign_nonce\"^\n" +
+	"\x19CreateTransactionResponse\x12A\n" +
+	"\vtransaction\x18\x01 \x01(\v2\x1f.hcp.transaction.v1.TransactionR\vtransaction\"+\n" +
+	"\x15GetTransactionRequest\x12\x12\n" +
+	"\x04hash\x18\x01 \x01(\tR\x04hash\"[\n" +
+	"\x16GetTransactionResponse\x12A\n" +
+	"\vtransaction\x18\x01 \x01(\v2\x1f.hcp.transaction.v1.TransactionR\vtransaction\"\xd8\x01\n" +
+	"\x17ListTransactionsRequest\x12!\n" +
+	"\fbenchmark_id\x18\x01 \x01(\tR\vbenchmarkId\x12!\n" +
+	"\ffrom_address\x18\x02 \x01(\tR\vfromAddress\x12\x1d\n" +
+	"\n" +
+	"to_address\x18\x03 \x01(\tR\ttoAddress\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12@\n" +
+	"\n" +
+	"pagination\x18\x05 \x01(\v2 .hcp.common.v1.PaginationRequestR\n" +
+	"pagination\"\xa2\x01\n" +
+	"\x18ListTransactionsResponse\x12C\n" +
+	"\ftransactions\x18\x01 \x03(\v2\x1f.hcp.transaction.v1.TransactionR\ftransactions\x12A\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2!.hcp.common.v1.PaginationResponseR\n" +
+	"pagination\"n\n" +
+	"\x1aGetTransactionStatsRequest\x12!\n" +
+	"\fbenchmark_id\x18\x01 \x01(\tR\vbenchmarkId\x12-\n" +
+	"\x12include_unmeasured\x18\x02 \x01(\bR\x11includeUnmeasured\"\xff\x02\n" +
+	"\x1bGetTransactionStatsResponse\x12-\n" +
+	"\x12total_transactions\x18\x01 \x01(\x03R\x11totalTransactions\x12#\n" +
+	"\rpending_count\x18\x02 \x01(\x03R\fpendingCount\x12'\n" +
+	"\x0fconfirmed_count\x18\x03 \x01(\x03R\x0econfirmedCount\x12!\n" +
+	"\ffailed_count\x18\x04 \x01(\x03R\vfailedCount\x12$\n" +
+	"\x0eavg_latency_ms\x18\x05 \x01(\x01R\favgLatencyMs\x12\x10\n" +
+	"\x03tps\x18\x06 \x01(\x01R\x03tps\x12!\n" +
+	"\fsender_count\x18\a \x01(\x03R\vsenderCount\x125\n" +
+	"\x17senders_with_nonce_gaps\x18\b \x01(\x03R\x14sendersWithNonceGaps\x12.\n" +
+	"\x13missing_nonce_count\x18\t \x01(\x03R\x11missingNonceCount\"\xa2\x02\n" +
+	"\x19ConfirmTransactionRequest\x12\x12\n" +
+	"\x04hash\x18\x01 \x01(\tR\x04hash\x12!\n" +
+	"\fsubmitted_at\x18\x02 \x01(\tR\vsubmittedAt\x12!\n" +
+	"\fblock_number\x18\x03 \x01(\x03R\vblockNumber\x12\x1d\n" +
+	"\n" +
+	"block_hash\x18\x04 \x01(\tR\tblockHash\x12+\n" +
+	"\x11transaction_index\x18\x05 \x01(\x05R\x10transactionIndex\x12\x19\n" +
+	"\bgas_used\x18\x06 \x01(\x03R\agasUsed\x12!\n" +
+	"\fconfirmed_at\x18\a \x01(\tR\vconfirmedAt\x12!\n" +
+	"\fbenchmark_id\x18\b \x01(\tR\vbenchmarkId\"_\n" +
+	"\x1aConfirmTransactionResponse\x12A\n" +
+	"\vtransaction\x18\x01 \x01(\v2\x1f.hcp.transaction.v1.TransactionR\vtransaction\"\xa1\x02\n" +
+	"\x16FailTransactionRequest\x12\x12\n" +
+	"\x04hash\x18\x01 \x01(\tR\x04hash\x12!\n" +
+	"\fsubmitted_at\x18\x02 \x01(\tR\vsubmittedAt\x12#\n" +
+	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage\x12!\n" +
+	"\fblock_number\x18\x04 \x01(\x03R\vblockNumber\x12\x1d\n" +
+	"\n" +
+	"block_hash\x18\x05 \x01(\tR\tblockHash\x12+\n" +
+	"\x11transaction_index\x18\x06 \x01(\x05R\x10transactionIndex\x12\x19\n" +
+	"\bgas_used\x18\a \x01(\x03R\agasUsed\x12!\n" +
+	"\fbenchmark_id\x18\b \x01(\tR\vbenchmarkId\"\\\n" +
+	"\x17FailTransactionResponse\x12A\n" +
+	"\vtransaction\x18\x01 \x01(\v2\x1f.hcp.transaction.v1.TransactionR\vtransaction\"q\n" +
+	"\x1aConfirmTransactionsRequest\x12S\n" +
+	"\rconfirmations\x18\x01 \x03(\v2-.hcp.transaction.v1.ConfirmTransactionRequestR\rconfirmations\"a\n" +
+	"\x17FailTransactionsRequest\x12F\n" +
+	"\bfailures\x18\x01 \x03(\v2*.hcp.transaction.v1.FailTransactionRequestR\bfailures\"\x7f\n" +
+	"\x10SettlementResult\x12\x12\n" +
+	"\x04hash\x18\x01 \x01(\tR\x04hash\x12A\n" +
+	"\vtransaction\x18\x02 \x01(\v2\x1f.hcp.transaction.v1.TransactionR\vtransaction\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"\x81\x01\n" +
+	"\x1aSettleTransactionsResponse\x12>\n" +
+	"\aresults\x18\x01 \x03(\v2$.hcp.transaction.v1.SettlementResultR\aresults\x12#\n" +
+	"\rsettled_count\x18\x02 \x01(\x05R\fsettledCount\"\xa5\x01\n" +
+	"\x19IngestTransactionsRequest\x12\x1a\n" +
+	"\bsequence\x18\x01 \x01(\x03R\bsequence\x12!\n" +
+	"\fbenchmark_id\x18\x02 \x01(\tR\vbenchmarkId\x12I\n" +
+	"\ftransactions\x18\x03 \x03(\v2%.hcp.transaction.v1.IngestTransactionR\ftransactions\"\xf4\x01\n" +
+	"\x11IngestTransaction\x12\x12\n" +
+	"\x04hash\x18\x01 \x01(\tR\x04hash\x12!\n" +
+	"\ffrom_address\x18\x02 \x01(\tR\vfromAddress\x12\x1d\n" +
+	"\n" +
+	"to_address\x18\x03 \x01(\tR\ttoAddress\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x03R\x06amount\x12\x1b\n" +
+	"\tgas_price\x18\x05 \x01(\x03R\bgasPrice\x12\x1b\n" +
+	"\tgas_limit\x18\x06 \x01(\x03R\bgasLimit\x12\x14\n" +
+	"\x05nonce\x18\a \x01(\x03R\x05nonce\x12!\n" +
+	"\fsubmitted_at\x18\b \x01(\tR\vsubmittedAt\"W\n" +
+	"\x13RejectedTransaction\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x12\n" +
+	"\x04hash\x18\x02 \x01(\tR\x04hash\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\x93\x01\n" +
+	"\tIngestAck\x12\x1a\n" +
+	"\bsequence\x18\x01 \x01(\x03R\bsequence\x12%\n" +
+	"\x0eaccepted_count\x18\x02 \x01(\x05R\racceptedCount\x12C\n" +
+	"\brejected\x18\x03 \x03(\v2'.hcp.transaction.v1.RejectedTransactionR\brejected2\x87\b\n" +
 	"\x12TransactionService\x12p\n" +
 	"\x11CreateTransaction\x12,.hcp.transaction.v1.CreateTransactionRequest\x1a-.hcp.transaction.v1.CreateTransactionResponse\x12g\n" +
 	"\x0eGetTransaction\x12).hcp.transaction.v1.GetTransactionRequest\x1a*.hcp.transaction.v1.GetTransactionResponse\x12m\n" +
 	"\x10ListTransactions\x12+.hcp.transaction.v1.ListTransactionsRequest\x1a,.hcp.transaction.v1.ListTransactionsResponse\x12v\n" +
-	"\x13GetTransactionStats\x12..hcp.transaction.v1.GetTransactionStatsRequest\x1a/.hcp.transaction.v1.GetTransactionStatsResponseB=Z;github.com/fffeng99999/hcp-server/api/generated/transactionb\x06proto3"
+	"\x13GetTransactionStats\x12..hcp.transaction.v1.GetTransactionStatsRequest\x1a/.hcp.transaction.v1.GetTransactionStatsResponse\x12s\n" +
+	"\x12ConfirmTransaction\x12-.hcp.transaction.v1.ConfirmTransactionRequest\x1a..hcp.transaction.v1.ConfirmTransactionResponse\x12j\n" +
+	"\x0fFailTransaction\x12*.hcp.transaction.v1.FailTransactionRequest\x1a+.hcp.transaction.v1.FailTransactionResponse\x12u\n" +
+	"\x13ConfirmTransactions\x12..hcp.transaction.v1.ConfirmTransactionsRequest\x1a..hcp.transaction.v1.SettleTransactionsResponse\x12o\n" +
+	"\x10FailTransactions\x12+.hcp.transaction.v1.FailTransactionsRequest\x1a..hcp.transaction.v1.SettleTransactionsResponse\x12f\n" +
+	"\x12IngestTransactions\x12-.hcp.transaction.v1.IngestTransactionsRequest\x1a\x1d.hcp.transaction.v1.IngestAck(\x010\x01B=Z;github.com/fffeng99999/hcp-server/api/generated/transactionb\x06proto3"
 
 var (
 	file_api_proto_transaction_proto_rawDescOnce sync.Once
@@ -730,7 +1667,7 @@ func file_api_proto_transaction_proto_rawDescGZIP() []byte {
 	return file_api_proto_transaction_proto_rawDescData
 }
 
-var file_api_proto_transaction_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_api_proto_transaction_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
 var file_api_proto_transaction_proto_goTypes = []any{
 	(*Transaction)(nil),                 // 0: hcp.transaction.v1.Transaction
 	(*CreateTransactionRequest)(nil),    // 1: hcp.transaction.v1.CreateTransactionRequest
@@ -741,28 +1678,58 @@ var file_api_proto_transaction_proto_goTypes = []any{
 	(*ListTransactionsResponse)(nil),    // 6: hcp.transaction.v1.ListTransactionsResponse
 	(*GetTransactionStatsRequest)(nil),  // 7: hcp.transaction.v1.GetTransactionStatsRequest
 	(*GetTransactionStatsResponse)(nil), // 8: hcp.transaction.v1.GetTransactionStatsResponse
-	(*common.PaginationRequest)(nil),    // 9: hcp.common.v1.PaginationRequest
-	(*common.PaginationResponse)(nil),   // 10: hcp.common.v1.PaginationResponse
+	(*ConfirmTransactionRequest)(nil),   // 9: hcp.transaction.v1.ConfirmTransactionRequest
+	(*ConfirmTransactionResponse)(nil),  // 10: hcp.transaction.v1.ConfirmTransactionResponse
+	(*FailTransactionRequest)(nil),      // 11: hcp.transaction.v1.FailTransactionRequest
+	(*FailTransactionResponse)(nil),     // 12: hcp.transaction.v1.FailTransactionResponse
+	(*ConfirmTransactionsRequest)(nil),  // 13: hcp.transaction.v1.ConfirmTransactionsRequest
+	(*FailTransactionsRequest)(nil),     // 14: hcp.transaction.v1.FailTransactionsRequest
+	(*SettlementResult)(nil),            // 15: hcp.transaction.v1.SettlementResult
+	(*SettleTransactionsResponse)(nil),  // 16: hcp.transaction.v1.SettleTransactionsResponse
+	(*IngestTransactionsRequest)(nil),   // 17: hcp.transaction.v1.IngestTransactionsRequest
+	(*IngestTransaction)(nil),           // 18: hcp.transaction.v1.IngestTransaction
+	(*RejectedTransaction)(nil),         // 19: hcp.transaction.v1.RejectedTransaction
+	(*IngestAck)(nil),                   // 20: hcp.transaction.v1.IngestAck
+	(*common.PaginationRequest)(nil),    // 21: hcp.common.v1.PaginationRequest
+	(*common.PaginationResponse)(nil),   // 22: hcp.common.v1.PaginationResponse
 }
 var file_api_proto_transaction_proto_depIdxs = []int32{
 	0,  // 0: hcp.transaction.v1.CreateTransactionResponse.transaction:type_name -> hcp.transaction.v1.Transaction
 	0,  // 1: hcp.transaction.v1.GetTransactionResponse.transaction:type_name -> hcp.transaction.v1.Transaction
-	9,  // 2: hcp.transaction.v1.ListTransactionsRequest.pagination:type_name -> hcp.common.v1.PaginationRequest
+	21, // 2: hcp.transaction.v1.ListTransactionsRequest.pagination:type_name -> hcp.common.v1.PaginationRequest
 	0,  // 3: hcp.transaction.v1.ListTransactionsResponse.transactions:type_name -> hcp.transaction.v1.Transaction
-	10, // 4: hcp.transaction.v1.ListTransactionsResponse.pagination:type_name -> hcp.common.v1.PaginationResponse
-	1,  // 5: hcp.transaction.v1.TransactionService.CreateTransaction:input_type -> hcp.transaction.v1.CreateTransactionRequest
-	3,  // 6: hcp.transaction.v1.TransactionService.GetTransaction:input_type -> hcp.transaction.v1.GetTransactionRequest
-	5,  // 7: hcp.transaction.v1.TransactionService.ListTransactions:input_type -> hcp.transaction.v1.ListTransactionsRequest
-	7,  // 8: hcp.transaction.v1.TransactionService.GetTransactionStats:input_type -> hcp.transaction.v1.GetTransactionStatsRequest
-	2,  // 9: hcp.transaction.v1.TransactionService.CreateTransaction:output_type -> hcp.transaction.v1.CreateTransactionResponse
-	4,  // 10: hcp.transaction.v1.TransactionService.GetTransaction:output_type -> hcp.transaction.v1.GetTransactionResponse
-	6,  // 11: hcp.transaction.v1.TransactionService.ListTransactions:output_type -> hcp.transaction.v1.ListTransactionsResponse
-	8,  // 12: hcp.transaction.v1.TransactionService.GetTransactionStats:output_type -> hcp.transaction.v1.GetTransactionStatsResponse
-	9,  // [9:13] is the sub-list for method output_type
-	5,  // [5:9] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	22, // 4: hcp.transaction.v1.ListTransactionsResponse.pagination:type_name -> hcp.common.v1.PaginationResponse
+	0,  // 5: hcp.transaction.v1.ConfirmTransactionResponse.transaction:type_name -> hcp.transaction.v1.Transaction
+	0,  // 6: hcp.transaction.v1.FailTransactionResponse.transaction:type_name -> hcp.transaction.v1.Transaction
+	9,  // 7: hcp.transaction.v1.ConfirmTransactionsRequest.confirmations:type_name -> hcp.transaction.v1.ConfirmTransactionRequest
+	11, // 8: hcp.transaction.v1.FailTransactionsRequest.failures:type_name -> hcp.transaction.v1.FailTransactionRequest
+	0,  // 9: hcp.transaction.v1.SettlementResult.transaction:type_name -> hcp.transaction.v1.Transaction
+	15, // 10: hcp.transaction.v1.SettleTransactionsResponse.results:type_name -> hcp.transaction.v1.SettlementResult
+	18, // 11: hcp.transaction.v1.IngestTransactionsRequest.transactions:type_name -> hcp.transaction.v1.IngestTransaction
+	19, // 12: hcp.transaction.v1.IngestAck.rejected:type_name -> hcp.transaction.v1.RejectedTransaction
+	1,  // 13: hcp.transaction.v1.TransactionService.CreateTransaction:input_type -> hcp.transaction.v1.CreateTransactionRequest
+	3,  // 14: hcp.transaction.v1.TransactionService.GetTransaction:input_type -> hcp.transaction.v1.GetTransactionRequest
+	5,  // 15: hcp.transaction.v1.TransactionService.ListTransactions:input_type -> hcp.transaction.v1.ListTransactionsRequest
+	7,  // 16: hcp.transaction.v1.TransactionService.GetTransactionStats:input_type -> hcp.transaction.v1.GetTransactionStatsRequest
+	9,  // 17: hcp.transaction.v1.TransactionService.ConfirmTransaction:input_type -> hcp.transaction.v1.ConfirmTransactionRequest
+	11, // 18: hcp.transaction.v1.TransactionService.FailTransaction:input_type -> hcp.transaction.v1.FailTransactionRequest
+	13, // 19: hcp.transaction.v1.TransactionService.ConfirmTransactions:input_type -> hcp.transaction.v1.ConfirmTransactionsRequest
+	14, // 20: hcp.transaction.v1.TransactionService.FailTransactions:input_type -> hcp.transaction.v1.FailTransactionsRequest
+	17, // 21: hcp.transaction.v1.TransactionService.IngestTransactions:input_type -> hcp.transaction.v1.IngestTransactionsRequest
+	2,  // 22: hcp.transaction.v1.TransactionService.CreateTransaction:output_type -> hcp.transaction.v1.CreateTransactionResponse
+	4,  // 23: hcp.transaction.v1.TransactionService.GetTransaction:output_type -> hcp.transaction.v1.GetTransactionResponse
+	6,  // 24: hcp.transaction.v1.TransactionService.ListTransactions:output_type -> hcp.transaction.v1.ListTransactionsResponse
+	8,  // 25: hcp.transaction.v1.TransactionService.GetTransactionStats:output_type -> hcp.transaction.v1.GetTransactionStatsResponse
+	10, // 26: hcp.transaction.v1.TransactionService.ConfirmTransaction:output_type -> hcp.transaction.v1.ConfirmTransactionResponse
+	12, // 27: hcp.transaction.v1.TransactionService.FailTransaction:output_type -> hcp.transaction.v1.FailTransactionResponse
+	16, // 28: hcp.transaction.v1.TransactionService.ConfirmTransactions:output_type -> hcp.transaction.v1.SettleTransactionsResponse
+	16, // 29: hcp.transaction.v1.TransactionService.FailTransactions:output_type -> hcp.transaction.v1.SettleTransactionsResponse
+	20, // 30: hcp.transaction.v1.TransactionService.IngestTransactions:output_type -> hcp.transaction.v1.IngestAck
+	22, // [22:31] is the sub-list for method output_type
+	13, // [13:22] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_api_proto_transaction_proto_init() }
@@ -770,13 +1737,14 @@ func file_api_proto_transaction_proto_init() {
 	if File_api_proto_transaction_proto != nil {
 		return
 	}
+	file_api_proto_transaction_proto_msgTypes[1].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_transaction_proto_rawDesc), len(file_api_proto_transaction_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   21,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
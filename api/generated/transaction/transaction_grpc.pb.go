@@ -23,6 +23,11 @@ const (
 	TransactionService_GetTransaction_FullMethodName      = "/hcp.transaction.v1.TransactionService/GetTransaction"
 	TransactionService_ListTransactions_FullMethodName    = "/hcp.transaction.v1.TransactionService/ListTransactions"
 	TransactionService_GetTransactionStats_FullMethodName = "/hcp.transaction.v1.TransactionService/GetTransactionStats"
+	TransactionService_ConfirmTransaction_FullMethodName  = "/hcp.transaction.v1.TransactionService/ConfirmTransaction"
+	TransactionService_FailTransaction_FullMethodName     = "/hcp.transaction.v1.TransactionService/FailTransaction"
+	TransactionService_ConfirmTransactions_FullMethodName = "/hcp.transaction.v1.TransactionService/ConfirmTransactions"
+	TransactionService_FailTransactions_FullMethodName    = "/hcp.transaction.v1.TransactionService/FailTransactions"
+	TransactionService_IngestTransactions_FullMethodName  = "/hcp.transaction.v1.TransactionService/IngestTransactions"
 )
 
 // TransactionServiceClient is the client API for TransactionService service.
@@ -33,6 +38,15 @@ type TransactionServiceClient interface {
 	GetTransaction(ctx context.Context, in *GetTransactionRequest, opts ...grpc.CallOption) (*GetTransactionResponse, error)
 	ListTransactions(ctx context.Context, in *ListTransactionsRequest, opts ...grpc.CallOption) (*ListTransactionsResponse, error)
 	GetTransactionStats(ctx context.Context, in *GetTransactionStatsRequest, opts ...grpc.CallOption) (*GetTransactionStatsResponse, error)
+	ConfirmTransaction(ctx context.Context, in *ConfirmTransactionRequest, opts ...grpc.CallOption) (*ConfirmTransactionResponse, error)
+	FailTransaction(ctx context.Context, in *FailTransactionRequest, opts ...grpc.CallOption) (*FailTransactionResponse, error)
+	// Batched variants apply what they can and report every entry's outcome.
+	ConfirmTransactions(ctx context.Context, in *ConfirmTransactionsRequest, opts ...grpc.CallOption) (*SettleTransactionsResponse, error)
+	FailTransactions(ctx context.Context, in *FailTransactionsRequest, opts ...grpc.CallOption) (*SettleTransactionsResponse, error)
+	// IngestTransactions stores streamed batches of transactions in bulk. The
+	// stream is held back while the server's writers catch up, and each batch
+	// is acknowledged once it has been written.
+	IngestTransactions(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[IngestTransactionsRequest, IngestAck], error)
 }
 
 type transactionServiceClient struct {
@@ -83,6 +97,59 @@ func (c *transactionServiceClient) GetTransactionStats(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *transactionServiceClient) ConfirmTransaction(ctx context.Context, in *ConfirmTransactionRequest, opts ...grpc.CallOption) (*ConfirmTransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmTransactionResponse)
+	err := c.cc.Invoke(ctx, TransactionService_ConfirmTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) FailTransaction(ctx context.Context, in *FailTransactionRequest, opts ...grpc.CallOption) (*FailTransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FailTransactionResponse)
+	err := c.cc.Invoke(ctx, TransactionService_FailTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) ConfirmTransactions(ctx context.Context, in *ConfirmTransactionsRequest, opts ...grpc.CallOption) (*SettleTransactionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SettleTransactionsResponse)
+	err := c.cc.Invoke(ctx, TransactionService_ConfirmTransactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) FailTransactions(ctx context.Context, in *FailTransactionsRequest, opts ...grpc.CallOption) (*SettleTransactionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SettleTransactionsResponse)
+	err := c.cc.Invoke(ctx, TransactionService_FailTransactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) IngestTransactions(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[IngestTransactionsRequest, IngestAck], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TransactionService_ServiceDesc.Streams[0], TransactionService_IngestTransactions_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[IngestTransactionsRequest, IngestAck]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TransactionService_IngestTransactionsClient = grpc.BidiStreamingClient[IngestTransactionsRequest, IngestAck]
+
 // TransactionServiceServer is the server API for TransactionService service.
 // All implementations must embed UnimplementedTransactionServiceServer
 // for forward compatibility.
@@ -91,6 +158,15 @@ type TransactionServiceServer interface {
 	GetTransaction(context.Context, *GetTransactionRequest) (*GetTransactionResponse, error)
 	ListTransactions(context.Context, *ListTransactionsRequest) (*ListTransactionsResponse, error)
 	GetTransactionStats(context.Context, *GetTransactionStatsRequest) (*GetTransactionStatsResponse, error)
+	ConfirmTransaction(context.Context, *ConfirmTransactionRequest) (*ConfirmTransactionResponse, error)
+	FailTransaction(context.Context, *FailTransactionRequest) (*FailTransactionResponse, error)
+	// Batched variants apply what they can and report every entry's outcome.
+	ConfirmTransactions(context.Context, *ConfirmTransactionsRequest) (*SettleTransactionsResponse, error)
+	FailTransactions(context.Context, *FailTransactionsRequest) (*SettleTransactionsResponse, error)
+	// IngestTransactions stores streamed batches of transactions in bulk. The
+	// stream is held back while the server's writers catch up, and each batch
+	// is acknowledged once it has been written.
+	IngestTransactions(grpc.BidiStreamingServer[IngestTransactionsRequest, IngestAck]) error
 	mustEmbedUnimplementedTransactionServiceServer()
 }
 
@@ -113,6 +189,21 @@ func (UnimplementedTransactionServiceServer) ListTransactions(context.Context, *
 func (UnimplementedTransactionServiceServer) GetTransactionStats(context.Context, *GetTransactionStatsRequest) (*GetTransactionStatsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetTransactionStats not implemented")
 }
+func (UnimplementedTransactionServiceServer) ConfirmTransaction(context.Context, *ConfirmTransactionRequest) (*ConfirmTransactionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ConfirmTransaction not implemented")
+}
+func (UnimplementedTransactionServiceServer) FailTransaction(context.Context, *FailTransactionRequest) (*FailTransactionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method FailTransaction not implemented")
+}
+func (UnimplementedTransactionServiceServer) ConfirmTransactions(context.Context, *ConfirmTransactionsRequest) (*SettleTransactionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ConfirmTransactions not implemented")
+}
+func (UnimplementedTransactionServiceServer) FailTransactions(context.Context, *FailTransactionsRequest) (*SettleTransactionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method FailTransactions not implemented")
+}
+func (UnimplementedTransactionServiceServer) IngestTransactions(grpc.BidiStreamingServer[IngestTransactionsRequest, IngestAck]) error {
+	return status.Error(codes.Unimplemented, "method IngestTransactions not implemented")
+}
 func (UnimplementedTransactionServiceServer) mustEmbedUnimplementedTransactionServiceServer() {}
 func (UnimplementedTransactionServiceServer) testEmbeddedByValue()                            {}
 
@@ -206,6 +297,85 @@ func _TransactionService_GetTransactionStats_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TransactionService_ConfirmTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).ConfirmTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_ConfirmTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).ConfirmTransaction(ctx, req.(*ConfirmTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_FailTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FailTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).FailTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_FailTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).FailTransaction(ctx, req.(*FailTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_ConfirmTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).ConfirmTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_ConfirmTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).ConfirmTransactions(ctx, req.(*ConfirmTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_FailTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FailTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).FailTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_FailTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).FailTransactions(ctx, req.(*FailTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_IngestTransactions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(TransactionServiceServer).IngestTransactions(&grpc.GenericServerStream[IngestTransactionsRequest, IngestAck]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TransactionService_IngestTransactionsServer = grpc.BidiStreamingServer[IngestTransactionsRequest, IngestAck]
+
 // TransactionService_ServiceDesc is the grpc.ServiceDesc for TransactionService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -229,7 +399,30 @@ var TransactionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTransactionStats",
 			Handler:    _TransactionService_GetTransactionStats_Handler,
 		},
+		{
+			MethodName: "ConfirmTransaction",
+			Handler:    _TransactionService_ConfirmTransaction_Handler,
+		},
+		{
+			MethodName: "FailTransaction",
+			Handler:    _TransactionService_FailTransaction_Handler,
+		},
+		{
+			MethodName: "ConfirmTransactions",
+			Handler:    _TransactionService_ConfirmTransactions_Handler,
+		},
+		{
+			MethodName: "FailTransactions",
+			Handler:    _TransactionService_FailTransactions_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "IngestTransactions",
+			Handler:       _TransactionService_IngestTransactions_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/proto/transaction.proto",
 }
@@ -6,6 +6,7 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"sort"
 	"time"
 
 	_ "github.com/lib/pq"
@@ -29,31 +30,34 @@ func main() {
 	}
 	log.Println("Connected successfully.")
 
-	// 1. Run Migration
-	migrationFile := "../../internal/database/migrations/001_init_schema.sql"
-	absPath, _ := filepath.Abs(migrationFile)
-	log.Printf("Reading migration file: %s", absPath)
-
-	content, err := os.ReadFile(migrationFile)
-	if err != nil {
+	// 1. Run Migrations
+	migrationDir := "../../internal/database/migrations"
+	if _, err := os.Stat(migrationDir); err != nil {
 		// Try absolute path based on known structure if relative fails
-		migrationFile = "/home/hcp-dev/hcp-project/hcp-server/internal/database/migrations/001_init_schema.sql"
-		content, err = os.ReadFile(migrationFile)
+		migrationDir = "/home/hcp-dev/hcp-project/hcp-server/internal/database/migrations"
+	}
+	absDir, _ := filepath.Abs(migrationDir)
+	log.Printf("Reading migrations from: %s", absDir)
+
+	// Files are named NNN_description.sql, so lexical order is apply order.
+	migrationFiles, err := filepath.Glob(filepath.Join(migrationDir, "*.sql"))
+	if err != nil || len(migrationFiles) == 0 {
+		log.Fatalf("Failed to find migration files: %v", err)
+	}
+	sort.Strings(migrationFiles)
+
+	// pq driver supports multiple statements, and the schema files use $$ for
+	// functions, so each file is executed as a single block.
+	for _, migrationFile := range migrationFiles {
+		content, err := os.ReadFile(migrationFile)
 		if err != nil {
 			log.Fatalf("Failed to read migration file: %v", err)
 		}
-	}
-
-	// Split by semicolon might be too simple if functions have semicolons, but the schema file
-	// uses $$ for functions, so internal semicolons are safe if we execute the whole block?
-	// Postgres driver might not support multiple statements in one Exec.
-	// Let's try executing the whole string first.
-	// pq driver supports multiple statements.
 
-	log.Println("Executing migration SQL...")
-	_, err = db.Exec(string(content))
-	if err != nil {
-		log.Fatalf("Failed to execute migration: %v", err)
+		log.Printf("Executing migration %s...", filepath.Base(migrationFile))
+		if _, err := db.Exec(string(content)); err != nil {
+			log.Fatalf("Failed to execute migration %s: %v", filepath.Base(migrationFile), err)
+		}
 	}
 	log.Println("Migration completed.")
 
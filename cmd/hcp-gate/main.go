@@ -0,0 +1,272 @@
+// Command hcp-gate compares a candidate benchmark against a baseline and exits
+// non-zero when a performance threshold is violated, so CI pipelines can block
+// merges on regressions.
+//
+//	hcp-gate -baseline-group nightly-tpbft -candidate <id> \
+//	    -rule latency_p99:max_increase=10% -rule actual_tps:min_ratio=0.95
+//
+// The baseline is a benchmark ID, or the latest completed run of a run group
+// or of a named experiment.
+//
+// Exit status is 0 when every rule passes, 1 when a rule fails and 2 on errors.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	pb "github.com/fffeng99999/hcp-server/api/generated/benchmark"
+	common "github.com/fffeng99999/hcp-server/api/generated/common"
+	"github.com/fffeng99999/hcp-server/internal/config"
+	"github.com/fffeng99999/hcp-server/internal/database"
+	"github.com/fffeng99999/hcp-server/internal/gate"
+	"github.com/fffeng99999/hcp-server/internal/models"
+	"github.com/fffeng99999/hcp-server/internal/repository"
+	"github.com/fffeng99999/hcp-server/internal/service"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"gorm.io/gorm"
+)
+
+const (
+	exitPass  = 0
+	exitFail  = 1
+	exitError = 2
+)
+
+// source resolves benchmarks and collects the metrics to compare.
+type source interface {
+	// latestCompleted returns the newest completed run of a run group or,
+	// if runGroup is empty, of the experiments with the given name,
+	// skipping excludeID so the candidate is never its own baseline.
+	latestCompleted(ctx context.Context, runGroup, experiment, excludeID string) (string, error)
+	observe(ctx context.Context, baselineID, candidateID string) (map[string]gate.Observation, error)
+	close() error
+}
+
+type ruleFlags []gate.Rule
+
+func (r *ruleFlags) String() string {
+	parts := make([]string, len(*r))
+	for i, rule := range *r {
+		parts[i] = rule.Metric + ":" + rule.Kind
+	}
+	return strings.Join(parts, ",")
+}
+
+func (r *ruleFlags) Set(s string) error {
+	rule, err := gate.ParseRule(s)
+	if err != nil {
+		return err
+	}
+	*r = append(*r, rule)
+	return nil
+}
+
+func main() {
+	os.Exit(run())
+}
+
+func run() int {
+	var rules ruleFlags
+	baselineID := flag.String("baseline", "", "Baseline benchmark ID")
+	baselineGroup := flag.String("baseline-group", "", "Use the latest completed benchmark of this run group as baseline")
+	baselineExperiment := flag.String("baseline-experiment", "", "Use the latest completed benchmark of the experiment with this name as baseline")
+	candidateID := flag.String("candidate", "", "Candidate benchmark ID")
+	addr := flag.String("addr", "localhost:8081", "hcp-server gRPC address")
+	offline := flag.Bool("offline", false, "Read the database directly instead of calling the gRPC API")
+	configDir := flag.String("config", "configs", "Path to config directory (offline mode)")
+	timeout := flag.Duration("timeout", time.Minute, "Overall timeout")
+	flag.Var(&rules, "rule", "Threshold as <metric>:<kind>=<value>, repeatable (e.g. latency_p99:max_increase=10%)")
+	flag.Parse()
+
+	baselines := 0
+	for _, b := range []string{*baselineID, *baselineGroup, *baselineExperiment} {
+		if b != "" {
+			baselines++
+		}
+	}
+	if *candidateID == "" || baselines != 1 {
+		fmt.Fprintln(os.Stderr, "hcp-gate: -candidate and exactly one of -baseline, -baseline-group or -baseline-experiment are required")
+		flag.Usage()
+		return exitError
+	}
+	if len(rules) == 0 {
+		rules = gate.DefaultRules
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	var src source
+	var err error
+	if *offline {
+		src, err = newOfflineSource(*configDir)
+	} else {
+		src, err = newGRPCSource(*addr)
+	}
+	if err != nil {
+		return fail(err)
+	}
+	defer src.close()
+
+	if *baselineID == "" {
+		if *baselineID, err = src.latestCompleted(ctx, *baselineGroup, *baselineExperiment, *candidateID); err != nil {
+			return fail(err)
+		}
+	}
+
+	observations, err := src.observe(ctx, *baselineID, *candidateID)
+	if err != nil {
+		return fail(err)
+	}
+	results, err := gate.Evaluate(rules, observations)
+	if err != nil {
+		return fail(err)
+	}
+
+	report := &gate.Report{BaselineID: *baselineID, CandidateID: *candidateID, Results: results}
+	if err := report.WriteTable(os.Stdout); err != nil {
+		return fail(err)
+	}
+	if !report.Passed() {
+		return exitFail
+	}
+	return exitPass
+}
+
+func fail(err error) int {
+	fmt.Fprintf(os.Stderr, "hcp-gate: %v\n", err)
+	return exitError
+}
+
+// noCompletedRun describes the failure to find a baseline.
+func noCompletedRun(runGroup, experiment string) error {
+	if runGroup != "" {
+		return fmt.Errorf("no completed benchmark in run group %q", runGroup)
+	}
+	return fmt.Errorf("no completed benchmark in experiment %q", experiment)
+}
+
+type grpcSource struct {
+	conn   *grpc.ClientConn
+	client pb.BenchmarkServiceClient
+}
+
+func newGRPCSource(addr string) (*grpcSource, error) {
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s: %w", addr, err)
+	}
+	return &grpcSource{conn: conn, client: pb.NewBenchmarkServiceClient(conn)}, nil
+}
+
+func (s *grpcSource) latestCompleted(ctx context.Context, runGroup, experiment, excludeID string) (string, error) {
+	// Two rows are enough: at most one of them is the excluded candidate.
+	resp, err := s.client.ListBenchmarks(ctx, &pb.ListBenchmarksRequest{
+		Pagination: &common.PaginationRequest{Page: 1, PageSize: 2},
+		RunGroup:   runGroup,
+		Experiment: experiment,
+		Status:     models.BenchmarkStatusCompleted,
+	})
+	if err != nil {
+		return "", err
+	}
+	for _, b := range resp.Benchmarks {
+		if b.Id != excludeID {
+			return b.Id, nil
+		}
+	}
+	return "", noCompletedRun(runGroup, experiment)
+}
+
+func (s *grpcSource) close() error {
+	return s.conn.Close()
+}
+
+func (s *grpcSource) observe(ctx context.Context, baselineID, candidateID string) (map[string]gate.Observation, error) {
+	resp, err := s.client.CompareBenchmarks(ctx, &pb.CompareBenchmarksRequest{Ids: []string{baselineID, candidateID}})
+	if err != nil {
+		return nil, err
+	}
+
+	observations := make(map[string]gate.Observation, len(resp.Metrics))
+	for _, m := range resp.Metrics {
+		observations[m.Name] = gate.Observation{
+			Metric:    m.Name,
+			Unit:      m.Unit,
+			Baseline:  m.Values[0].Value,
+			Candidate: m.Values[1].Value,
+		}
+	}
+	return observations, nil
+}
+
+type offlineSource struct {
+	db            *gorm.DB
+	benchmarkRepo repository.BenchmarkRepository
+	comparator    service.BenchmarkComparator
+}
+
+func newOfflineSource(configDir string) (*offlineSource, error) {
+	cfg, err := config.LoadConfig(configDir)
+	if err != nil {
+		return nil, err
+	}
+	db, err := database.NewPostgresDB(cfg.Database)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	benchmarkRepo := repository.NewBenchmarkRepository(db)
+	finalizer := service.NewBenchmarkFinalizer(benchmarkRepo, repository.NewTransactionRepository(db), repository.NewMetricRepository(db))
+	return &offlineSource{
+		db:            db,
+		benchmarkRepo: benchmarkRepo,
+		comparator:    service.NewBenchmarkComparator(benchmarkRepo, finalizer),
+	}, nil
+}
+
+func (s *offlineSource) latestCompleted(ctx context.Context, runGroup, experiment, excludeID string) (string, error) {
+	filter := repository.BenchmarkFilter{RunGroup: runGroup, Experiment: experiment, Status: models.BenchmarkStatusCompleted}
+	benchmarks, _, err := s.benchmarkRepo.List(ctx, filter, 1, 2)
+	if err != nil {
+		return "", err
+	}
+	for _, b := range benchmarks {
+		if id := b.ID.String(); id != excludeID {
+			return id, nil
+		}
+	}
+	return "", noCompletedRun(runGroup, experiment)
+}
+
+func (s *offlineSource) close() error {
+	sqlDB, err := s.db.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.Close()
+}
+
+func (s *offlineSource) observe(ctx context.Context, baselineID, candidateID string) (map[string]gate.Observation, error) {
+	comparison, err := s.comparator.Compare(ctx, []string{baselineID, candidateID})
+	if err != nil {
+		return nil, err
+	}
+
+	observations := make(map[string]gate.Observation, len(comparison.Dimensions))
+	for _, d := range comparison.Dimensions {
+		observations[d.Name] = gate.Observation{
+			Metric:    d.Name,
+			Unit:      d.Unit,
+			Baseline:  d.Values[0].Value,
+			Candidate: d.Values[1].Value,
+		}
+	}
+	return observations, nil
+}
@@ -0,0 +1,280 @@
+// Command hcp-loadgen submits transactions for a benchmark through the
+// server's gRPC transaction service, which stamps each as it arrives and
+// updates the run's live progress.
+//
+//	hcp-loadgen -benchmark <id> -mode open -ramp linear -ramp-duration 30s
+//	hcp-loadgen -benchmark <id> -mode closed -clients 50 -addresses 1000
+//
+// Open-loop runs default to the benchmark's target TPS and both modes to its
+// full length, warmup and cooldown included. By default the generator waits
+// for the run to leave the queue. It stops early when the run finishes, at the
+// latest when the server rejects a transaction for the finished run.
+//
+// Exit status is 0 when every transaction was recorded, 1 when some were not
+// and 2 on errors.
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	benchmarkpb "github.com/fffeng99999/hcp-server/api/generated/benchmark"
+	transactionpb "github.com/fffeng99999/hcp-server/api/generated/transaction"
+	"github.com/fffeng99999/hcp-server/internal/loadgen"
+	"github.com/fffeng99999/hcp-server/internal/models"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	exitOK      = 0
+	exitPartial = 1
+	exitError   = 2
+)
+
+// pollInterval is how often the benchmark's status is checked.
+const pollInterval = time.Second
+
+func main() {
+	os.Exit(run())
+}
+
+func run() int {
+	benchmarkID := flag.String("benchmark", "", "Benchmark ID to submit transactions for")
+	mode := flag.String("mode", loadgen.ModeOpen, "Load mode: open (fixed rate) or closed (fixed concurrency)")
+	rate := flag.Float64("rate", 0, "Open-loop rate in tx/s (default: the benchmark's target TPS)")
+	clients := flag.Int("clients", 10, "Closed-loop client count")
+	workers := flag.Int("workers", 64, "Open-loop submissions in flight at most")
+	duration := flag.Duration("duration", 0, "How long to generate load (default: the benchmark's warmup, duration and cooldown)")
+	ramp := flag.String("ramp", loadgen.RampNone, "Ramp-up profile: none, linear or step")
+	rampDuration := flag.Duration("ramp-duration", 0, "Time to reach full load")
+	rampSteps := flag.Int("ramp-steps", 4, "Number of steps of the step ramp")
+	addressCount := flag.Int("addresses", 100, "Size of the generated address pool")
+	addressFile := flag.String("address-file", "", "File with one address per line to use instead of generated ones")
+	seed := flag.Int64("seed", 1, "Seed for generated addresses and the choice of senders")
+	minAmount := flag.Int64("min-amount", 1, "Smallest transfer amount in wei")
+	maxAmount := flag.Int64("max-amount", 1000, "Largest transfer amount in wei")
+	wait := flag.Bool("wait", true, "Wait for the benchmark to start and stop when it finishes")
+	progress := flag.Duration("progress", 5*time.Second, "Interval of progress reports, 0 to disable")
+	addr := flag.String("addr", "localhost:8081", "hcp-server gRPC address")
+	flag.Parse()
+
+	if *benchmarkID == "" {
+		fmt.Fprintln(os.Stderr, "hcp-loadgen: -benchmark is required")
+		flag.Usage()
+		return exitError
+	}
+	id, err := uuid.Parse(*benchmarkID)
+	if err != nil {
+		return fail(fmt.Errorf("invalid benchmark id %q: %w", *benchmarkID, err))
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	conn, err := grpc.NewClient(*addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return fail(fmt.Errorf("failed to connect to %s: %w", *addr, err))
+	}
+	defer conn.Close()
+	benchmarks := benchmarkpb.NewBenchmarkServiceClient(conn)
+	submitter := grpcSubmitter{client: transactionpb.NewTransactionServiceClient(conn)}
+
+	b, err := getBenchmark(ctx, benchmarks, *benchmarkID)
+	if err != nil {
+		return fail(fmt.Errorf("benchmark %s: %w", *benchmarkID, err))
+	}
+	if *rate == 0 {
+		*rate = float64(b.TargetTps)
+	}
+	if *duration == 0 {
+		*duration = time.Duration(b.WarmupDuration+b.Duration+b.CooldownDuration) * time.Second
+	}
+
+	addresses := loadgen.GenerateAddresses(*addressCount, *seed)
+	if *addressFile != "" {
+		if addresses, err = readAddresses(*addressFile); err != nil {
+			return fail(err)
+		}
+	}
+	pool, err := loadgen.NewAddressPool(addresses, *seed)
+	if err != nil {
+		return fail(err)
+	}
+
+	gen, err := loadgen.New(loadgen.Config{
+		BenchmarkID: id,
+		Mode:        *mode,
+		Rate:        *rate,
+		Clients:     *clients,
+		Workers:     *workers,
+		Duration:    *duration,
+		Ramp:        loadgen.Ramp{Profile: *ramp, Duration: *rampDuration, Steps: *rampSteps},
+		MinAmount:   *minAmount,
+		MaxAmount:   *maxAmount,
+	}, pool, submitter)
+	if err != nil {
+		return fail(err)
+	}
+
+	if *wait {
+		if err := waitForStart(ctx, benchmarks, *benchmarkID); err != nil {
+			return fail(err)
+		}
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithCancel(ctx)
+		defer cancel()
+		go stopWhenFinished(ctx, cancel, benchmarks, *benchmarkID)
+	}
+	if *progress > 0 {
+		go report(ctx, gen, *progress)
+	}
+
+	fmt.Printf("generating %s-loop load for benchmark %s for %s\n", *mode, id, *duration)
+	start := time.Now()
+	stats := gen.Run(ctx)
+	elapsed := time.Since(start)
+
+	fmt.Printf("submitted %d, failed %d, dropped %d in %s (%.1f tx/s)\n",
+		stats.Submitted, stats.Failed, stats.Dropped, elapsed.Round(time.Millisecond),
+		float64(stats.Submitted)/elapsed.Seconds())
+	if stats.Rejected > 0 {
+		fmt.Printf("benchmark finished, %d in flight rejected\n", stats.Rejected)
+	}
+	if err := gen.Err(); err != nil {
+		fmt.Fprintf(os.Stderr, "hcp-loadgen: first failure: %v\n", err)
+	}
+	if stats.Failed > 0 || stats.Dropped > 0 {
+		return exitPartial
+	}
+	return exitOK
+}
+
+func fail(err error) int {
+	fmt.Fprintf(os.Stderr, "hcp-loadgen: %v\n", err)
+	return exitError
+}
+
+// grpcSubmitter records transactions through the server's transaction
+// service. The server rejects transactions of a finished benchmark with
+// FailedPrecondition.
+type grpcSubmitter struct {
+	client transactionpb.TransactionServiceClient
+}
+
+func (s grpcSubmitter) Create(ctx context.Context, tx *models.Transaction) (*models.Transaction, error) {
+	_, err := s.client.CreateTransaction(ctx, &transactionpb.CreateTransactionRequest{
+		FromAddress: tx.FromAddress,
+		ToAddress:   tx.ToAddress,
+		Amount:      tx.Amount,
+		BenchmarkId: tx.BenchmarkID.String(),
+		Hash:        tx.Hash,
+		Nonce:       &tx.Nonce,
+		GasPrice:    tx.GasPrice,
+		GasLimit:    tx.GasLimit,
+	})
+	if status.Code(err) == codes.FailedPrecondition {
+		return nil, fmt.Errorf("%w: %v", loadgen.ErrBenchmarkFinished, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+func getBenchmark(ctx context.Context, client benchmarkpb.BenchmarkServiceClient, id string) (*benchmarkpb.Benchmark, error) {
+	resp, err := client.GetBenchmark(ctx, &benchmarkpb.GetBenchmarkRequest{Id: id})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Benchmark, nil
+}
+
+func isTerminal(b *benchmarkpb.Benchmark) bool {
+	return (&models.Benchmark{Status: b.Status}).IsTerminal()
+}
+
+// waitForStart blocks while the benchmark is queued or provisioning.
+func waitForStart(ctx context.Context, client benchmarkpb.BenchmarkServiceClient, id string) error {
+	for {
+		b, err := getBenchmark(ctx, client, id)
+		if err != nil {
+			return err
+		}
+		switch {
+		case isTerminal(b):
+			return fmt.Errorf("benchmark %s already %s", id, b.Status)
+		case b.Status != models.BenchmarkStatusQueued && b.Status != models.BenchmarkStatusProvisioning:
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+// stopWhenFinished cancels the load once the benchmark reaches a final state.
+// Sends that overlap the end are rejected by the server, which also ends the
+// run; polling covers the stretches a ramp sends nothing.
+func stopWhenFinished(ctx context.Context, cancel context.CancelFunc, client benchmarkpb.BenchmarkServiceClient, id string) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if b, err := getBenchmark(ctx, client, id); err == nil && isTerminal(b) {
+			fmt.Printf("benchmark %s, stopping\n", b.Status)
+			cancel()
+			return
+		}
+	}
+}
+
+func report(ctx context.Context, gen *loadgen.Generator, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	var last int64
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		stats := gen.Stats()
+		fmt.Printf("submitted %d (%.1f tx/s), failed %d, dropped %d\n",
+			stats.Submitted, float64(stats.Submitted-last)/interval.Seconds(), stats.Failed, stats.Dropped)
+		last = stats.Submitted
+	}
+}
+
+func readAddresses(path string) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var addresses []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if line := strings.TrimSpace(scanner.Text()); line != "" && !strings.HasPrefix(line, "#") {
+			addresses = append(addresses, line)
+		}
+	}
+	return addresses, scanner.Err()
+}
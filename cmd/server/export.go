@@ -0,0 +1,58 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/fffeng99999/hcp-server/internal/report"
+	"github.com/fffeng99999/hcp-server/internal/service"
+)
+
+// runExport implements the export subcommand:
+//
+//	hcp-server export -id <benchmark> -format html [-bucket 5s] [-out report.html]
+//
+// The report is written to -out, to the suggested file name in the current
+// directory when -out is empty, or to stdout when -out is "-".
+func runExport(ctx context.Context, reporter service.BenchmarkReporter, args []string) error {
+	fs := flag.NewFlagSet("export", flag.ContinueOnError)
+	id := fs.String("id", "", "Benchmark ID")
+	format := fs.String("format", report.FormatHTML, "Report format: "+strings.Join(report.Formats, ", "))
+	bucket := fs.Duration("bucket", 0, "Time series bucket width (default: about 300 points per run)")
+	out := fs.String("out", "", `Output file, "-" for stdout (default: suggested file name)`)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *id == "" {
+		return fmt.Errorf("-id is required")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	defer cancel()
+
+	r, err := reporter.Build(ctx, *id, *bucket)
+	if err != nil {
+		return err
+	}
+	rendered, err := report.Render(r, *format)
+	if err != nil {
+		return err
+	}
+
+	switch *out {
+	case "-":
+		_, err = os.Stdout.Write(rendered.Data)
+		return err
+	case "":
+		*out = rendered.Filename
+	}
+	if err := os.WriteFile(*out, rendered.Data, 0o644); err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "Wrote %s\n", *out)
+	return nil
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"net"
@@ -9,6 +10,7 @@ import (
 	"syscall"
 
 	pb_benchmark "github.com/fffeng99999/hcp-server/api/generated/benchmark"
+	pb_experiment "github.com/fffeng99999/hcp-server/api/generated/experiment"
 	pb_metric "github.com/fffeng99999/hcp-server/api/generated/metric"
 	pb_node "github.com/fffeng99999/hcp-server/api/generated/node"
 	pb_transaction "github.com/fffeng99999/hcp-server/api/generated/transaction"
@@ -19,6 +21,7 @@ import (
 	"github.com/fffeng99999/hcp-server/internal/repository"
 	"github.com/fffeng99999/hcp-server/internal/service"
 	"github.com/fffeng99999/hcp-server/internal/utils"
+	cache "github.com/fffeng99999/hcp-server/storage/redis"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 )
@@ -67,6 +70,11 @@ func main() {
 			&models.Node{},
 			&models.Metric{},
 			&models.Anomaly{},
+			&models.Experiment{},
+			&models.BenchmarkTemplate{},
+			&models.BenchmarkNode{},
+			&models.BenchmarkSchedule{},
+			&models.TransactionNonce{},
 		)
 		if err != nil {
 			utils.Logger.Fatal("Migration failed", zap.Error(err))
@@ -80,12 +88,56 @@ func main() {
 	transactionRepo := repository.NewTransactionRepository(db)
 	nodeRepo := repository.NewNodeRepository(db)
 	metricRepo := repository.NewMetricRepository(db)
+	experimentRepo := repository.NewExperimentRepository(db)
+	templateRepo := repository.NewBenchmarkTemplateRepository(db)
+	benchmarkNodeRepo := repository.NewBenchmarkNodeRepository(db)
+	anomalyRepo := repository.NewAnomalyRepository(db)
+	scheduleRepo := repository.NewBenchmarkScheduleRepository(db)
+	queueRepo := repository.NewBenchmarkQueueRepository(db)
+	rankingRepo := repository.NewBenchmarkRankingRepository(db)
 
 	// 6. Init Services
 	benchmarkService := service.NewBenchmarkService(benchmarkRepo)
-	transactionService := service.NewTransactionService(transactionRepo)
+	monitor := service.NewBenchmarkMonitor(benchmarkRepo, transactionRepo)
+	transactionService := service.NewTransactionService(transactionRepo, benchmarkRepo, monitor)
 	nodeService := service.NewNodeService(nodeRepo)
-	metricService := service.NewMetricService(metricRepo)
+	metricService := service.NewMetricService(metricRepo, benchmarkRepo, monitor)
+	orchestrator := service.NewBenchmarkOrchestrator(benchmarkRepo, cfg.Benchmark)
+	// Pending transactions are failed before watchers get the final frame.
+	orchestrator.Subscribe(transactionService.OnTransition)
+	orchestrator.Subscribe(monitor.OnTransition)
+	finalizer := service.NewBenchmarkFinalizer(benchmarkRepo, transactionRepo, metricRepo)
+	orchestrator.Subscribe(finalizer.OnTransition)
+	queue := service.NewBenchmarkQueue(queueRepo, orchestrator, cfg.Benchmark.Queue)
+	orchestrator.Subscribe(queue.OnTransition)
+	// Without Redis the cache misses every time and rankings are computed
+	// per request.
+	ranking := service.NewBenchmarkRanking(rankingRepo, cache.NewCache(rdb, "hcp:"), cfg.Benchmark.RankingCacheTTL)
+	orchestrator.Subscribe(ranking.OnTransition)
+	comparator := service.NewBenchmarkComparator(benchmarkRepo, finalizer)
+	statistics := service.NewBenchmarkStatistics(benchmarkRepo, transactionRepo)
+	templateService := service.NewBenchmarkTemplateService(templateRepo)
+	benchmarkNodeService := service.NewBenchmarkNodeService(benchmarkRepo, benchmarkNodeRepo)
+	experimentService := service.NewExperimentService(experimentRepo, queue)
+	orchestrator.Subscribe(experimentService.OnTransition)
+	scheduleService := service.NewBenchmarkScheduleService(scheduleRepo, templateService, benchmarkService, queue, cfg.Benchmark.ScheduleInterval)
+	ingester := service.NewTransactionIngester(transactionRepo, benchmarkRepo, monitor, cfg.Transaction.Ingest)
+	reporter := service.NewBenchmarkReporter(benchmarkRepo, transactionRepo, metricRepo, anomalyRepo, benchmarkNodeRepo, finalizer)
+
+	// 6.1 Export a report instead of serving
+	if flag.Arg(0) == "export" {
+		if err := runExport(context.Background(), reporter, flag.Args()[1:]); err != nil {
+			utils.Logger.Fatal("Export failed", zap.Error(err))
+		}
+		return
+	}
+
+	bgCtx, stopBackground := context.WithCancel(context.Background())
+	defer stopBackground()
+	go orchestrator.Run(bgCtx)
+	go scheduleService.Run(bgCtx)
+	go queue.Run(bgCtx)
+	go ingester.Run(bgCtx)
 
 	// 7. Init gRPC Server
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", cfg.Server.Port))
@@ -96,10 +148,13 @@ func main() {
 	s := grpc.NewServer()
 
 	// Register Handlers
-	benchmarkHandler := handlers.NewBenchmarkHandler(benchmarkService)
+	benchmarkHandler := handlers.NewBenchmarkHandler(benchmarkService, orchestrator, finalizer, comparator, statistics, templateService, benchmarkNodeService, reporter, monitor, scheduleService, queue, ranking)
 	pb_benchmark.RegisterBenchmarkServiceServer(s, benchmarkHandler)
 
-	transactionHandler := handlers.NewTransactionHandler(transactionService)
+	experimentHandler := handlers.NewExperimentHandler(experimentService)
+	pb_experiment.RegisterExperimentServiceServer(s, experimentHandler)
+
+	transactionHandler := handlers.NewTransactionHandler(transactionService, ingester)
 	pb_transaction.RegisterTransactionServiceServer(s, transactionHandler)
 
 	nodeHandler := handlers.NewNodeHandler(nodeService)
@@ -122,6 +177,7 @@ func main() {
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 	utils.Logger.Info("Shutting down server...")
+	stopBackground()
 	s.GracefulStop()
 	utils.Logger.Info("Server stopped")
 }
@@ -0,0 +1,149 @@
+// Package chain implements the Ethereum conventions transactions follow:
+// Keccak-256 transaction hashes and EIP-55 checksummed addresses.
+//
+// A transaction's hash is the Keccak-256 of its canonical encoding, the ABI
+// encoding of (bytes16 benchmark_id, address from, address to, uint256
+// amount, uint256 nonce, uint256 gas_price, uint256 gas_limit): seven 32-byte
+// words with the benchmark's UUID bytes right-padded and the addresses
+// left-padded. It equals Solidity's keccak256(abi.encode(benchmarkId, from,
+// to, amount, nonce, gasPrice, gasLimit)), so clients can compute it with any
+// Ethereum library. The benchmark ID keeps the same transfer replayed in
+// another benchmark from sharing a hash.
+package chain
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/fffeng99999/hcp-server/internal/models"
+	"github.com/google/uuid"
+	"golang.org/x/crypto/sha3"
+)
+
+var (
+	ErrInvalidAddress = errors.New("invalid address")
+	ErrInvalidHash    = errors.New("invalid transaction hash")
+	// ErrHashMismatch is returned when a client-supplied hash is not the
+	// hash of the transaction's contents.
+	ErrHashMismatch = errors.New("transaction hash does not match its contents")
+)
+
+const (
+	addressLength = 20
+	hashLength    = 32
+	wordLength    = 32
+)
+
+// Keccak256 returns the legacy Keccak-256 digest Ethereum uses, which differs
+// from the standardized SHA3-256 in its padding.
+func Keccak256(data ...[]byte) []byte {
+	h := sha3.NewLegacyKeccak256()
+	for _, d := range data {
+		h.Write(d)
+	}
+	return h.Sum(nil)
+}
+
+// ParseAddress decodes a 0x-prefixed 40-digit hex address. Addresses in mixed
+// case must carry a valid EIP-55 checksum; all-lowercase and all-uppercase
+// ones carry none and are accepted as is.
+func ParseAddress(s string) ([addressLength]byte, error) {
+	var addr [addressLength]byte
+	digits, ok := strings.CutPrefix(s, "0x")
+	if !ok || len(digits) != 2*addressLength {
+		return addr, fmt.Errorf("%w %q: want 0x followed by 40 hex digits", ErrInvalidAddress, s)
+	}
+	if _, err := hex.Decode(addr[:], []byte(digits)); err != nil {
+		return addr, fmt.Errorf("%w %q: want 0x followed by 40 hex digits", ErrInvalidAddress, s)
+	}
+	if digits != strings.ToLower(digits) && digits != strings.ToUpper(digits) && checksum(addr) != s {
+		return addr, fmt.Errorf("%w %q: bad EIP-55 checksum", ErrInvalidAddress, s)
+	}
+	return addr, nil
+}
+
+// ChecksumAddress validates an address and returns it in EIP-55 form.
+func ChecksumAddress(s string) (string, error) {
+	addr, err := ParseAddress(s)
+	if err != nil {
+		return "", err
+	}
+	return checksum(addr), nil
+}
+
+// checksum renders addr with the letters whose nibble in the Keccak-256 of
+// the lowercase hex address is 8 or more in upper case.
+func checksum(addr [addressLength]byte) string {
+	digits := []byte(hex.EncodeToString(addr[:]))
+	sum := Keccak256(digits)
+	for i, c := range digits {
+		nibble := sum[i/2] >> 4
+		if i%2 == 1 {
+			nibble = sum[i/2] & 0x0f
+		}
+		if c >= 'a' && nibble >= 8 {
+			digits[i] = c - 'a' + 'A'
+		}
+	}
+	return "0x" + string(digits)
+}
+
+// ValidateHash checks that s is a 0x-prefixed 64-digit hex hash.
+func ValidateHash(s string) error {
+	digits, ok := strings.CutPrefix(s, "0x")
+	if !ok || len(digits) != 2*hashLength {
+		return fmt.Errorf("%w %q: want 0x followed by 64 hex digits", ErrInvalidHash, s)
+	}
+	if _, err := hex.DecodeString(digits); err != nil {
+		return fmt.Errorf("%w %q: want 0x followed by 64 hex digits", ErrInvalidHash, s)
+	}
+	return nil
+}
+
+// TransactionHash returns the 0x-prefixed lowercase hash of tx's contents.
+func TransactionHash(tx *models.Transaction) (string, error) {
+	if tx.BenchmarkID == uuid.Nil {
+		return "", errors.New("cannot hash a transaction without a benchmark")
+	}
+	from, err := ParseAddress(tx.FromAddress)
+	if err != nil {
+		return "", err
+	}
+	to, err := ParseAddress(tx.ToAddress)
+	if err != nil {
+		return "", err
+	}
+	for _, v := range []int64{tx.Amount, tx.Nonce, tx.GasPrice, tx.GasLimit} {
+		if v < 0 {
+			return "", fmt.Errorf("cannot hash negative amount, nonce or gas value %d", v)
+		}
+	}
+
+	buf := make([]byte, 7*wordLength)
+	copy(buf, tx.BenchmarkID[:])
+	copy(buf[2*wordLength-addressLength:], from[:])
+	copy(buf[3*wordLength-addressLength:], to[:])
+	for i, v := range []int64{tx.Amount, tx.Nonce, tx.GasPrice, tx.GasLimit} {
+		binary.BigEndian.PutUint64(buf[(i+4)*wordLength-8:], uint64(v))
+	}
+	return "0x" + hex.EncodeToString(Keccak256(buf)), nil
+}
+
+// VerifyHash checks that tx.Hash is the hash of tx's contents. Hex digits may
+// be in either case.
+func VerifyHash(tx *models.Transaction) error {
+	if err := ValidateHash(tx.Hash); err != nil {
+		return err
+	}
+	want, err := TransactionHash(tx)
+	if err != nil {
+		return err
+	}
+	if !strings.EqualFold(tx.Hash, want) {
+		return fmt.Errorf("%w: got %s, want %s", ErrHashMismatch, tx.Hash, want)
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package chain
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/fffeng99999/hcp-server/internal/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeccak256(t *testing.T) {
+	assert.Equal(t, "c5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470", hex.EncodeToString(Keccak256()))
+	assert.Equal(t, "4e03657aea45a94fc7d47ba826c8d667c0d1e6e33a64a036ec44f58fa12d6c45", hex.EncodeToString(Keccak256([]byte("abc"))))
+}
+
+func TestChecksumAddress(t *testing.T) {
+	// Test vectors from EIP-55.
+	for _, want := range []string{
+		"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+		"0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359",
+		"0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB",
+		"0xD1220A0cf47c7B9Be7A2E6BA89F429762e7b9aDb",
+	} {
+		got, err := ChecksumAddress(strings.ToLower(want))
+		require.NoError(t, err)
+		assert.Equal(t, want, got)
+
+		got, err = ChecksumAddress(want)
+		require.NoError(t, err)
+		assert.Equal(t, want, got)
+	}
+}
+
+func TestParseAddress_Rejects(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"5aaeb6053f3e94c9b9a09f33669435e7ef1beaed",
+		"0x5aaeb6053f3e94c9b9a09f33669435e7ef1bea",
+		"0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaedff",
+		"0x5aaeb6053f3e94c9b9a09f33669435e7ef1beazz",
+		// Checksum with one letter's case flipped.
+		"0x5AAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+	} {
+		_, err := ParseAddress(s)
+		assert.ErrorIs(t, err, ErrInvalidAddress, s)
+	}
+}
+
+func TestTransactionHash(t *testing.T) {
+	tx := &models.Transaction{
+		BenchmarkID: uuid.MustParse("0d5f1c2e-7a3b-4c8d-9e6f-102132435465"),
+		FromAddress: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+		ToAddress:   "0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359",
+		Amount:      1000,
+		Nonce:       7,
+		GasPrice:    20,
+		GasLimit:    21000,
+	}
+	hash, err := TransactionHash(tx)
+	require.NoError(t, err)
+	require.NoError(t, ValidateHash(hash))
+
+	// The encoding is abi.encode(benchmarkId, from, to, amount, nonce,
+	// gasPrice, gasLimit).
+	encoded, _ := hex.DecodeString("" +
+		"0d5f1c2e7a3b4c8d9e6f10213243546500000000000000000000000000000000" +
+		"0000000000000000000000005aaeb6053f3e94c9b9a09f33669435e7ef1beaed" +
+		"000000000000000000000000fb6916095ca1df60bb79ce92ce3ea74c37c5d359" +
+		"00000000000000000000000000000000000000000000000000000000000003e8" +
+		"0000000000000000000000000000000000000000000000000000000000000007" +
+		"0000000000000000000000000000000000000000000000000000000000000014" +
+		"0000000000000000000000000000000000000000000000000000000000005208")
+	assert.Equal(t, "0x"+hex.EncodeToString(Keccak256(encoded)), hash)
+
+	// Address case does not matter; every field does.
+	lower := *tx
+	lower.FromAddress = strings.ToLower(tx.FromAddress)
+	same, _ := TransactionHash(&lower)
+	assert.Equal(t, hash, same)
+	next := *tx
+	next.Nonce++
+	other, _ := TransactionHash(&next)
+	assert.NotEqual(t, hash, other)
+	replayed := *tx
+	replayed.BenchmarkID = uuid.New()
+	elsewhere, _ := TransactionHash(&replayed)
+	assert.NotEqual(t, hash, elsewhere)
+	replayed.BenchmarkID = uuid.Nil
+	_, err = TransactionHash(&replayed)
+	assert.Error(t, err)
+
+	tx.Hash = strings.ToUpper(hash[2:])
+	assert.ErrorIs(t, VerifyHash(tx), ErrInvalidHash)
+	tx.Hash = "0x" + strings.ToUpper(hash[2:])
+	assert.NoError(t, VerifyHash(tx))
+	tx.Hash = other
+	assert.ErrorIs(t, VerifyHash(tx), ErrHashMismatch)
+}
@@ -1,10 +1,14 @@
 package config
 
+import "time"
+
 type Config struct {
-	Server   ServerConfig   `mapstructure:"server"`
-	Database DatabaseConfig `mapstructure:"database"`
-	Redis    RedisConfig    `mapstructure:"redis"`
-	Log      LogConfig      `mapstructure:"log"`
+	Server      ServerConfig      `mapstructure:"server"`
+	Database    DatabaseConfig    `mapstructure:"database"`
+	Redis       RedisConfig       `mapstructure:"redis"`
+	Log         LogConfig         `mapstructure:"log"`
+	Benchmark   BenchmarkConfig   `mapstructure:"benchmark"`
+	Transaction TransactionConfig `mapstructure:"transaction"`
 }
 
 type ServerConfig struct {
@@ -30,3 +34,45 @@ type RedisConfig struct {
 type LogConfig struct {
 	Level string `mapstructure:"level"`
 }
+
+type BenchmarkConfig struct {
+	// TimeoutGrace is how long a run may overrun its configured duration
+	// before the orchestrator marks it failed.
+	TimeoutGrace time.Duration `mapstructure:"timeout_grace"`
+	// SweepInterval controls how often stuck runs are looked for.
+	SweepInterval time.Duration `mapstructure:"sweep_interval"`
+	// ScheduleInterval controls how often schedules are checked for due runs.
+	ScheduleInterval time.Duration `mapstructure:"schedule_interval"`
+	// RankingCacheTTL is how long leaderboards and trends are cached in
+	// Redis; completed runs invalidate them earlier.
+	RankingCacheTTL time.Duration `mapstructure:"ranking_cache_ttl"`
+	Queue           QueueConfig   `mapstructure:"queue"`
+}
+
+type QueueConfig struct {
+	// MaxConcurrent is how many benchmarks may be active at once on a cluster
+	// that has no limit of its own.
+	MaxConcurrent int `mapstructure:"max_concurrent"`
+	// Clusters overrides MaxConcurrent per cluster (or region) name. Keys are
+	// read lowercased, so cluster names should be lowercase.
+	Clusters map[string]int `mapstructure:"clusters"`
+	// DispatchInterval controls how often the queue is checked for runs that
+	// can start, in addition to checks whenever a run is queued or finishes.
+	DispatchInterval time.Duration `mapstructure:"dispatch_interval"`
+}
+
+type TransactionConfig struct {
+	Ingest IngestConfig `mapstructure:"ingest"`
+}
+
+type IngestConfig struct {
+	// BatchSize is how many rows a writer collects before inserting them.
+	BatchSize int `mapstructure:"batch_size"`
+	// FlushInterval bounds how long rows wait for a batch to fill up.
+	FlushInterval time.Duration `mapstructure:"flush_interval"`
+	// Writers is how many batches are inserted concurrently.
+	Writers int `mapstructure:"writers"`
+	// QueueSize is how many submitted batches may wait for a writer before
+	// ingestion streams are held back.
+	QueueSize int `mapstructure:"queue_size"`
+}
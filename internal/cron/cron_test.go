@@ -0,0 +1,58 @@
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRejectsInvalidExpressions(t *testing.T) {
+	for _, expr := range []string{"", "* * * *", "60 * * * *", "* 24 * * *", "* * 0 * *", "*/0 * * * *", "5-1 * * * *", "* * * foo *", "@every 5m"} {
+		_, err := Parse(expr)
+		assert.ErrorIs(t, err, ErrInvalidExpression, expr)
+	}
+}
+
+func TestNext(t *testing.T) {
+	// A Wednesday.
+	from := time.Date(2024, 3, 6, 10, 17, 30, 0, time.UTC)
+	cases := []struct {
+		expr string
+		want time.Time
+	}{
+		{"* * * * *", time.Date(2024, 3, 6, 10, 18, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2024, 3, 6, 10, 30, 0, 0, time.UTC)},
+		{"0 2 * * *", time.Date(2024, 3, 7, 2, 0, 0, 0, time.UTC)},
+		{"@daily", time.Date(2024, 3, 7, 0, 0, 0, 0, time.UTC)},
+		{"30 1 * * mon-fri", time.Date(2024, 3, 7, 1, 30, 0, 0, time.UTC)},
+		{"0 0 * * 7", time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)},
+		{"0 0 1 jan,jul *", time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC)},
+		// Both day fields restricted: either may match.
+		{"0 12 15 * fri", time.Date(2024, 3, 8, 12, 0, 0, 0, time.UTC)},
+		{"10-20/5 10 * * *", time.Date(2024, 3, 6, 10, 20, 0, 0, time.UTC)},
+	}
+	for _, c := range cases {
+		s, err := Parse(c.expr)
+		require.NoError(t, err, c.expr)
+		assert.Equal(t, c.want, s.Next(from), c.expr)
+	}
+}
+
+func TestNextNeverMatching(t *testing.T) {
+	s, err := Parse("0 0 30 2 *")
+	require.NoError(t, err)
+	assert.True(t, s.Next(time.Now()).IsZero())
+}
+
+func TestNextInLocation(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+	s, err := Parse("0 3 * * *")
+	require.NoError(t, err)
+
+	next := s.Next(time.Date(2024, 3, 6, 12, 0, 0, 0, time.UTC).In(loc))
+	assert.Equal(t, time.Date(2024, 3, 7, 2, 0, 0, 0, time.UTC), next.UTC())
+}
@@ -0,0 +1,6 @@
+-- Benchmark lifecycle states
+ALTER TABLE benchmarks DROP CONSTRAINT IF EXISTS chk_status;
+ALTER TABLE benchmarks ADD CONSTRAINT chk_status CHECK (
+    status IN ('queued', 'provisioning', 'warmup', 'running', 'cooldown', 'completed', 'failed', 'cancelled')
+);
+ALTER TABLE benchmarks ALTER COLUMN status SET DEFAULT 'queued';
@@ -0,0 +1,6 @@
+-- Measurement window used when computing benchmark results
+ALTER TABLE benchmarks ADD COLUMN IF NOT EXISTS measurement_started_at TIMESTAMP;
+ALTER TABLE benchmarks ADD COLUMN IF NOT EXISTS measurement_ended_at TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS idx_transactions_benchmark_submitted ON transactions(benchmark_id, submitted_at);
+CREATE INDEX IF NOT EXISTS idx_metrics_benchmark_id ON metrics(benchmark_id, metric_name);
@@ -0,0 +1,4 @@
+-- Repeated-run groups for statistical comparisons
+ALTER TABLE benchmarks ADD COLUMN IF NOT EXISTS run_group VARCHAR(100);
+
+CREATE INDEX IF NOT EXISTS idx_benchmarks_run_group ON benchmarks(run_group);
@@ -0,0 +1,30 @@
+-- Experiments: parameter sweeps expanded into child benchmarks
+CREATE TABLE IF NOT EXISTS experiments (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name VARCHAR(255) NOT NULL,
+    description TEXT,
+    matrix JSONB NOT NULL,
+    duration INTEGER NOT NULL,
+    status VARCHAR(20) DEFAULT 'queued',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT chk_experiment_status CHECK (status IN ('queued', 'running', 'completed')),
+    CONSTRAINT chk_experiment_duration CHECK (duration > 0)
+);
+
+CREATE INDEX IF NOT EXISTS idx_experiments_status ON experiments(status);
+CREATE INDEX IF NOT EXISTS idx_experiments_created_at ON experiments(created_at DESC);
+
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_trigger WHERE tgname = 'update_experiments_updated_at') THEN
+        CREATE TRIGGER update_experiments_updated_at
+            BEFORE UPDATE ON experiments
+            FOR EACH ROW
+            EXECUTE FUNCTION update_updated_at_column();
+    END IF;
+END $$;
+
+ALTER TABLE benchmarks ADD COLUMN IF NOT EXISTS experiment_id UUID REFERENCES experiments(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_benchmarks_experiment_id ON benchmarks(experiment_id, status);
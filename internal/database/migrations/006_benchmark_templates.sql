@@ -0,0 +1,35 @@
+-- Benchmark templates: reusable run configurations
+CREATE TABLE IF NOT EXISTS benchmark_templates (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name VARCHAR(255) NOT NULL,
+    description TEXT,
+    name_pattern VARCHAR(255),
+    algorithm VARCHAR(50) NOT NULL,
+    node_count INTEGER NOT NULL,
+    duration INTEGER NOT NULL,
+    target_tps INTEGER,
+    run_group VARCHAR(100),
+    consensus_params JSONB,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT chk_template_algorithm CHECK (algorithm IN ('tPBFT', 'Raft', 'HotStuff', 'Leios', 'HybridPBFT')),
+    CONSTRAINT chk_template_node_count CHECK (node_count > 0 AND node_count <= 1000),
+    CONSTRAINT chk_template_duration CHECK (duration > 0)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_benchmark_templates_name ON benchmark_templates(name);
+
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_trigger WHERE tgname = 'update_benchmark_templates_updated_at') THEN
+        CREATE TRIGGER update_benchmark_templates_updated_at
+            BEFORE UPDATE ON benchmark_templates
+            FOR EACH ROW
+            EXECUTE FUNCTION update_updated_at_column();
+    END IF;
+END $$;
+
+-- Run configuration and provenance
+ALTER TABLE benchmarks ADD COLUMN IF NOT EXISTS consensus_params JSONB;
+ALTER TABLE benchmarks ADD COLUMN IF NOT EXISTS template_id UUID REFERENCES benchmark_templates(id) ON DELETE SET NULL;
+ALTER TABLE benchmarks ADD COLUMN IF NOT EXISTS cloned_from_id UUID REFERENCES benchmarks(id) ON DELETE SET NULL;
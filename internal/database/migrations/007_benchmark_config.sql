@@ -0,0 +1,8 @@
+-- Per-run consensus configuration and environment manifest
+ALTER TABLE benchmarks ADD COLUMN IF NOT EXISTS consensus_config JSONB NOT NULL DEFAULT '{}';
+ALTER TABLE benchmarks ADD COLUMN IF NOT EXISTS environment JSONB NOT NULL DEFAULT '{}';
+
+-- Commits are the most common environment filter.
+CREATE INDEX IF NOT EXISTS idx_benchmarks_git_commit ON benchmarks((environment->>'git_commit'));
+
+ALTER TABLE benchmark_templates ADD COLUMN IF NOT EXISTS consensus_config JSONB NOT NULL DEFAULT '{}';
@@ -0,0 +1,27 @@
+-- Benchmark membership: which nodes took part in a run, and how they ended
+CREATE TABLE IF NOT EXISTS benchmark_nodes (
+    benchmark_id UUID NOT NULL REFERENCES benchmarks(id) ON DELETE CASCADE,
+    node_id VARCHAR(50) NOT NULL REFERENCES nodes(id),
+    role VARCHAR(20) NOT NULL,
+    final_status VARCHAR(20) NOT NULL DEFAULT 'active',
+    joined_at TIMESTAMP,
+    left_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (benchmark_id, node_id),
+    CONSTRAINT chk_benchmark_node_role CHECK (role IN ('leader', 'validator', 'observer')),
+    CONSTRAINT chk_benchmark_node_status CHECK (final_status IN ('active', 'healthy', 'crashed', 'byzantine', 'partitioned'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_benchmark_nodes_node_id ON benchmark_nodes(node_id);
+CREATE INDEX IF NOT EXISTS idx_benchmark_nodes_final_status ON benchmark_nodes(final_status);
+
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_trigger WHERE tgname = 'update_benchmark_nodes_updated_at') THEN
+        CREATE TRIGGER update_benchmark_nodes_updated_at
+            BEFORE UPDATE ON benchmark_nodes
+            FOR EACH ROW
+            EXECUTE FUNCTION update_updated_at_column();
+    END IF;
+END $$;
@@ -0,0 +1,9 @@
+-- Tags, and indexes backing the ListBenchmarks filters and search
+ALTER TABLE benchmarks ADD COLUMN IF NOT EXISTS tags TEXT[] DEFAULT '{}';
+
+CREATE INDEX IF NOT EXISTS idx_benchmarks_tags ON benchmarks USING GIN (tags);
+
+-- Must match the expression used by BenchmarkRepository.List.
+CREATE INDEX IF NOT EXISTS idx_benchmarks_search ON benchmarks USING GIN (
+    to_tsvector('simple', coalesce(name, '') || ' ' || coalesce(description, ''))
+);
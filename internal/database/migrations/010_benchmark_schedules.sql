@@ -0,0 +1,45 @@
+-- Benchmark schedules: recurring runs started by the server's scheduler
+CREATE TABLE IF NOT EXISTS benchmark_schedules (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name VARCHAR(255) NOT NULL,
+    description TEXT,
+    cron_expr VARCHAR(100) NOT NULL,
+    timezone VARCHAR(64) NOT NULL DEFAULT 'UTC',
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    template_id UUID REFERENCES benchmark_templates(id) ON DELETE RESTRICT,
+    name_pattern VARCHAR(255),
+    algorithm VARCHAR(50),
+    node_count INTEGER,
+    duration INTEGER,
+    target_tps INTEGER,
+    consensus_config JSONB,
+    consensus_params JSONB,
+    run_group VARCHAR(100),
+    tags TEXT[] DEFAULT '{}',
+    next_run_at TIMESTAMP,
+    last_run_at TIMESTAMP,
+    last_benchmark_id UUID REFERENCES benchmarks(id) ON DELETE SET NULL,
+    last_error TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    -- Runs come from a template or from the schedule's own configuration.
+    CONSTRAINT chk_schedule_config CHECK (
+        template_id IS NOT NULL OR (algorithm IS NOT NULL AND node_count > 0 AND duration > 0)
+    )
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_benchmark_schedules_name ON benchmark_schedules(name);
+CREATE INDEX IF NOT EXISTS idx_benchmark_schedules_due ON benchmark_schedules(next_run_at) WHERE enabled;
+
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_trigger WHERE tgname = 'update_benchmark_schedules_updated_at') THEN
+        CREATE TRIGGER update_benchmark_schedules_updated_at
+            BEFORE UPDATE ON benchmark_schedules
+            FOR EACH ROW
+            EXECUTE FUNCTION update_updated_at_column();
+    END IF;
+END $$;
+
+ALTER TABLE benchmarks ADD COLUMN IF NOT EXISTS schedule_id UUID REFERENCES benchmark_schedules(id) ON DELETE SET NULL;
+CREATE INDEX IF NOT EXISTS idx_benchmarks_schedule_id ON benchmarks(schedule_id);
@@ -0,0 +1,8 @@
+-- Benchmark queue: runs wait per cluster and are dispatched by priority
+ALTER TABLE benchmarks ADD COLUMN IF NOT EXISTS priority INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE benchmarks ADD COLUMN IF NOT EXISTS cluster VARCHAR(100) NOT NULL DEFAULT 'default';
+
+-- Dispatch order within a cluster's queue
+CREATE INDEX IF NOT EXISTS idx_benchmarks_queue ON benchmarks(cluster, priority DESC, created_at, id) WHERE status = 'queued';
+-- Occupied slots per cluster
+CREATE INDEX IF NOT EXISTS idx_benchmarks_cluster_status ON benchmarks(cluster, status);
@@ -0,0 +1,18 @@
+-- Warmup and cooldown: phases around the measured duration whose data is kept
+-- but left out of the results
+ALTER TABLE benchmarks ADD COLUMN IF NOT EXISTS warmup_duration INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE benchmarks ADD COLUMN IF NOT EXISTS cooldown_duration INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE benchmark_templates ADD COLUMN IF NOT EXISTS warmup_duration INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE benchmark_templates ADD COLUMN IF NOT EXISTS cooldown_duration INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE benchmark_schedules ADD COLUMN IF NOT EXISTS warmup_duration INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE benchmark_schedules ADD COLUMN IF NOT EXISTS cooldown_duration INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE experiments ADD COLUMN IF NOT EXISTS warmup_duration INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE experiments ADD COLUMN IF NOT EXISTS cooldown_duration INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE benchmarks DROP CONSTRAINT IF EXISTS chk_benchmark_warmup_cooldown;
+ALTER TABLE benchmarks ADD CONSTRAINT chk_benchmark_warmup_cooldown CHECK (
+    warmup_duration >= 0 AND cooldown_duration >= 0
+);
+
+-- Metric queries restricted to a run's measurement window
+CREATE INDEX IF NOT EXISTS idx_metrics_benchmark_timestamp ON metrics(benchmark_id, timestamp);
@@ -0,0 +1,12 @@
+-- SLA assertions evaluated against the results when a run completes
+ALTER TABLE benchmarks ADD COLUMN IF NOT EXISTS assertions TEXT[];
+ALTER TABLE benchmarks ADD COLUMN IF NOT EXISTS assertion_results JSONB;
+ALTER TABLE benchmarks ADD COLUMN IF NOT EXISTS sla_status VARCHAR(10);
+ALTER TABLE benchmark_templates ADD COLUMN IF NOT EXISTS assertions TEXT[];
+
+ALTER TABLE benchmarks DROP CONSTRAINT IF EXISTS chk_benchmark_sla_status;
+ALTER TABLE benchmarks ADD CONSTRAINT chk_benchmark_sla_status CHECK (
+    sla_status IS NULL OR sla_status IN ('', 'passed', 'failed')
+);
+
+CREATE INDEX IF NOT EXISTS idx_benchmarks_sla_status ON benchmarks(sla_status);
@@ -0,0 +1,14 @@
+-- Nonces used per sender and benchmark, so replays and duplicate hashes can be
+-- rejected and gaps reported. Unique indexes on the partitioned transactions
+-- table would have to include submitted_at, so they cannot enforce this.
+CREATE TABLE IF NOT EXISTS transaction_nonces (
+    benchmark_id UUID NOT NULL REFERENCES benchmarks(id) ON DELETE CASCADE,
+    from_address VARCHAR(42) NOT NULL,
+    nonce BIGINT NOT NULL,
+    hash VARCHAR(66) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (benchmark_id, from_address, nonce),
+    CONSTRAINT chk_transaction_nonce CHECK (nonce >= 0)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_transaction_nonces_hash ON transaction_nonces(benchmark_id, hash);
@@ -0,0 +1,7 @@
+-- A node cannot leave a run before it joined. Join and leave are reported
+-- separately, so the check also covers reports that race each other. Rows
+-- recorded before the check are left alone.
+ALTER TABLE benchmark_nodes DROP CONSTRAINT IF EXISTS chk_benchmark_node_times;
+ALTER TABLE benchmark_nodes ADD CONSTRAINT chk_benchmark_node_times CHECK (
+    joined_at IS NULL OR left_at IS NULL OR left_at >= joined_at
+) NOT VALID;
@@ -0,0 +1,4 @@
+-- DECIMAL(10,4) held latencies up to about 16 minutes, and a slower
+-- confirmation aborted the whole settlement batch it was in. DECIMAL(14,4)
+-- holds about 115 days; the server rejects or caps anything longer.
+ALTER TABLE transactions ALTER COLUMN latency_ms TYPE DECIMAL(14,4);
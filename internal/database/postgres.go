@@ -13,7 +13,11 @@ func NewPostgresDB(cfg config.DatabaseConfig) (*gorm.DB, error) {
 	dsn := fmt.Sprintf("host=%s user=%s password=%s dbname=%s port=%d sslmode=%s",
 		cfg.Host, cfg.User, cfg.Password, cfg.DBName, cfg.Port, cfg.SSLMode)
 
-	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	// TranslateError surfaces constraint violations as gorm.ErrDuplicatedKey
+	// and friends, so callers can map them without parsing driver errors.
+	gormCfg := &gorm.Config{TranslateError: true}
+
+	db, err := gorm.Open(postgres.Open(dsn), gormCfg)
 	if err != nil {
 		// If database does not exist, try to create it
 		if strings.Contains(err.Error(), "does not exist") || strings.Contains(err.Error(), "3D000") {
@@ -21,7 +25,7 @@ func NewPostgresDB(cfg config.DatabaseConfig) (*gorm.DB, error) {
 				return nil, fmt.Errorf("failed to create database: %w (original error: %v)", createErr, err)
 			}
 			// Retry connection
-			db, err = gorm.Open(postgres.Open(dsn), &gorm.Config{})
+			db, err = gorm.Open(postgres.Open(dsn), gormCfg)
 			if err != nil {
 				return nil, err
 			}
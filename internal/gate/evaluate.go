@@ -0,0 +1,135 @@
+package gate
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"text/tabwriter"
+)
+
+const (
+	OutcomePass = "PASS"
+	OutcomeFail = "FAIL"
+	// OutcomeSkip is used for relative rules when the baseline has no data.
+	OutcomeSkip = "SKIP"
+)
+
+// Observation is one metric measured on both runs.
+type Observation struct {
+	Metric    string
+	Unit      string
+	Baseline  float64
+	Candidate float64
+}
+
+// Result is the outcome of one rule.
+type Result struct {
+	Rule        Rule
+	Observation Observation
+	Outcome     string
+}
+
+// Report is the outcome of a gate evaluation.
+type Report struct {
+	BaselineID  string
+	CandidateID string
+	Results     []Result
+}
+
+// Passed reports whether no rule failed.
+func (r *Report) Passed() bool {
+	for _, res := range r.Results {
+		if res.Outcome == OutcomeFail {
+			return false
+		}
+	}
+	return true
+}
+
+// Evaluate checks every rule against the observations. A rule naming a
+// metric that was not observed is an error.
+func Evaluate(rules []Rule, observations map[string]Observation) ([]Result, error) {
+	results := make([]Result, 0, len(rules))
+	for _, rule := range rules {
+		obs, ok := observations[rule.Metric]
+		if !ok {
+			return nil, fmt.Errorf("unknown metric %q (available: %v)", rule.Metric, metricNames(observations))
+		}
+		results = append(results, Result{Rule: rule, Observation: obs, Outcome: check(rule, obs)})
+	}
+	return results, nil
+}
+
+func check(rule Rule, obs Observation) string {
+	if rule.relative() && obs.Baseline == 0 {
+		return OutcomeSkip
+	}
+
+	b, c, v := obs.Baseline, obs.Candidate, rule.Value
+	var ok bool
+	switch rule.Kind {
+	case KindMaxIncrease:
+		ok = c <= b*(1+v)
+	case KindMaxDecrease:
+		ok = c >= b*(1-v)
+	case KindMinRatio:
+		ok = c >= b*v
+	case KindMaxRatio:
+		ok = c <= b*v
+	case KindMax:
+		ok = c <= v
+	case KindMin:
+		ok = c >= v
+	}
+	if ok {
+		return OutcomePass
+	}
+	return OutcomeFail
+}
+
+// WriteTable renders the report as an aligned diff table.
+func (r *Report) WriteTable(w io.Writer) error {
+	fmt.Fprintf(w, "baseline:  %s\ncandidate: %s\n\n", r.BaselineID, r.CandidateID)
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "METRIC\tBASELINE\tCANDIDATE\tDELTA\tRULE\tRESULT")
+	for _, res := range r.Results {
+		o := res.Observation
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\n",
+			o.Metric, formatValue(o.Baseline, o.Unit), formatValue(o.Candidate, o.Unit),
+			formatDelta(o), res.Rule, res.Outcome)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	verdict := "PASSED"
+	if !r.Passed() {
+		verdict = "FAILED"
+	}
+	_, err := fmt.Fprintf(w, "\nperformance gate %s\n", verdict)
+	return err
+}
+
+func formatValue(v float64, unit string) string {
+	if unit == "" {
+		return fmt.Sprintf("%.2f", v)
+	}
+	return fmt.Sprintf("%.2f %s", v, unit)
+}
+
+func formatDelta(o Observation) string {
+	if o.Baseline == 0 {
+		return "n/a"
+	}
+	return fmt.Sprintf("%+.2f%%", (o.Candidate-o.Baseline)/o.Baseline*100)
+}
+
+func metricNames(observations map[string]Observation) []string {
+	names := make([]string, 0, len(observations))
+	for name := range observations {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,58 @@
+package gate
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRule(t *testing.T) {
+	r, err := ParseRule("latency_p99:max_increase=10%")
+	require.NoError(t, err)
+	assert.Equal(t, Rule{Metric: "latency_p99", Kind: KindMaxIncrease, Value: 0.10}, r)
+
+	r, err = ParseRule("actual_tps:min_ratio=0.95")
+	require.NoError(t, err)
+	assert.Equal(t, Rule{Metric: "actual_tps", Kind: KindMinRatio, Value: 0.95}, r)
+
+	r, err = ParseRule("cpu_usage_max:max=80")
+	require.NoError(t, err)
+	assert.Equal(t, 80.0, r.Value)
+
+	for _, bad := range []string{"latency_p99", "latency_p99:max_increase", "x:bogus=1", "x:max=80%", "x:min_ratio=abc"} {
+		_, err := ParseRule(bad)
+		assert.Error(t, err, bad)
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	observations := map[string]Observation{
+		"actual_tps":  {Metric: "actual_tps", Baseline: 1000, Candidate: 960},
+		"latency_p99": {Metric: "latency_p99", Baseline: 100, Candidate: 115},
+		"cpu_usage":   {Metric: "cpu_usage", Baseline: 0, Candidate: 50},
+	}
+	rules := []Rule{
+		{Metric: "actual_tps", Kind: KindMinRatio, Value: 0.95},
+		{Metric: "latency_p99", Kind: KindMaxIncrease, Value: 0.10},
+		{Metric: "cpu_usage", Kind: KindMaxIncrease, Value: 0.10},
+	}
+
+	results, err := Evaluate(rules, observations)
+	require.NoError(t, err)
+	assert.Equal(t, OutcomePass, results[0].Outcome)
+	assert.Equal(t, OutcomeFail, results[1].Outcome)
+	assert.Equal(t, OutcomeSkip, results[2].Outcome)
+
+	report := &Report{BaselineID: "a", CandidateID: "b", Results: results}
+	assert.False(t, report.Passed())
+
+	var buf bytes.Buffer
+	require.NoError(t, report.WriteTable(&buf))
+	assert.Contains(t, buf.String(), "+15.00%")
+	assert.Contains(t, buf.String(), "performance gate FAILED")
+
+	_, err = Evaluate([]Rule{{Metric: "missing", Kind: KindMax}}, observations)
+	assert.Error(t, err)
+}
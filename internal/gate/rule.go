@@ -0,0 +1,97 @@
+// Package gate evaluates performance regression rules between a baseline and
+// a candidate benchmark.
+package gate
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Rule kinds. Relative kinds compare the candidate against the baseline;
+// absolute kinds compare the candidate against a fixed limit.
+const (
+	KindMaxIncrease = "max_increase" // candidate <= baseline * (1 + v)
+	KindMaxDecrease = "max_decrease" // candidate >= baseline * (1 - v)
+	KindMinRatio    = "min_ratio"    // candidate >= baseline * v
+	KindMaxRatio    = "max_ratio"    // candidate <= baseline * v
+	KindMax         = "max"          // candidate <= v
+	KindMin         = "min"          // candidate >= v
+)
+
+// Rule is a single threshold, e.g. "latency_p99 may not increase more than 10%".
+type Rule struct {
+	Metric string
+	Kind   string
+	Value  float64
+}
+
+// DefaultRules are applied when no rules are configured.
+var DefaultRules = []Rule{
+	{Metric: "actual_tps", Kind: KindMinRatio, Value: 0.95},
+	{Metric: "latency_p99", Kind: KindMaxIncrease, Value: 0.10},
+}
+
+// ParseRule parses "<metric>:<kind>=<value>", e.g. "latency_p99:max_increase=10%"
+// or "actual_tps:min_ratio=0.95". Percentages are converted to fractions for
+// the relative kinds.
+func ParseRule(s string) (Rule, error) {
+	metric, rest, ok := strings.Cut(strings.TrimSpace(s), ":")
+	if !ok || metric == "" {
+		return Rule{}, fmt.Errorf("rule %q: expected <metric>:<kind>=<value>", s)
+	}
+	kind, raw, ok := strings.Cut(rest, "=")
+	if !ok {
+		return Rule{}, fmt.Errorf("rule %q: expected <kind>=<value>", s)
+	}
+
+	switch kind {
+	case KindMaxIncrease, KindMaxDecrease, KindMinRatio, KindMaxRatio, KindMax, KindMin:
+	default:
+		return Rule{}, fmt.Errorf("rule %q: unknown kind %q", s, kind)
+	}
+
+	percent := strings.HasSuffix(raw, "%")
+	value, err := strconv.ParseFloat(strings.TrimSuffix(raw, "%"), 64)
+	if err != nil {
+		return Rule{}, fmt.Errorf("rule %q: invalid value: %w", s, err)
+	}
+	if percent {
+		if kind == KindMax || kind == KindMin {
+			return Rule{}, fmt.Errorf("rule %q: absolute limits cannot be percentages", s)
+		}
+		value /= 100
+	}
+	if value < 0 {
+		return Rule{}, fmt.Errorf("rule %q: value must not be negative", s)
+	}
+
+	return Rule{Metric: metric, Kind: kind, Value: value}, nil
+}
+
+func (r Rule) relative() bool {
+	return r.Kind != KindMax && r.Kind != KindMin
+}
+
+// String renders the rule in human-readable form for reports.
+func (r Rule) String() string {
+	switch r.Kind {
+	case KindMaxIncrease:
+		return fmt.Sprintf("<= +%s", percent(r.Value))
+	case KindMaxDecrease:
+		return fmt.Sprintf(">= -%s", percent(r.Value))
+	case KindMinRatio:
+		return fmt.Sprintf(">= %s of baseline", percent(r.Value))
+	case KindMaxRatio:
+		return fmt.Sprintf("<= %s of baseline", percent(r.Value))
+	case KindMax:
+		return fmt.Sprintf("<= %g", r.Value)
+	case KindMin:
+		return fmt.Sprintf(">= %g", r.Value)
+	}
+	return r.Kind
+}
+
+func percent(v float64) string {
+	return strconv.FormatFloat(v*100, 'f', -1, 64) + "%"
+}
@@ -2,49 +2,186 @@ package handlers
 
 import (
 	"context"
+	"encoding/json"
+	"time"
 
 	pb "github.com/fffeng99999/hcp-server/api/generated/benchmark"
 	common "github.com/fffeng99999/hcp-server/api/generated/common"
 	"github.com/fffeng99999/hcp-server/internal/models"
+	"github.com/fffeng99999/hcp-server/internal/repository"
 	"github.com/fffeng99999/hcp-server/internal/service"
+	"github.com/fffeng99999/hcp-server/internal/stats"
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
 type BenchmarkHandler struct {
 	pb.UnimplementedBenchmarkServiceServer
-	svc service.BenchmarkService
+	svc        service.BenchmarkService
+	orch       service.BenchmarkOrchestrator
+	finalizer  service.BenchmarkFinalizer
+	comparator service.BenchmarkComparator
+	statistics service.BenchmarkStatistics
+	templates  service.BenchmarkTemplateService
+	nodes      service.BenchmarkNodeService
+	reporter   service.BenchmarkReporter
+	monitor    service.BenchmarkMonitor
+	schedules  service.BenchmarkScheduleService
+	queue      service.BenchmarkQueue
+	ranking    service.BenchmarkRanking
 }
 
-func NewBenchmarkHandler(svc service.BenchmarkService) *BenchmarkHandler {
-	return &BenchmarkHandler{svc: svc}
+func NewBenchmarkHandler(svc service.BenchmarkService, orch service.BenchmarkOrchestrator, finalizer service.BenchmarkFinalizer, comparator service.BenchmarkComparator, statistics service.BenchmarkStatistics, templates service.BenchmarkTemplateService, nodes service.BenchmarkNodeService, reporter service.BenchmarkReporter, monitor service.BenchmarkMonitor, schedules service.BenchmarkScheduleService, queue service.BenchmarkQueue, ranking service.BenchmarkRanking) *BenchmarkHandler {
+	return &BenchmarkHandler{svc: svc, orch: orch, finalizer: finalizer, comparator: comparator, statistics: statistics, templates: templates, nodes: nodes, reporter: reporter, monitor: monitor, schedules: schedules, queue: queue, ranking: ranking}
 }
 
 func (h *BenchmarkHandler) CreateBenchmark(ctx context.Context, req *pb.CreateBenchmarkRequest) (*pb.CreateBenchmarkResponse, error) {
-	benchmark := &models.Benchmark{
-		Name:        req.Name,
-		Description: req.Description,
-		Algorithm:   req.Algorithm,
-		NodeCount:   int(req.NodeCount),
-		Duration:    int(req.Duration),
-		TargetTPS:   int(req.TargetTps),
+	benchmark := &models.Benchmark{}
+	if req.TemplateId != "" {
+		var err error
+		if benchmark, err = h.templates.Instantiate(ctx, req.TemplateId); err != nil {
+			return nil, toStatusError(err)
+		}
 	}
+	applyCreateRequest(benchmark, req)
 
 	created, err := h.svc.Create(ctx, benchmark)
 	if err != nil {
 		return nil, err
 	}
+	pbBenchmark, err := h.enqueue(ctx, created)
+	if err != nil {
+		return nil, err
+	}
 
 	return &pb.CreateBenchmarkResponse{
-		Benchmark: mapModelToProto(created),
+		Benchmark: pbBenchmark,
+	}, nil
+}
+
+// applyCreateRequest writes the fields set on the request over b, which may
+// already hold a template's configuration.
+func applyCreateRequest(b *models.Benchmark, req *pb.CreateBenchmarkRequest) {
+	if req.Name != "" {
+		b.Name = req.Name
+	}
+	if req.Description != "" {
+		b.Description = req.Description
+	}
+	if req.Algorithm != "" {
+		b.Algorithm = req.Algorithm
+	}
+	if req.NodeCount != 0 {
+		b.NodeCount = int(req.NodeCount)
+	}
+	if req.Duration != 0 {
+		b.Duration = int(req.Duration)
+	}
+	if req.TargetTps != 0 {
+		b.TargetTPS = int(req.TargetTps)
+	}
+	if req.WarmupDuration != 0 {
+		b.WarmupDuration = int(req.WarmupDuration)
+	}
+	if req.CooldownDuration != 0 {
+		b.CooldownDuration = int(req.CooldownDuration)
+	}
+	if req.RunGroup != "" {
+		b.RunGroup = req.RunGroup
+	}
+	if req.Priority != 0 {
+		b.Priority = int(req.Priority)
+	}
+	if req.Cluster != "" {
+		b.Cluster = req.Cluster
+	}
+	if len(req.Tags) > 0 {
+		b.Tags = req.Tags
+	}
+	if len(req.Assertions) > 0 {
+		b.Assertions = req.Assertions
+	}
+	if len(req.ConsensusParams) > 0 && b.ConsensusParams == nil {
+		b.ConsensusParams = make(map[string]string, len(req.ConsensusParams))
+	}
+	for k, v := range req.ConsensusParams {
+		b.ConsensusParams[k] = v
+	}
+	if req.ConsensusConfig != nil {
+		b.ConsensusConfig = mapConsensusConfigFromProto(req.ConsensusConfig)
+	}
+	if req.Environment != nil {
+		b.Environment = mapEnvironmentFromProto(req.Environment)
+	}
+}
+
+// enqueue wakes the dispatcher for a freshly created run and returns the run
+// with its place in the queue.
+func (h *BenchmarkHandler) enqueue(ctx context.Context, b *models.Benchmark) (*pb.Benchmark, error) {
+	position, err := h.queue.Position(ctx, b.ID.String())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	h.queue.Notify()
+
+	pbBenchmark := mapModelToProto(b)
+	pbBenchmark.QueuePosition = int32(position)
+	return pbBenchmark, nil
+}
+
+func (h *BenchmarkHandler) CloneBenchmark(ctx context.Context, req *pb.CloneBenchmarkRequest) (*pb.CloneBenchmarkResponse, error) {
+	cloned, err := h.svc.Clone(ctx, req.Id, req.Name, req.RunGroup)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	pbBenchmark, err := h.enqueue(ctx, cloned)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CloneBenchmarkResponse{
+		Benchmark: pbBenchmark,
+	}, nil
+}
+
+func (h *BenchmarkHandler) CancelBenchmark(ctx context.Context, req *pb.CancelBenchmarkRequest) (*pb.CancelBenchmarkResponse, error) {
+	if req.CancelledBy == "" {
+		return nil, status.Error(codes.InvalidArgument, "cancelled_by is required")
+	}
+	if _, err := h.orch.Cancel(ctx, req.Id, req.CancelledBy, req.Reason); err != nil {
+		return nil, toStatusError(err)
+	}
+	cancelled, err := h.svc.Get(ctx, req.Id)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &pb.CancelBenchmarkResponse{
+		Benchmark: mapModelToProto(cancelled),
 	}, nil
 }
 
 func (h *BenchmarkHandler) GetBenchmark(ctx context.Context, req *pb.GetBenchmarkRequest) (*pb.GetBenchmarkResponse, error) {
 	benchmark, err := h.svc.Get(ctx, req.Id)
 	if err != nil {
-		return nil, err
+		return nil, toStatusError(err)
+	}
+	memberships, err := h.nodes.List(ctx, req.Id, repository.BenchmarkNodeFilter{})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	pbBenchmark := mapModelToProto(benchmark)
+	pbBenchmark.Nodes = mapBenchmarkNodesToProto(memberships)
+	if benchmark.Status == models.BenchmarkStatusQueued {
+		position, err := h.queue.Position(ctx, req.Id)
+		if err != nil {
+			return nil, toStatusError(err)
+		}
+		pbBenchmark.QueuePosition = int32(position)
 	}
 	return &pb.GetBenchmarkResponse{
-		Benchmark: mapModelToProto(benchmark),
+		Benchmark: pbBenchmark,
 	}, nil
 }
 
@@ -60,9 +197,42 @@ func (h *BenchmarkHandler) ListBenchmarks(ctx context.Context, req *pb.ListBench
 		}
 	}
 
-	benchmarks, total, err := h.svc.List(ctx, page, pageSize)
+	filter := repository.BenchmarkFilter{
+		Status:       req.Status,
+		Algorithm:    req.Algorithm,
+		RunGroup:     req.RunGroup,
+		MinNodeCount: int(req.MinNodeCount),
+		MaxNodeCount: int(req.MaxNodeCount),
+		Tags:         req.Tags,
+		Search:       req.Search,
+		Parameters:   req.Parameters,
+		SortBy:       req.SortBy,
+		SLAStatus:    req.SlaStatus,
+		Experiment:   req.Experiment,
+	}
+	var err error
+	if filter.CreatedAfter, err = parseOptionalTime(req.CreatedAfter); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid created_after: %v", err)
+	}
+	if filter.CreatedBefore, err = parseOptionalTime(req.CreatedBefore); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid created_before: %v", err)
+	}
+	switch req.SlaStatus {
+	case "", models.SLAStatusPassed, models.SLAStatusFailed:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "invalid sla_status %q", req.SlaStatus)
+	}
+	switch req.SortOrder {
+	case "", "desc":
+	case "asc":
+		filter.SortAscending = true
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "invalid sort_order %q", req.SortOrder)
+	}
+
+	benchmarks, total, err := h.svc.List(ctx, filter, page, pageSize)
 	if err != nil {
-		return nil, err
+		return nil, toStatusError(err)
 	}
 
 	var pbBenchmarks []*pb.Benchmark
@@ -80,30 +250,427 @@ func (h *BenchmarkHandler) ListBenchmarks(ctx context.Context, req *pb.ListBench
 	}, nil
 }
 
+// benchmarkUpdatePaths maps the field mask paths accepted by UpdateBenchmark
+// to the value they write. Paths match the benchmarks column names.
+var benchmarkUpdatePaths = map[string]func(*pb.UpdateBenchmarkRequest) interface{}{
+	"name":              func(r *pb.UpdateBenchmarkRequest) interface{} { return r.Name },
+	"description":       func(r *pb.UpdateBenchmarkRequest) interface{} { return r.Description },
+	"error_message":     func(r *pb.UpdateBenchmarkRequest) interface{} { return r.ErrorMessage },
+	"actual_tps":        func(r *pb.UpdateBenchmarkRequest) interface{} { return r.ActualTps },
+	"latency_p50":       func(r *pb.UpdateBenchmarkRequest) interface{} { return r.LatencyP50 },
+	"latency_p90":       func(r *pb.UpdateBenchmarkRequest) interface{} { return r.LatencyP90 },
+	"latency_p99":       func(r *pb.UpdateBenchmarkRequest) interface{} { return r.LatencyP99 },
+	"latency_p999":      func(r *pb.UpdateBenchmarkRequest) interface{} { return r.LatencyP999 },
+	"latency_avg":       func(r *pb.UpdateBenchmarkRequest) interface{} { return r.LatencyAvg },
+	"latency_min":       func(r *pb.UpdateBenchmarkRequest) interface{} { return r.LatencyMin },
+	"latency_max":       func(r *pb.UpdateBenchmarkRequest) interface{} { return r.LatencyMax },
+	"transaction_count": func(r *pb.UpdateBenchmarkRequest) interface{} { return int(r.TransactionCount) },
+	"successful_tx":     func(r *pb.UpdateBenchmarkRequest) interface{} { return int(r.SuccessfulTx) },
+	"failed_tx":         func(r *pb.UpdateBenchmarkRequest) interface{} { return int(r.FailedTx) },
+	"tags":              func(r *pb.UpdateBenchmarkRequest) interface{} { return pq.StringArray(r.Tags) },
+	"priority":          func(r *pb.UpdateBenchmarkRequest) interface{} { return int(r.Priority) },
+}
+
+// benchmarkJSONUpdatePaths are the update paths of JSONB columns, whose
+// values are encoded by jsonColumn.
+var benchmarkJSONUpdatePaths = map[string]func(*pb.UpdateBenchmarkRequest) interface{}{
+	"environment": func(r *pb.UpdateBenchmarkRequest) interface{} { return mapEnvironmentFromProto(r.Environment) },
+}
+
+// jsonColumn encodes a value for a JSONB column. Column updates given as a
+// map bypass the model's json serializer.
+func jsonColumn(v interface{}) (string, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
 func (h *BenchmarkHandler) UpdateBenchmark(ctx context.Context, req *pb.UpdateBenchmarkRequest) (*pb.UpdateBenchmarkResponse, error) {
-	// Not implemented for skeleton
-	return &pb.UpdateBenchmarkResponse{}, nil
+	paths := req.GetUpdateMask().GetPaths()
+	if len(paths) == 0 {
+		paths = populatedUpdatePaths(req)
+	}
+	if len(paths) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no fields to update")
+	}
+
+	var expectedUpdatedAt *time.Time
+	if req.ExpectedUpdatedAt != "" {
+		t, err := time.Parse(time.RFC3339Nano, req.ExpectedUpdatedAt)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid expected_updated_at: %v", err)
+		}
+		expectedUpdatedAt = &t
+	}
+
+	updates := map[string]interface{}{}
+	newStatus := ""
+	for _, path := range paths {
+		if path == "status" {
+			if req.Status == "" {
+				return nil, status.Error(codes.InvalidArgument, "status cannot be empty")
+			}
+			newStatus = req.Status
+			continue
+		}
+		if value, ok := benchmarkJSONUpdatePaths[path]; ok {
+			encoded, err := jsonColumn(value(req))
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid %s: %v", path, err)
+			}
+			updates[path] = encoded
+			continue
+		}
+		value, ok := benchmarkUpdatePaths[path]
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown update_mask path %q", path)
+		}
+		updates[path] = value(req)
+	}
+	if name, ok := updates["name"]; ok && name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name cannot be empty")
+	}
+	switch newStatus {
+	case "", models.BenchmarkStatusFailed:
+	case models.BenchmarkStatusCancelled:
+		if req.CancelledBy == "" {
+			return nil, status.Error(codes.InvalidArgument, "cancelled_by is required")
+		}
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "status can only be set to %s or %s", models.BenchmarkStatusFailed, models.BenchmarkStatusCancelled)
+	}
+
+	current, err := h.svc.Get(ctx, req.Id)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	updated := current
+	switch {
+	case newStatus != "" && newStatus != current.Status:
+		updated, err = h.orch.Stop(ctx, service.StopRequest{
+			ID:                req.Id,
+			Status:            newStatus,
+			CancelledBy:       req.CancelledBy,
+			Reason:            req.ErrorMessage,
+			Fields:            updates,
+			ExpectedUpdatedAt: expectedUpdatedAt,
+		})
+	case len(updates) > 0:
+		updated, err = h.svc.Update(ctx, req.Id, updates, expectedUpdatedAt)
+	case expectedUpdatedAt != nil && !current.UpdatedAt.Equal(*expectedUpdatedAt):
+		err = repository.ErrConflict
+	}
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	h.ranking.Invalidate(ctx, updated)
+
+	return &pb.UpdateBenchmarkResponse{
+		Benchmark: mapModelToProto(updated),
+	}, nil
 }
 
 func (h *BenchmarkHandler) DeleteBenchmark(ctx context.Context, req *pb.DeleteBenchmarkRequest) (*common.StatusResponse, error) {
-	// Not implemented for skeleton
+	benchmark, err := h.svc.Get(ctx, req.Id)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	if err := h.svc.Delete(ctx, req.Id); err != nil {
+		return nil, toStatusError(err)
+	}
+	h.ranking.Invalidate(ctx, benchmark)
 	return &common.StatusResponse{Success: true}, nil
 }
 
+func (h *BenchmarkHandler) RecomputeBenchmarkResults(ctx context.Context, req *pb.RecomputeBenchmarkResultsRequest) (*pb.RecomputeBenchmarkResultsResponse, error) {
+	benchmark, err := h.finalizer.Finalize(ctx, req.Id)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	h.ranking.Invalidate(ctx, benchmark)
+	return &pb.RecomputeBenchmarkResultsResponse{
+		Benchmark: mapModelToProto(benchmark),
+	}, nil
+}
+
+func (h *BenchmarkHandler) CompareBenchmarks(ctx context.Context, req *pb.CompareBenchmarksRequest) (*pb.CompareBenchmarksResponse, error) {
+	comparison, err := h.comparator.Compare(ctx, req.Ids)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	resp := &pb.CompareBenchmarksResponse{
+		RecomputedIds: comparison.Recomputed,
+	}
+	for _, b := range comparison.Benchmarks {
+		resp.Benchmarks = append(resp.Benchmarks, mapModelToProto(b))
+	}
+	for _, d := range comparison.Dimensions {
+		metric := &pb.MetricComparison{
+			Name:           d.Name,
+			Category:       d.Category,
+			Unit:           d.Unit,
+			HigherIsBetter: d.HigherIsBetter,
+			WinnerId:       d.WinnerID,
+		}
+		for _, v := range d.Values {
+			metric.Values = append(metric.Values, &pb.ComparedValue{
+				BenchmarkId:   v.BenchmarkID,
+				Value:         v.Value,
+				AbsoluteDelta: v.AbsoluteDelta,
+				RelativeDelta: v.RelativeDelta,
+				Winner:        v.Winner,
+			})
+		}
+		resp.Metrics = append(resp.Metrics, metric)
+	}
+	return resp, nil
+}
+
+func (h *BenchmarkHandler) CompareBenchmarkGroups(ctx context.Context, req *pb.CompareBenchmarkGroupsRequest) (*pb.CompareBenchmarkGroupsResponse, error) {
+	if req.Baseline == nil || req.Candidate == nil {
+		return nil, status.Error(codes.InvalidArgument, "baseline and candidate groups are required")
+	}
+
+	opts := service.SignificanceOptions{
+		ConfidenceLevel:     req.ConfidenceLevel,
+		Alpha:               req.Alpha,
+		BootstrapIterations: int(req.BootstrapIterations),
+		MaxSamples:          int(req.MaxSamples),
+		Seed:                req.Seed,
+	}
+	result, err := h.statistics.CompareGroups(ctx, mapGroupFromProto(req.Baseline), mapGroupFromProto(req.Candidate), opts)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.CompareBenchmarkGroupsResponse{
+		Baseline:  mapGroupStatisticsToProto(&result.Baseline),
+		Candidate: mapGroupStatisticsToProto(&result.Candidate),
+		MannWhitney: &pb.MannWhitneyResult{
+			U:          result.MannWhitney.U,
+			Z:          result.MannWhitney.Z,
+			PValue:     result.MannWhitney.PValue,
+			EffectSize: result.MannWhitney.EffectSize,
+		},
+		Verdict: result.Verdict,
+	}, nil
+}
+
+// populatedUpdatePaths infers a field mask from the fields a client actually
+// set, for callers that do not send update_mask.
+func populatedUpdatePaths(req protoreflect.ProtoMessage) []string {
+	var paths []string
+	req.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		switch name := string(fd.Name()); name {
+		case "id", "update_mask", "expected_updated_at", "cancelled_by":
+		default:
+			paths = append(paths, name)
+		}
+		return true
+	})
+	return paths
+}
 
 // Helper
 func mapModelToProto(m *models.Benchmark) *pb.Benchmark {
-	return &pb.Benchmark{
-		Id:          m.ID.String(),
-		Name:        m.Name,
-		Description: m.Description,
-		Algorithm:   m.Algorithm,
-		NodeCount:   int32(m.NodeCount),
-		Duration:    int32(m.Duration),
-		TargetTps:   int32(m.TargetTPS),
-		Status:      m.Status,
-		ActualTps:   m.ActualTPS,
-		LatencyAvg:  m.LatencyAvg,
-		CreatedAt:   m.CreatedAt.String(),
+	pbBenchmark := &pb.Benchmark{
+		Id:               m.ID.String(),
+		Name:             m.Name,
+		Description:      m.Description,
+		Algorithm:        m.Algorithm,
+		NodeCount:        int32(m.NodeCount),
+		Duration:         int32(m.Duration),
+		WarmupDuration:   int32(m.WarmupDuration),
+		CooldownDuration: int32(m.CooldownDuration),
+		TargetTps:        int32(m.TargetTPS),
+		Status:           m.Status,
+		ActualTps:        m.ActualTPS,
+		LatencyAvg:       m.LatencyAvg,
+		ErrorMessage:     m.ErrorMessage,
+		RunGroup:         m.RunGroup,
+		Tags:             m.Tags,
+		Priority:         int32(m.Priority),
+		Cluster:          m.Cluster,
+		ConsensusParams:  m.ConsensusParams,
+		ConsensusConfig:  mapConsensusConfigToProto(m.ConsensusConfig),
+		Environment:      mapEnvironmentToProto(m.Environment),
+		Results:          mapResultsToProto(m),
+		Assertions:       m.Assertions,
+		AssertionResults: mapAssertionResultsToProto(m.AssertionResults),
+		SlaStatus:        m.SLAStatus,
+		CreatedAt:        m.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:        m.UpdatedAt.Format(time.RFC3339Nano),
+	}
+	if m.ExperimentID != nil {
+		pbBenchmark.ExperimentId = m.ExperimentID.String()
+	}
+	if m.TemplateID != nil {
+		pbBenchmark.TemplateId = m.TemplateID.String()
+	}
+	if m.ClonedFromID != nil {
+		pbBenchmark.ClonedFromId = m.ClonedFromID.String()
+	}
+	if m.ScheduleID != nil {
+		pbBenchmark.ScheduleId = m.ScheduleID.String()
+	}
+	if m.StartedAt != nil {
+		pbBenchmark.StartedAt = m.StartedAt.Format(time.RFC3339)
+	}
+	if m.CompletedAt != nil {
+		pbBenchmark.CompletedAt = m.CompletedAt.Format(time.RFC3339)
+	}
+	if m.MeasurementStartedAt != nil {
+		pbBenchmark.MeasurementStartedAt = m.MeasurementStartedAt.Format(time.RFC3339)
+	}
+	if m.MeasurementEndedAt != nil {
+		pbBenchmark.MeasurementEndedAt = m.MeasurementEndedAt.Format(time.RFC3339)
+	}
+	return pbBenchmark
+}
+
+func mapAssertionResultsToProto(results []models.AssertionResult) []*pb.AssertionResult {
+	var pbResults []*pb.AssertionResult
+	for _, r := range results {
+		pbResults = append(pbResults, &pb.AssertionResult{
+			Assertion: r.Assertion,
+			Passed:    r.Passed,
+			Left:      r.Left,
+			Right:     r.Right,
+			Error:     r.Error,
+		})
+	}
+	return pbResults
+}
+
+func mapResultsToProto(m *models.Benchmark) *pb.BenchmarkResults {
+	return &pb.BenchmarkResults{
+		ActualTps: m.ActualTPS,
+		Latency: &pb.LatencyStats{
+			P50:  m.LatencyP50,
+			P90:  m.LatencyP90,
+			P99:  m.LatencyP99,
+			P999: m.LatencyP999,
+			Avg:  m.LatencyAvg,
+			Min:  m.LatencyMin,
+			Max:  m.LatencyMax,
+		},
+		Transactions: &pb.TransactionCounts{
+			Total:      int32(m.TransactionCount),
+			Successful: int32(m.SuccessfulTx),
+			Failed:     int32(m.FailedTx),
+		},
+		Blocks: &pb.BlockStats{
+			BlockCount:           int32(m.BlockCount),
+			BlockSizeAvg:         m.BlockSizeAvg,
+			BlockPropagationTime: m.BlockPropagationTime,
+		},
+		Resources: &pb.ResourceUsage{
+			CpuUsageAvg:    m.CPUUsageAvg,
+			CpuUsageMax:    m.CPUUsageMax,
+			MemoryUsageAvg: m.MemoryUsageAvg,
+			MemoryUsageMax: m.MemoryUsageMax,
+			NetworkInMbps:  m.NetworkInMbps,
+			NetworkOutMbps: m.NetworkOutMbps,
+			DiskIoRead:     m.DiskIORead,
+			DiskIoWrite:    m.DiskIOWrite,
+		},
+		Consensus: &pb.ConsensusStats{
+			ViewChangeCount:     int32(m.ViewChangeCount),
+			PreparePhaseLatency: m.PreparePhaseLatency,
+			CommitPhaseLatency:  m.CommitPhaseLatency,
+		},
+	}
+}
+
+func mapGroupFromProto(g *pb.BenchmarkGroup) service.BenchmarkGroup {
+	return service.BenchmarkGroup{
+		RunGroup:     g.RunGroup,
+		BenchmarkIDs: g.BenchmarkIds,
+	}
+}
+
+func mapGroupStatisticsToProto(g *service.GroupStatistics) *pb.GroupStatistics {
+	return &pb.GroupStatistics{
+		BenchmarkIds: g.BenchmarkIDs,
+		SampleSize:   int32(g.SampleSize),
+		Mean:         g.Mean,
+		MeanCi:       mapIntervalToProto(g.MeanCI),
+		Median:       g.Median,
+		P99:          g.P99,
+		P99Ci:        mapIntervalToProto(g.P99CI),
+	}
+}
+
+func mapIntervalToProto(ci stats.Interval) *pb.ConfidenceInterval {
+	return &pb.ConfidenceInterval{
+		Lower: ci.Lower,
+		Upper: ci.Upper,
+		Level: ci.Level,
+	}
+}
+
+func mapConsensusConfigToProto(c models.ConsensusConfig) *pb.ConsensusConfig {
+	return &pb.ConsensusConfig{
+		BlockSize:           int32(c.BlockSize),
+		BatchSize:           int32(c.BatchSize),
+		BlockIntervalMs:     int32(c.BlockIntervalMs),
+		RequestTimeoutMs:    int32(c.RequestTimeoutMs),
+		ViewChangeTimeoutMs: int32(c.ViewChangeTimeoutMs),
+		CheckpointInterval:  int32(c.CheckpointInterval),
+		Network: &pb.NetworkEmulation{
+			LatencyMs:         c.Network.LatencyMs,
+			JitterMs:          c.Network.JitterMs,
+			PacketLossPercent: c.Network.PacketLossPercent,
+			BandwidthMbps:     c.Network.BandwidthMbps,
+		},
+	}
+}
+
+func mapConsensusConfigFromProto(c *pb.ConsensusConfig) models.ConsensusConfig {
+	return models.ConsensusConfig{
+		BlockSize:           int(c.GetBlockSize()),
+		BatchSize:           int(c.GetBatchSize()),
+		BlockIntervalMs:     int(c.GetBlockIntervalMs()),
+		RequestTimeoutMs:    int(c.GetRequestTimeoutMs()),
+		ViewChangeTimeoutMs: int(c.GetViewChangeTimeoutMs()),
+		CheckpointInterval:  int(c.GetCheckpointInterval()),
+		Network: models.NetworkEmulation{
+			LatencyMs:         c.GetNetwork().GetLatencyMs(),
+			JitterMs:          c.GetNetwork().GetJitterMs(),
+			PacketLossPercent: c.GetNetwork().GetPacketLossPercent(),
+			BandwidthMbps:     c.GetNetwork().GetBandwidthMbps(),
+		},
+	}
+}
+
+func mapEnvironmentToProto(e models.Environment) *pb.Environment {
+	return &pb.Environment{
+		GitCommit:    e.GitCommit,
+		Version:      e.Version,
+		Os:           e.OS,
+		Kernel:       e.Kernel,
+		CpuModel:     e.CPUModel,
+		CpuCores:     int32(e.CPUCores),
+		MemoryGb:     e.MemoryGB,
+		InstanceType: e.InstanceType,
+		Extra:        e.Extra,
+	}
+}
+
+func mapEnvironmentFromProto(e *pb.Environment) models.Environment {
+	return models.Environment{
+		GitCommit:    e.GetGitCommit(),
+		Version:      e.GetVersion(),
+		OS:           e.GetOs(),
+		Kernel:       e.GetKernel(),
+		CPUModel:     e.GetCpuModel(),
+		CPUCores:     int(e.GetCpuCores()),
+		MemoryGB:     e.GetMemoryGb(),
+		InstanceType: e.GetInstanceType(),
+		Extra:        e.GetExtra(),
 	}
 }
@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/fffeng99999/hcp-server/api/generated/benchmark"
+	"github.com/fffeng99999/hcp-server/internal/models"
+	"github.com/fffeng99999/hcp-server/internal/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *BenchmarkHandler) RecordBenchmarkNodes(ctx context.Context, req *pb.RecordBenchmarkNodesRequest) (*pb.RecordBenchmarkNodesResponse, error) {
+	memberships := make([]models.BenchmarkNode, 0, len(req.Nodes))
+	for _, n := range req.Nodes {
+		m := models.BenchmarkNode{
+			NodeID:      n.NodeId,
+			Role:        n.Role,
+			FinalStatus: n.FinalStatus,
+		}
+		var err error
+		if m.JoinedAt, err = parseOptionalTime(n.JoinedAt); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid joined_at for node %s: %v", n.NodeId, err)
+		}
+		if m.LeftAt, err = parseOptionalTime(n.LeftAt); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid left_at for node %s: %v", n.NodeId, err)
+		}
+		memberships = append(memberships, m)
+	}
+
+	recorded, err := h.nodes.Record(ctx, req.BenchmarkId, memberships)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &pb.RecordBenchmarkNodesResponse{
+		Nodes: mapBenchmarkNodesToProto(recorded),
+	}, nil
+}
+
+func (h *BenchmarkHandler) ListBenchmarkNodes(ctx context.Context, req *pb.ListBenchmarkNodesRequest) (*pb.ListBenchmarkNodesResponse, error) {
+	filter := repository.BenchmarkNodeFilter{
+		Role:       req.Role,
+		FaultyOnly: req.FaultyOnly,
+	}
+
+	memberships, err := h.nodes.List(ctx, req.BenchmarkId, filter)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &pb.ListBenchmarkNodesResponse{
+		Nodes: mapBenchmarkNodesToProto(memberships),
+	}, nil
+}
+
+func parseOptionalTime(s string) (*time.Time, error) {
+	if s == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+func mapBenchmarkNodesToProto(memberships []models.BenchmarkNode) []*pb.BenchmarkNode {
+	var nodes []*pb.BenchmarkNode
+	for i := range memberships {
+		m := &memberships[i]
+		n := &pb.BenchmarkNode{
+			NodeId:      m.NodeID,
+			Role:        m.Role,
+			FinalStatus: m.FinalStatus,
+			Faulty:      m.Faulty(),
+		}
+		if m.Node != nil {
+			n.NodeName = m.Node.Name
+			n.Address = m.Node.Address
+			n.Region = m.Node.Region
+		}
+		if m.JoinedAt != nil {
+			n.JoinedAt = m.JoinedAt.Format(time.RFC3339)
+		}
+		if m.LeftAt != nil {
+			n.LeftAt = m.LeftAt.Format(time.RFC3339)
+		}
+		nodes = append(nodes, n)
+	}
+	return nodes
+}
@@ -0,0 +1,37 @@
+package handlers
+
+import (
+	"context"
+
+	pb "github.com/fffeng99999/hcp-server/api/generated/benchmark"
+)
+
+func (h *BenchmarkHandler) GetBenchmarkQueue(ctx context.Context, req *pb.GetBenchmarkQueueRequest) (*pb.GetBenchmarkQueueResponse, error) {
+	queued, clusters, err := h.queue.List(ctx, req.Cluster)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	resp := &pb.GetBenchmarkQueueResponse{}
+	position := 0
+	for i := range queued {
+		// Queued runs come grouped by cluster, so positions restart with
+		// every cluster.
+		if i == 0 || queued[i].Cluster != queued[i-1].Cluster {
+			position = 0
+		}
+		position++
+		pbBenchmark := mapModelToProto(&queued[i])
+		pbBenchmark.QueuePosition = int32(position)
+		resp.Benchmarks = append(resp.Benchmarks, pbBenchmark)
+	}
+	for _, c := range clusters {
+		resp.Clusters = append(resp.Clusters, &pb.ClusterQueue{
+			Cluster:       c.Cluster,
+			Queued:        int32(c.Queued),
+			Active:        int32(c.Active),
+			MaxConcurrent: int32(c.MaxConcurrent),
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,96 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/fffeng99999/hcp-server/api/generated/benchmark"
+	"github.com/fffeng99999/hcp-server/internal/repository"
+	"github.com/fffeng99999/hcp-server/internal/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *BenchmarkHandler) GetLeaderboard(ctx context.Context, req *pb.GetLeaderboardRequest) (*pb.GetLeaderboardResponse, error) {
+	filter, err := rankingFilter(req.Algorithms, req.NodeCount, req.CreatedAfter, req.CreatedBefore)
+	if err != nil {
+		return nil, err
+	}
+	board, err := h.ranking.Leaderboard(ctx, service.LeaderboardQuery{Filter: filter, RankBy: req.RankBy})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	resp := &pb.GetLeaderboardResponse{
+		RankBy:      board.RankBy,
+		GeneratedAt: board.GeneratedAt.Format(time.RFC3339),
+	}
+	for _, bucket := range board.Buckets {
+		pbBucket := &pb.LeaderboardBucket{NodeCount: int32(bucket.NodeCount)}
+		for _, e := range bucket.Entries {
+			pbBucket.Entries = append(pbBucket.Entries, &pb.LeaderboardEntry{
+				Rank:             int32(e.Rank),
+				Algorithm:        e.Algorithm,
+				Runs:             int32(e.Runs),
+				BestTps:          e.BestTPS,
+				MedianTps:        e.MedianTPS,
+				BestLatencyP99:   e.BestLatencyP99,
+				MedianLatencyP99: e.MedianLatencyP99,
+				BestBenchmarkId:  e.BestBenchmarkID,
+			})
+		}
+		resp.Buckets = append(resp.Buckets, pbBucket)
+	}
+	return resp, nil
+}
+
+func (h *BenchmarkHandler) GetTrend(ctx context.Context, req *pb.GetTrendRequest) (*pb.GetTrendResponse, error) {
+	if req.Algorithm == "" {
+		return nil, status.Error(codes.InvalidArgument, "algorithm is required")
+	}
+	filter, err := rankingFilter([]string{req.Algorithm}, req.NodeCount, req.CreatedAfter, req.CreatedBefore)
+	if err != nil {
+		return nil, err
+	}
+	trend, err := h.ranking.Trend(ctx, service.TrendQuery{
+		Filter:   filter,
+		Metric:   req.Metric,
+		GroupBy:  req.GroupBy,
+		Interval: req.Interval,
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	resp := &pb.GetTrendResponse{
+		Algorithm:   trend.Algorithm,
+		Metric:      trend.Metric,
+		GroupBy:     trend.GroupBy,
+		Interval:    trend.Interval,
+		GeneratedAt: trend.GeneratedAt.Format(time.RFC3339),
+	}
+	for _, p := range trend.Points {
+		resp.Points = append(resp.Points, &pb.TrendPoint{
+			Label:      p.Label,
+			FirstRunAt: p.FirstRunAt.Format(time.RFC3339),
+			Runs:       int32(p.Runs),
+			Mean:       p.Mean,
+			Median:     p.Median,
+			Min:        p.Min,
+			Max:        p.Max,
+		})
+	}
+	return resp, nil
+}
+
+func rankingFilter(algorithms []string, nodeCount int32, createdAfter, createdBefore string) (repository.RankingFilter, error) {
+	filter := repository.RankingFilter{Algorithms: algorithms, NodeCount: int(nodeCount)}
+	var err error
+	if filter.CreatedAfter, err = parseOptionalTime(createdAfter); err != nil {
+		return filter, status.Errorf(codes.InvalidArgument, "invalid created_after: %v", err)
+	}
+	if filter.CreatedBefore, err = parseOptionalTime(createdBefore); err != nil {
+		return filter, status.Errorf(codes.InvalidArgument, "invalid created_before: %v", err)
+	}
+	return filter, nil
+}
@@ -0,0 +1,27 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/fffeng99999/hcp-server/api/generated/benchmark"
+	"github.com/fffeng99999/hcp-server/internal/report"
+)
+
+func (h *BenchmarkHandler) ExportBenchmarkReport(ctx context.Context, req *pb.ExportBenchmarkReportRequest) (*pb.ExportBenchmarkReportResponse, error) {
+	bucket := time.Duration(req.BucketSeconds) * time.Second
+	r, err := h.reporter.Build(ctx, req.Id, bucket)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	out, err := report.Render(r, req.Format)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.ExportBenchmarkReportResponse{
+		Content:     out.Data,
+		ContentType: out.ContentType,
+		Filename:    out.Filename,
+	}, nil
+}
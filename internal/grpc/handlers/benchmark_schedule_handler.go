@@ -0,0 +1,178 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/fffeng99999/hcp-server/api/generated/benchmark"
+	common "github.com/fffeng99999/hcp-server/api/generated/common"
+	"github.com/fffeng99999/hcp-server/internal/models"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// scheduleUpdatePaths maps the field mask paths accepted by
+// UpdateBenchmarkSchedule to a setter on the stored schedule.
+var scheduleUpdatePaths = map[string]func(*models.BenchmarkSchedule, *pb.UpdateBenchmarkScheduleRequest){
+	"name":        func(s *models.BenchmarkSchedule, r *pb.UpdateBenchmarkScheduleRequest) { s.Name = r.Name },
+	"description": func(s *models.BenchmarkSchedule, r *pb.UpdateBenchmarkScheduleRequest) { s.Description = r.Description },
+	"cron_expr":   func(s *models.BenchmarkSchedule, r *pb.UpdateBenchmarkScheduleRequest) { s.CronExpr = r.CronExpr },
+	"timezone":    func(s *models.BenchmarkSchedule, r *pb.UpdateBenchmarkScheduleRequest) { s.Timezone = r.Timezone },
+	"enabled":     func(s *models.BenchmarkSchedule, r *pb.UpdateBenchmarkScheduleRequest) { s.Enabled = r.Enabled },
+	"run_group":   func(s *models.BenchmarkSchedule, r *pb.UpdateBenchmarkScheduleRequest) { s.RunGroup = r.RunGroup },
+	"tags":        func(s *models.BenchmarkSchedule, r *pb.UpdateBenchmarkScheduleRequest) { s.Tags = r.Tags },
+}
+
+func (h *BenchmarkHandler) CreateBenchmarkSchedule(ctx context.Context, req *pb.CreateBenchmarkScheduleRequest) (*pb.CreateBenchmarkScheduleResponse, error) {
+	schedule := &models.BenchmarkSchedule{
+		Name:             req.Name,
+		Description:      req.Description,
+		CronExpr:         req.CronExpr,
+		Timezone:         req.Timezone,
+		Enabled:          !req.Disabled,
+		NamePattern:      req.NamePattern,
+		Algorithm:        req.Algorithm,
+		NodeCount:        int(req.NodeCount),
+		Duration:         int(req.Duration),
+		TargetTPS:        int(req.TargetTps),
+		WarmupDuration:   int(req.WarmupDuration),
+		CooldownDuration: int(req.CooldownDuration),
+		ConsensusConfig:  mapConsensusConfigFromProto(req.ConsensusConfig),
+		ConsensusParams:  req.ConsensusParams,
+		RunGroup:         req.RunGroup,
+		Tags:             req.Tags,
+	}
+	if req.TemplateId != "" {
+		templateID, err := uuid.Parse(req.TemplateId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid template_id: %v", err)
+		}
+		schedule.TemplateID = &templateID
+	}
+
+	created, err := h.schedules.Create(ctx, schedule)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &pb.CreateBenchmarkScheduleResponse{
+		Schedule: mapScheduleToProto(created),
+	}, nil
+}
+
+func (h *BenchmarkHandler) GetBenchmarkSchedule(ctx context.Context, req *pb.GetBenchmarkScheduleRequest) (*pb.GetBenchmarkScheduleResponse, error) {
+	schedule, err := h.schedules.Get(ctx, req.Id)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &pb.GetBenchmarkScheduleResponse{
+		Schedule: mapScheduleToProto(schedule),
+	}, nil
+}
+
+func (h *BenchmarkHandler) ListBenchmarkSchedules(ctx context.Context, req *pb.ListBenchmarkSchedulesRequest) (*pb.ListBenchmarkSchedulesResponse, error) {
+	page := 1
+	pageSize := 10
+	if req.Pagination != nil {
+		if req.Pagination.Page > 0 {
+			page = int(req.Pagination.Page)
+		}
+		if req.Pagination.PageSize > 0 {
+			pageSize = int(req.Pagination.PageSize)
+		}
+	}
+
+	schedules, total, err := h.schedules.List(ctx, page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	var pbSchedules []*pb.BenchmarkSchedule
+	for i := range schedules {
+		pbSchedules = append(pbSchedules, mapScheduleToProto(&schedules[i]))
+	}
+
+	return &pb.ListBenchmarkSchedulesResponse{
+		Schedules: pbSchedules,
+		Pagination: &common.PaginationResponse{
+			TotalItems:  int32(total),
+			TotalPages:  int32((total + int64(pageSize) - 1) / int64(pageSize)),
+			CurrentPage: int32(page),
+		},
+	}, nil
+}
+
+func (h *BenchmarkHandler) UpdateBenchmarkSchedule(ctx context.Context, req *pb.UpdateBenchmarkScheduleRequest) (*pb.UpdateBenchmarkScheduleResponse, error) {
+	paths := req.GetUpdateMask().GetPaths()
+	if len(paths) == 0 {
+		paths = populatedUpdatePaths(req)
+	}
+	if len(paths) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no fields to update")
+	}
+
+	schedule, err := h.schedules.Get(ctx, req.Id)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	for _, path := range paths {
+		set, ok := scheduleUpdatePaths[path]
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown update_mask path %q", path)
+		}
+		set(schedule, req)
+	}
+
+	updated, err := h.schedules.Update(ctx, schedule)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &pb.UpdateBenchmarkScheduleResponse{
+		Schedule: mapScheduleToProto(updated),
+	}, nil
+}
+
+func (h *BenchmarkHandler) DeleteBenchmarkSchedule(ctx context.Context, req *pb.DeleteBenchmarkScheduleRequest) (*common.StatusResponse, error) {
+	if err := h.schedules.Delete(ctx, req.Id); err != nil {
+		return nil, toStatusError(err)
+	}
+	return &common.StatusResponse{Success: true}, nil
+}
+
+func mapScheduleToProto(s *models.BenchmarkSchedule) *pb.BenchmarkSchedule {
+	pbSchedule := &pb.BenchmarkSchedule{
+		Id:               s.ID.String(),
+		Name:             s.Name,
+		Description:      s.Description,
+		CronExpr:         s.CronExpr,
+		Timezone:         s.Timezone,
+		Enabled:          s.Enabled,
+		NamePattern:      s.NamePattern,
+		Algorithm:        s.Algorithm,
+		NodeCount:        int32(s.NodeCount),
+		Duration:         int32(s.Duration),
+		TargetTps:        int32(s.TargetTPS),
+		WarmupDuration:   int32(s.WarmupDuration),
+		CooldownDuration: int32(s.CooldownDuration),
+		ConsensusConfig:  mapConsensusConfigToProto(s.ConsensusConfig),
+		ConsensusParams:  s.ConsensusParams,
+		RunGroup:         s.RunGroup,
+		Tags:             s.Tags,
+		LastError:        s.LastError,
+		CreatedAt:        s.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:        s.UpdatedAt.Format(time.RFC3339),
+	}
+	if s.TemplateID != nil {
+		pbSchedule.TemplateId = s.TemplateID.String()
+	}
+	if s.NextRunAt != nil {
+		pbSchedule.NextRunAt = s.NextRunAt.Format(time.RFC3339)
+	}
+	if s.LastRunAt != nil {
+		pbSchedule.LastRunAt = s.LastRunAt.Format(time.RFC3339)
+	}
+	if s.LastBenchmarkID != nil {
+		pbSchedule.LastBenchmarkId = s.LastBenchmarkID.String()
+	}
+	return pbSchedule
+}
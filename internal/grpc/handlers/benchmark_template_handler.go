@@ -0,0 +1,106 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/fffeng99999/hcp-server/api/generated/benchmark"
+	common "github.com/fffeng99999/hcp-server/api/generated/common"
+	"github.com/fffeng99999/hcp-server/internal/models"
+)
+
+func (h *BenchmarkHandler) CreateBenchmarkTemplate(ctx context.Context, req *pb.CreateBenchmarkTemplateRequest) (*pb.CreateBenchmarkTemplateResponse, error) {
+	template := &models.BenchmarkTemplate{
+		Name:             req.Name,
+		Description:      req.Description,
+		NamePattern:      req.NamePattern,
+		Algorithm:        req.Algorithm,
+		NodeCount:        int(req.NodeCount),
+		Duration:         int(req.Duration),
+		TargetTPS:        int(req.TargetTps),
+		WarmupDuration:   int(req.WarmupDuration),
+		CooldownDuration: int(req.CooldownDuration),
+		RunGroup:         req.RunGroup,
+		ConsensusConfig:  mapConsensusConfigFromProto(req.ConsensusConfig),
+		ConsensusParams:  req.ConsensusParams,
+		Assertions:       req.Assertions,
+	}
+
+	created, err := h.templates.Create(ctx, template)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &pb.CreateBenchmarkTemplateResponse{
+		Template: mapTemplateToProto(created),
+	}, nil
+}
+
+func (h *BenchmarkHandler) GetBenchmarkTemplate(ctx context.Context, req *pb.GetBenchmarkTemplateRequest) (*pb.GetBenchmarkTemplateResponse, error) {
+	template, err := h.templates.Get(ctx, req.Id)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &pb.GetBenchmarkTemplateResponse{
+		Template: mapTemplateToProto(template),
+	}, nil
+}
+
+func (h *BenchmarkHandler) ListBenchmarkTemplates(ctx context.Context, req *pb.ListBenchmarkTemplatesRequest) (*pb.ListBenchmarkTemplatesResponse, error) {
+	page := 1
+	pageSize := 10
+	if req.Pagination != nil {
+		if req.Pagination.Page > 0 {
+			page = int(req.Pagination.Page)
+		}
+		if req.Pagination.PageSize > 0 {
+			pageSize = int(req.Pagination.PageSize)
+		}
+	}
+
+	templates, total, err := h.templates.List(ctx, page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	var pbTemplates []*pb.BenchmarkTemplate
+	for i := range templates {
+		pbTemplates = append(pbTemplates, mapTemplateToProto(&templates[i]))
+	}
+
+	return &pb.ListBenchmarkTemplatesResponse{
+		Templates: pbTemplates,
+		Pagination: &common.PaginationResponse{
+			TotalItems:  int32(total),
+			TotalPages:  int32((total + int64(pageSize) - 1) / int64(pageSize)),
+			CurrentPage: int32(page),
+		},
+	}, nil
+}
+
+func (h *BenchmarkHandler) DeleteBenchmarkTemplate(ctx context.Context, req *pb.DeleteBenchmarkTemplateRequest) (*common.StatusResponse, error) {
+	if err := h.templates.Delete(ctx, req.Id); err != nil {
+		return nil, toStatusError(err)
+	}
+	return &common.StatusResponse{Success: true}, nil
+}
+
+func mapTemplateToProto(t *models.BenchmarkTemplate) *pb.BenchmarkTemplate {
+	return &pb.BenchmarkTemplate{
+		Id:               t.ID.String(),
+		Name:             t.Name,
+		Description:      t.Description,
+		NamePattern:      t.NamePattern,
+		Algorithm:        t.Algorithm,
+		NodeCount:        int32(t.NodeCount),
+		Duration:         int32(t.Duration),
+		TargetTps:        int32(t.TargetTPS),
+		WarmupDuration:   int32(t.WarmupDuration),
+		CooldownDuration: int32(t.CooldownDuration),
+		RunGroup:         t.RunGroup,
+		ConsensusParams:  t.ConsensusParams,
+		ConsensusConfig:  mapConsensusConfigToProto(t.ConsensusConfig),
+		Assertions:       t.Assertions,
+		CreatedAt:        t.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:        t.UpdatedAt.Format(time.RFC3339),
+	}
+}
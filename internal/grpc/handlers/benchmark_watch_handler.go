@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"time"
+
+	pb "github.com/fffeng99999/hcp-server/api/generated/benchmark"
+	"github.com/fffeng99999/hcp-server/internal/service"
+	"google.golang.org/grpc"
+)
+
+func (h *BenchmarkHandler) WatchBenchmark(req *pb.WatchBenchmarkRequest, stream grpc.ServerStreamingServer[pb.BenchmarkProgress]) error {
+	opts := service.WatchOptions{
+		Interval: time.Duration(req.IntervalMs) * time.Millisecond,
+		Window:   time.Duration(req.WindowSeconds) * time.Second,
+	}
+	frames, err := h.monitor.Watch(stream.Context(), req.Id, opts)
+	if err != nil {
+		return toStatusError(err)
+	}
+	for frame := range frames {
+		if err := stream.Send(mapProgressToProto(&frame)); err != nil {
+			return err
+		}
+	}
+	return stream.Context().Err()
+}
+
+func mapProgressToProto(f *service.ProgressFrame) *pb.BenchmarkProgress {
+	p := &pb.BenchmarkProgress{
+		BenchmarkId:    f.BenchmarkID,
+		Status:         f.Status,
+		Timestamp:      f.Time.Format(time.RFC3339Nano),
+		ElapsedSeconds: f.Elapsed.Seconds(),
+		WindowSeconds:  f.Window.Seconds(),
+		RollingTps:     f.RollingTPS,
+		LatencyP50:     f.LatencyP50,
+		LatencyP99:     f.LatencyP99,
+		PendingCount:   f.Pending,
+		ConfirmedCount: f.Confirmed,
+		FailedCount:    f.Failed,
+	}
+	for _, n := range f.Nodes {
+		p.Nodes = append(p.Nodes, &pb.NodeHealth{
+			NodeId:     n.NodeID,
+			Health:     n.Health,
+			LastSeenAt: n.LastSeenAt.Format(time.RFC3339),
+			Metrics:    n.Metrics,
+		})
+	}
+	for _, t := range f.Transitions {
+		p.Transitions = append(p.Transitions, &pb.StatusTransition{
+			From: t.From,
+			To:   t.To,
+			At:   t.At.Format(time.RFC3339Nano),
+		})
+	}
+	return p
+}
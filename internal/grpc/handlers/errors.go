@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"errors"
+
+	"github.com/fffeng99999/hcp-server/internal/chain"
+	"github.com/fffeng99999/hcp-server/internal/report"
+	"github.com/fffeng99999/hcp-server/internal/repository"
+	"github.com/fffeng99999/hcp-server/internal/service"
+	"github.com/fffeng99999/hcp-server/internal/sla"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// toStatusError maps well-known repository and service errors onto gRPC
+// status codes. Unknown errors are returned unchanged.
+func toStatusError(err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, gorm.ErrDuplicatedKey),
+		errors.Is(err, repository.ErrNonceUsed), errors.Is(err, repository.ErrDuplicateTransaction):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, repository.ErrConflict):
+		return status.Error(codes.Aborted, err.Error())
+	case errors.Is(err, repository.ErrInvalidFilter), errors.Is(err, gorm.ErrCheckConstraintViolated),
+		errors.Is(err, service.ErrInvalidComparison), errors.Is(err, service.ErrEmptyGroup),
+		errors.Is(err, service.ErrInvalidExperiment), errors.Is(err, service.ErrInvalidTemplate),
+		errors.Is(err, service.ErrInvalidMembership), errors.Is(err, report.ErrUnknownFormat),
+		errors.Is(err, service.ErrInvalidSchedule), errors.Is(err, sla.ErrInvalid),
+		errors.Is(err, service.ErrInvalidRanking), errors.Is(err, service.ErrInvalidSettlement),
+		errors.Is(err, service.ErrInvalidIngest), errors.Is(err, service.ErrInvalidTransaction),
+		errors.Is(err, chain.ErrInvalidAddress), errors.Is(err, chain.ErrInvalidHash),
+		errors.Is(err, chain.ErrHashMismatch):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, gorm.ErrForeignKeyViolated),
+		errors.Is(err, service.ErrInvalidTransition), errors.Is(err, service.ErrBenchmarkActive),
+		errors.Is(err, repository.ErrBenchmarkFinished),
+		errors.Is(err, service.ErrBenchmarkNotCompleted), errors.Is(err, service.ErrTransactionSettled):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, service.ErrIngestStopped):
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	return err
+}
@@ -0,0 +1,139 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/fffeng99999/hcp-server/api/generated/experiment"
+	"github.com/fffeng99999/hcp-server/internal/models"
+	"github.com/fffeng99999/hcp-server/internal/repository"
+	"github.com/fffeng99999/hcp-server/internal/service"
+)
+
+type ExperimentHandler struct {
+	pb.UnimplementedExperimentServiceServer
+	svc service.ExperimentService
+}
+
+func NewExperimentHandler(svc service.ExperimentService) *ExperimentHandler {
+	return &ExperimentHandler{svc: svc}
+}
+
+func (h *ExperimentHandler) CreateExperiment(ctx context.Context, req *pb.CreateExperimentRequest) (*pb.CreateExperimentResponse, error) {
+	experiment := &models.Experiment{
+		Name:             req.Name,
+		Description:      req.Description,
+		Matrix:           mapMatrixFromProto(req.Matrix),
+		Duration:         int(req.Duration),
+		WarmupDuration:   int(req.WarmupDuration),
+		CooldownDuration: int(req.CooldownDuration),
+	}
+
+	if _, err := h.svc.Create(ctx, experiment); err != nil {
+		return nil, toStatusError(err)
+	}
+	created, progress, err := h.svc.Get(ctx, experiment.ID.String())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.CreateExperimentResponse{
+		Experiment: mapExperimentToProto(created, progress),
+	}, nil
+}
+
+func (h *ExperimentHandler) GetExperiment(ctx context.Context, req *pb.GetExperimentRequest) (*pb.GetExperimentResponse, error) {
+	experiment, progress, err := h.svc.Get(ctx, req.Id)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &pb.GetExperimentResponse{
+		Experiment: mapExperimentToProto(experiment, progress),
+	}, nil
+}
+
+func (h *ExperimentHandler) GetExperimentGrid(ctx context.Context, req *pb.GetExperimentGridRequest) (*pb.GetExperimentGridResponse, error) {
+	axes := req.Axes
+	if len(axes) == 0 {
+		axes = []string{repository.AxisAlgorithm, repository.AxisNodeCount, repository.AxisTargetTPS}
+	}
+
+	cells, err := h.svc.Grid(ctx, req.Id, axes)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	resp := &pb.GetExperimentGridResponse{Axes: axes}
+	for _, c := range cells {
+		resp.Cells = append(resp.Cells, &pb.GridCell{
+			Algorithm:     c.Algorithm,
+			NodeCount:     int32(c.NodeCount),
+			TargetTps:     int32(c.TargetTPS),
+			Runs:          int32(c.Runs),
+			CompletedRuns: int32(c.CompletedRuns),
+			TpsMean:       c.TPSMean,
+			TpsStddev:     c.TPSStddev,
+			TpsMin:        c.TPSMin,
+			TpsMax:        c.TPSMax,
+			LatencyP50:    c.LatencyP50,
+			LatencyP99:    c.LatencyP99,
+		})
+	}
+	return resp, nil
+}
+
+func mapMatrixFromProto(m *pb.ExperimentMatrix) models.ExperimentMatrix {
+	var matrix models.ExperimentMatrix
+	if m == nil {
+		return matrix
+	}
+	matrix.Algorithms = m.Algorithms
+	matrix.Repetitions = int(m.Repetitions)
+	for _, n := range m.NodeCounts {
+		matrix.NodeCounts = append(matrix.NodeCounts, int(n))
+	}
+	for _, tps := range m.TargetTps {
+		matrix.TargetTPS = append(matrix.TargetTPS, int(tps))
+	}
+	return matrix
+}
+
+func mapExperimentToProto(e *models.Experiment, p *service.ExperimentProgress) *pb.Experiment {
+	matrix := &pb.ExperimentMatrix{
+		Algorithms:  e.Matrix.Algorithms,
+		Repetitions: int32(e.Matrix.Repetitions),
+	}
+	for _, n := range e.Matrix.NodeCounts {
+		matrix.NodeCounts = append(matrix.NodeCounts, int32(n))
+	}
+	for _, tps := range e.Matrix.TargetTPS {
+		matrix.TargetTps = append(matrix.TargetTps, int32(tps))
+	}
+
+	pbExperiment := &pb.Experiment{
+		Id:               e.ID.String(),
+		Name:             e.Name,
+		Description:      e.Description,
+		Matrix:           matrix,
+		Duration:         int32(e.Duration),
+		WarmupDuration:   int32(e.WarmupDuration),
+		CooldownDuration: int32(e.CooldownDuration),
+		Status:           e.Status,
+		CreatedAt:        e.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:        e.UpdatedAt.Format(time.RFC3339),
+	}
+	if p != nil {
+		pbExperiment.Progress = &pb.ExperimentProgress{
+			Total:     int32(p.Total),
+			Queued:    int32(p.Queued),
+			Active:    int32(p.Active),
+			Completed: int32(p.Completed),
+			Failed:    int32(p.Failed),
+			Cancelled: int32(p.Cancelled),
+		}
+		if p.Total > 0 {
+			pbExperiment.Progress.Percent = float64(p.Done()) * 100 / float64(p.Total)
+		}
+	}
+	return pbExperiment
+}
@@ -99,9 +99,9 @@ func (h *MetricHandler) GetBenchmarkMetrics(ctx context.Context, req *pb.GetBenc
 		}
 	}
 
-	metrics, total, err := h.svc.GetBenchmarkMetrics(ctx, req.BenchmarkId, req.MetricName, page, pageSize)
+	metrics, total, err := h.svc.GetBenchmarkMetrics(ctx, req.BenchmarkId, req.MetricName, req.IncludeUnmeasured, page, pageSize)
 	if err != nil {
-		return nil, err
+		return nil, toStatusError(err)
 	}
 
 	var pbMetrics []*pb.Metric
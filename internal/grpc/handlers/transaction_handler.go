@@ -7,6 +7,7 @@ import (
 	"github.com/google/uuid"
 	common "github.com/fffeng99999/hcp-server/api/generated/common"
 	pb "github.com/fffeng99999/hcp-server/api/generated/transaction"
+	"github.com/fffeng99999/hcp-server/internal/chain"
 	"github.com/fffeng99999/hcp-server/internal/models"
 	"github.com/fffeng99999/hcp-server/internal/repository"
 	"github.com/fffeng99999/hcp-server/internal/service"
@@ -14,11 +15,12 @@ import (
 
 type TransactionHandler struct {
 	pb.UnimplementedTransactionServiceServer
-	svc service.TransactionService
+	svc      service.TransactionService
+	ingester service.TransactionIngester
 }
 
-func NewTransactionHandler(svc service.TransactionService) *TransactionHandler {
-	return &TransactionHandler{svc: svc}
+func NewTransactionHandler(svc service.TransactionService, ingester service.TransactionIngester) *TransactionHandler {
+	return &TransactionHandler{svc: svc, ingester: ingester}
 }
 
 func (h *TransactionHandler) CreateTransaction(ctx context.Context, req *pb.CreateTransactionRequest) (*pb.CreateTransactionResponse, error) {
@@ -31,18 +33,26 @@ func (h *TransactionHandler) CreateTransaction(ctx context.Context, req *pb.Crea
 	}
 
 	tx := &models.Transaction{
-		Hash:        uuid.New().String(), // Generate a hash if not provided (mock) or use real hash logic
+		Hash:        req.Hash,
 		FromAddress: req.FromAddress,
 		ToAddress:   req.ToAddress,
 		Amount:      req.Amount,
+		GasPrice:    req.GasPrice,
+		GasLimit:    req.GasLimit,
+		Nonce:       req.GetNonce(),
 		BenchmarkID: benchmarkID,
-		Status:      "pending",
+		Status:      models.TransactionStatusPending,
 		SubmittedAt: time.Now(),
 	}
 
-	created, err := h.svc.Create(ctx, tx)
+	// Clients that leave the nonce out get the sender's next one.
+	create := h.svc.Create
+	if req.Nonce == nil {
+		create = h.svc.CreateWithNextNonce
+	}
+	created, err := create(ctx, tx)
 	if err != nil {
-		return nil, err
+		return nil, toStatusError(err)
 	}
 
 	return &pb.CreateTransactionResponse{
@@ -81,6 +91,13 @@ func (h *TransactionHandler) ListTransactions(ctx context.Context, req *pb.ListT
 		ToAddress:   req.ToAddress,
 		Status:      req.Status,
 	}
+	// Addresses are stored checksummed; match them in any case.
+	if addr, err := chain.ChecksumAddress(filter.FromAddress); err == nil {
+		filter.FromAddress = addr
+	}
+	if addr, err := chain.ChecksumAddress(filter.ToAddress); err == nil {
+		filter.ToAddress = addr
+	}
 
 	txs, total, err := h.svc.List(ctx, filter, page, pageSize)
 	if err != nil {
@@ -103,9 +120,9 @@ func (h *TransactionHandler) ListTransactions(ctx context.Context, req *pb.ListT
 }
 
 func (h *TransactionHandler) GetTransactionStats(ctx context.Context, req *pb.GetTransactionStatsRequest) (*pb.GetTransactionStatsResponse, error) {
-	stats, err := h.svc.GetStats(ctx, req.BenchmarkId)
+	stats, err := h.svc.GetStats(ctx, req.BenchmarkId, req.IncludeUnmeasured)
 	if err != nil {
-		return nil, err
+		return nil, toStatusError(err)
 	}
 
 	// Calculate TPS (simplified)
@@ -114,12 +131,15 @@ func (h *TransactionHandler) GetTransactionStats(ctx context.Context, req *pb.Ge
 	// For now, let's just pass what we have.
 
 	return &pb.GetTransactionStatsResponse{
-		TotalTransactions: stats.TotalTransactions,
-		PendingCount:      stats.PendingCount,
-		ConfirmedCount:    stats.ConfirmedCount,
-		FailedCount:       stats.FailedCount,
-		AvgLatencyMs:      stats.AvgLatencyMs,
-		Tps:               tps,
+		TotalTransactions:    stats.TotalTransactions,
+		PendingCount:         stats.PendingCount,
+		ConfirmedCount:       stats.ConfirmedCount,
+		FailedCount:          stats.FailedCount,
+		AvgLatencyMs:         stats.AvgLatencyMs,
+		Tps:                  tps,
+		SenderCount:          stats.NonceGaps.Senders,
+		SendersWithNonceGaps: stats.NonceGaps.SendersWithGaps,
+		MissingNonceCount:    stats.NonceGaps.MissingNonces,
 	}, nil
 }
 
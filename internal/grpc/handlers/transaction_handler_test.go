@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	pb "github.com/fffeng99999/hcp-server/api/generated/transaction"
+	"github.com/fffeng99999/hcp-server/internal/models"
+	"github.com/fffeng99999/hcp-server/internal/repository"
+	"github.com/fffeng99999/hcp-server/internal/service"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// nonceRepository claims nonces per benchmark and sender like the database
+// does. Other methods are not implemented.
+type nonceRepository struct {
+	repository.TransactionRepository
+	used map[string]bool
+}
+
+func nonceKey(benchmarkID, from string, nonce int64) string {
+	return fmt.Sprintf("%s/%s/%d", benchmarkID, from, nonce)
+}
+
+func (r *nonceRepository) Create(ctx context.Context, tx *models.Transaction) error {
+	key := nonceKey(tx.BenchmarkID.String(), tx.FromAddress, tx.Nonce)
+	if r.used[key] {
+		return repository.ErrNonceUsed
+	}
+	r.used[key] = true
+	return nil
+}
+
+func (r *nonceRepository) NextNonce(ctx context.Context, benchmarkID, fromAddress string) (int64, error) {
+	var next int64
+	for r.used[nonceKey(benchmarkID, fromAddress, next)] {
+		next++
+	}
+	return next, nil
+}
+
+func TestCreateTransaction_AssignsNonceWhenUnset(t *testing.T) {
+	repo := &nonceRepository{used: map[string]bool{}}
+	h := NewTransactionHandler(service.NewTransactionService(repo, nil, service.NewBenchmarkMonitor(nil, nil)), nil)
+	ctx := context.Background()
+	req := &pb.CreateTransactionRequest{
+		FromAddress: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+		ToAddress:   "0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359",
+		Amount:      5,
+		BenchmarkId: uuid.NewString(),
+	}
+
+	// Clients that predate nonces send none.
+	for want := int64(0); want < 2; want++ {
+		resp, err := h.CreateTransaction(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, want, resp.Transaction.Nonce)
+	}
+
+	// An explicit nonce is used as given, so a reused one is a replay.
+	nonce := int64(1)
+	req.Nonce = &nonce
+	_, err := h.CreateTransaction(ctx, req)
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+}
@@ -0,0 +1,141 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sort"
+
+	pb "github.com/fffeng99999/hcp-server/api/generated/transaction"
+	"github.com/fffeng99999/hcp-server/internal/models"
+	"github.com/fffeng99999/hcp-server/internal/service"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxPendingAcks bounds the batches of one stream that are written but not
+// yet acknowledged, or still being written.
+const maxPendingAcks = 64
+
+// ingestBatch is a received batch whose rows are being written.
+type ingestBatch struct {
+	sequence int64
+	ticket   *service.IngestTicket
+	// index maps the rows handed to the ingester to their place in the batch.
+	index []int
+	// rejected holds the rows that could not be parsed.
+	rejected []*pb.RejectedTransaction
+}
+
+func (b *ingestBatch) ack(result service.IngestResult) *pb.IngestAck {
+	ack := &pb.IngestAck{Sequence: b.sequence, AcceptedCount: int32(result.Accepted), Rejected: b.rejected}
+	for _, r := range result.Rejected {
+		ack.Rejected = append(ack.Rejected, &pb.RejectedTransaction{Index: int32(b.index[r.Index]), Hash: r.Hash, Reason: r.Reason})
+	}
+	sort.Slice(ack.Rejected, func(i, j int) bool { return ack.Rejected[i].Index < ack.Rejected[j].Index })
+	return ack
+}
+
+// IngestTransactions submits batches as they arrive and acknowledges each,
+// in order, once it has been written. It does not read the next batch while
+// the ingester's queue is full or maxPendingAcks batches await their ack,
+// which lets gRPC flow control hold the client back.
+func (h *TransactionHandler) IngestTransactions(stream grpc.BidiStreamingServer[pb.IngestTransactionsRequest, pb.IngestAck]) error {
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	pending := make(chan *ingestBatch, maxPendingAcks)
+	var recvErr error
+	go func() {
+		defer close(pending)
+		if recvErr = h.receiveIngest(ctx, stream, pending); recvErr != nil {
+			cancel()
+		}
+	}()
+
+	sendErr := sendIngestAcks(ctx, stream, pending)
+	if sendErr != nil {
+		cancel()
+	}
+	// The receiver must be done with the stream before the handler returns.
+	for range pending {
+	}
+	if recvErr != nil {
+		return recvErr
+	}
+	return toStatusError(sendErr)
+}
+
+// receiveIngest submits the batches of the stream until the client closes
+// its side.
+func (h *TransactionHandler) receiveIngest(ctx context.Context, stream grpc.BidiStreamingServer[pb.IngestTransactionsRequest, pb.IngestAck], pending chan<- *ingestBatch) error {
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		batch, err := h.submitIngest(ctx, req)
+		if err != nil {
+			return toStatusError(err)
+		}
+		select {
+		case pending <- batch:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// sendIngestAcks acknowledges the pending batches in order as they are
+// written.
+func sendIngestAcks(ctx context.Context, stream grpc.BidiStreamingServer[pb.IngestTransactionsRequest, pb.IngestAck], pending <-chan *ingestBatch) error {
+	for b := range pending {
+		result, err := b.ticket.Wait(ctx)
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(b.ack(result)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h *TransactionHandler) submitIngest(ctx context.Context, req *pb.IngestTransactionsRequest) (*ingestBatch, error) {
+	benchmarkID, err := uuid.Parse(req.BenchmarkId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "batch %d: invalid benchmark_id %q", req.Sequence, req.BenchmarkId)
+	}
+
+	batch := &ingestBatch{sequence: req.Sequence}
+	txs := make([]*models.Transaction, 0, len(req.Transactions))
+	for i, t := range req.Transactions {
+		submittedAt, err := parseOptionalTime(t.SubmittedAt)
+		if err != nil {
+			batch.rejected = append(batch.rejected, &pb.RejectedTransaction{Index: int32(i), Hash: t.Hash, Reason: "invalid submitted_at: " + err.Error()})
+			continue
+		}
+		txs = append(txs, &models.Transaction{
+			Hash:        t.Hash,
+			FromAddress: t.FromAddress,
+			ToAddress:   t.ToAddress,
+			Amount:      t.Amount,
+			GasPrice:    t.GasPrice,
+			GasLimit:    t.GasLimit,
+			Nonce:       t.Nonce,
+			SubmittedAt: valueOrZero(submittedAt),
+		})
+		batch.index = append(batch.index, i)
+	}
+
+	batch.ticket, err = h.ingester.Submit(ctx, benchmarkID, txs)
+	if err != nil {
+		return nil, err
+	}
+	return batch, nil
+}
@@ -0,0 +1,138 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pb "github.com/fffeng99999/hcp-server/api/generated/transaction"
+	"github.com/fffeng99999/hcp-server/internal/models"
+	"github.com/fffeng99999/hcp-server/internal/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *TransactionHandler) ConfirmTransaction(ctx context.Context, req *pb.ConfirmTransactionRequest) (*pb.ConfirmTransactionResponse, error) {
+	settlement, err := confirmationFromProto(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	tx, err := h.svc.Confirm(ctx, settlement)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &pb.ConfirmTransactionResponse{Transaction: mapTransactionToProto(tx)}, nil
+}
+
+func (h *TransactionHandler) FailTransaction(ctx context.Context, req *pb.FailTransactionRequest) (*pb.FailTransactionResponse, error) {
+	settlement, err := failureFromProto(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	tx, err := h.svc.Fail(ctx, settlement)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &pb.FailTransactionResponse{Transaction: mapTransactionToProto(tx)}, nil
+}
+
+func (h *TransactionHandler) ConfirmTransactions(ctx context.Context, req *pb.ConfirmTransactionsRequest) (*pb.SettleTransactionsResponse, error) {
+	settlements := make([]repository.TransactionSettlement, len(req.Confirmations))
+	parseErrs := make([]error, len(req.Confirmations))
+	for i, c := range req.Confirmations {
+		settlements[i], parseErrs[i] = confirmationFromProto(c)
+	}
+	return h.settle(ctx, settlements, parseErrs)
+}
+
+func (h *TransactionHandler) FailTransactions(ctx context.Context, req *pb.FailTransactionsRequest) (*pb.SettleTransactionsResponse, error) {
+	settlements := make([]repository.TransactionSettlement, len(req.Failures))
+	parseErrs := make([]error, len(req.Failures))
+	for i, f := range req.Failures {
+		settlements[i], parseErrs[i] = failureFromProto(f)
+	}
+	return h.settle(ctx, settlements, parseErrs)
+}
+
+// settle applies the entries that parsed and reports the others with their
+// parse error, keeping the results in request order.
+func (h *TransactionHandler) settle(ctx context.Context, settlements []repository.TransactionSettlement, parseErrs []error) (*pb.SettleTransactionsResponse, error) {
+	var valid []repository.TransactionSettlement
+	for i, err := range parseErrs {
+		if err == nil {
+			valid = append(valid, settlements[i])
+		}
+	}
+	results, err := h.svc.Settle(ctx, valid)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	resp := &pb.SettleTransactionsResponse{Results: make([]*pb.SettlementResult, len(settlements))}
+	next := 0
+	for i, s := range settlements {
+		r := &pb.SettlementResult{Hash: s.Hash}
+		switch {
+		case parseErrs[i] != nil:
+			r.Error = parseErrs[i].Error()
+		case results[next].Err != nil:
+			r.Error = results[next].Err.Error()
+			next++
+		default:
+			r.Transaction = mapTransactionToProto(results[next].Transaction)
+			resp.SettledCount++
+			next++
+		}
+		resp.Results[i] = r
+	}
+	return resp, nil
+}
+
+func confirmationFromProto(req *pb.ConfirmTransactionRequest) (repository.TransactionSettlement, error) {
+	s := repository.TransactionSettlement{
+		Hash:             req.Hash,
+		BenchmarkID:      req.BenchmarkId,
+		Status:           models.TransactionStatusConfirmed,
+		BlockNumber:      req.BlockNumber,
+		BlockHash:        req.BlockHash,
+		TransactionIndex: int(req.TransactionIndex),
+		GasUsed:          req.GasUsed,
+	}
+	submittedAt, err := parseOptionalTime(req.SubmittedAt)
+	if err != nil {
+		return s, fmt.Errorf("invalid submitted_at: %v", err)
+	}
+	confirmedAt, err := parseOptionalTime(req.ConfirmedAt)
+	if err != nil {
+		return s, fmt.Errorf("invalid confirmed_at: %v", err)
+	}
+	s.SubmittedAt = valueOrZero(submittedAt)
+	s.ConfirmedAt = valueOrZero(confirmedAt)
+	return s, nil
+}
+
+func failureFromProto(req *pb.FailTransactionRequest) (repository.TransactionSettlement, error) {
+	s := repository.TransactionSettlement{
+		Hash:             req.Hash,
+		BenchmarkID:      req.BenchmarkId,
+		Status:           models.TransactionStatusFailed,
+		BlockNumber:      req.BlockNumber,
+		BlockHash:        req.BlockHash,
+		TransactionIndex: int(req.TransactionIndex),
+		GasUsed:          req.GasUsed,
+		ErrorMessage:     req.ErrorMessage,
+	}
+	submittedAt, err := parseOptionalTime(req.SubmittedAt)
+	if err != nil {
+		return s, fmt.Errorf("invalid submitted_at: %v", err)
+	}
+	s.SubmittedAt = valueOrZero(submittedAt)
+	return s, nil
+}
+
+func valueOrZero(t *time.Time) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return *t
+}
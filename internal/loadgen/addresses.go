@@ -0,0 +1,82 @@
+package loadgen
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math/rand"
+	"sync"
+
+	"github.com/fffeng99999/hcp-server/internal/chain"
+)
+
+// GenerateAddresses derives n distinct addresses from seed, so repeated runs
+// with the same seed use the same accounts. They are in EIP-55 form.
+func GenerateAddresses(n int, seed int64) []string {
+	addresses := make([]string, n)
+	buf := make([]byte, 16)
+	for i := range addresses {
+		binary.BigEndian.PutUint64(buf, uint64(seed))
+		binary.BigEndian.PutUint64(buf[8:], uint64(i))
+		sum := sha256.Sum256(buf)
+		addresses[i], _ = chain.ChecksumAddress("0x" + hex.EncodeToString(sum[:20]))
+	}
+	return addresses
+}
+
+// AddressPool hands out sender and recipient pairs and keeps the next nonce
+// of every sender. It is safe for concurrent use.
+type AddressPool struct {
+	mu        sync.Mutex
+	addresses []string
+	nonces    map[string]int64
+	rng       *rand.Rand
+}
+
+// NewAddressPool returns a pool over at least two addresses, which are
+// validated and checksummed.
+func NewAddressPool(addresses []string, seed int64) (*AddressPool, error) {
+	if len(addresses) < 2 {
+		return nil, fmt.Errorf("address pool needs at least two addresses, got %d", len(addresses))
+	}
+	checksummed := make([]string, len(addresses))
+	for i, a := range addresses {
+		addr, err := chain.ChecksumAddress(a)
+		if err != nil {
+			return nil, err
+		}
+		checksummed[i] = addr
+	}
+	return &AddressPool{
+		addresses: checksummed,
+		nonces:    make(map[string]int64, len(addresses)),
+		rng:       rand.New(rand.NewSource(seed)),
+	}, nil
+}
+
+// Next picks two different addresses at random and returns them with the
+// sender's next nonce.
+func (p *AddressPool) Next() (from, to string, nonce int64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	i := p.rng.Intn(len(p.addresses))
+	j := p.rng.Intn(len(p.addresses) - 1)
+	if j >= i {
+		j++
+	}
+	from, to = p.addresses[i], p.addresses[j]
+	nonce = p.nonces[from]
+	p.nonces[from] = nonce + 1
+	return from, to, nonce
+}
+
+// amount returns a random amount in [min, max].
+func (p *AddressPool) amount(min, max int64) int64 {
+	if max <= min {
+		return min
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return min + p.rng.Int63n(max-min+1)
+}
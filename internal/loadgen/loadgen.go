@@ -0,0 +1,260 @@
+// Package loadgen submits transactions for a benchmark, either open-loop at a
+// target rate or closed-loop from a fixed number of clients, optionally
+// ramping up to full load.
+//
+// Open-loop runs send on schedule whether or not earlier submissions have
+// finished; sends that find every worker busy are dropped and counted rather
+// than delayed, so a slow system under test cannot hold the offered load
+// down. Closed-loop clients send their next transaction as soon as the
+// previous one was recorded. Either run ends early once the benchmark stops
+// taking transactions.
+package loadgen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/fffeng99999/hcp-server/internal/chain"
+	"github.com/fffeng99999/hcp-server/internal/models"
+	"github.com/google/uuid"
+)
+
+// Load modes.
+const (
+	ModeOpen   = "open"
+	ModeClosed = "closed"
+)
+
+const (
+	defaultWorkers = 64
+	// idleTick is how often an open-loop run looks at its ramp again while
+	// the ramp has the rate at zero.
+	idleTick = 10 * time.Millisecond
+)
+
+// ErrBenchmarkFinished is returned, possibly wrapped, by a Submitter once the
+// benchmark no longer takes transactions.
+var ErrBenchmarkFinished = errors.New("benchmark has finished")
+
+// Submitter records a transaction.
+type Submitter interface {
+	Create(ctx context.Context, tx *models.Transaction) (*models.Transaction, error)
+}
+
+type Config struct {
+	BenchmarkID uuid.UUID
+	// Mode is ModeOpen or ModeClosed.
+	Mode string
+	// Rate is the open-loop target in transactions per second.
+	Rate float64
+	// Clients is the number of closed-loop clients.
+	Clients int
+	// Workers bounds the open-loop submissions in flight; it defaults to 64.
+	Workers  int
+	Duration time.Duration
+	Ramp     Ramp
+	// Amounts are drawn uniformly from [MinAmount, MaxAmount].
+	MinAmount int64
+	MaxAmount int64
+}
+
+// Stats counts what a run has done so far.
+type Stats struct {
+	Submitted int64
+	Failed    int64
+	// Dropped counts open-loop sends skipped because every worker was busy.
+	Dropped int64
+	// Rejected counts sends refused because the benchmark had finished.
+	Rejected int64
+}
+
+type Generator struct {
+	cfg    Config
+	pool   *AddressPool
+	submit Submitter
+	now    func() time.Time
+
+	submitted atomic.Int64
+	failed    atomic.Int64
+	dropped   atomic.Int64
+	rejected  atomic.Int64
+
+	// stop ends the current run.
+	stop context.CancelFunc
+
+	mu       sync.Mutex
+	firstErr error
+}
+
+func New(cfg Config, pool *AddressPool, submit Submitter) (*Generator, error) {
+	if cfg.Workers <= 0 {
+		cfg.Workers = defaultWorkers
+	}
+	switch {
+	case cfg.BenchmarkID == uuid.Nil:
+		return nil, fmt.Errorf("benchmark id is required")
+	case cfg.Mode == ModeOpen && cfg.Rate <= 0:
+		return nil, fmt.Errorf("open-loop rate must be positive")
+	case cfg.Mode == ModeClosed && cfg.Clients <= 0:
+		return nil, fmt.Errorf("closed-loop client count must be positive")
+	case cfg.Mode != ModeOpen && cfg.Mode != ModeClosed:
+		return nil, fmt.Errorf("unknown mode %q", cfg.Mode)
+	case cfg.Duration <= 0:
+		return nil, fmt.Errorf("duration must be positive")
+	case cfg.MinAmount < 0 || cfg.MaxAmount < cfg.MinAmount:
+		return nil, fmt.Errorf("invalid amount range [%d, %d]", cfg.MinAmount, cfg.MaxAmount)
+	}
+	if err := cfg.Ramp.validate(); err != nil {
+		return nil, err
+	}
+	return &Generator{cfg: cfg, pool: pool, submit: submit, now: time.Now}, nil
+}
+
+// Stats returns the counts so far. It may be called while Run is going.
+func (g *Generator) Stats() Stats {
+	return Stats{
+		Submitted: g.submitted.Load(),
+		Failed:    g.failed.Load(),
+		Dropped:   g.dropped.Load(),
+		Rejected:  g.rejected.Load(),
+	}
+}
+
+// Err returns the first submission error, if any.
+func (g *Generator) Err() error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.firstErr
+}
+
+// Run generates load for the configured duration, until ctx is done or until
+// the benchmark finishes, and waits for submissions in flight.
+func (g *Generator) Run(ctx context.Context) Stats {
+	ctx, cancel := context.WithTimeout(ctx, g.cfg.Duration)
+	defer cancel()
+	g.stop = cancel
+	if g.cfg.Mode == ModeOpen {
+		g.runOpen(ctx)
+	} else {
+		g.runClosed(ctx)
+	}
+	return g.Stats()
+}
+
+func (g *Generator) runOpen(ctx context.Context) {
+	jobs := make(chan struct{}, g.cfg.Workers)
+	var wg sync.WaitGroup
+	for i := 0; i < g.cfg.Workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range jobs {
+				// In-flight submissions finish even after the run ends.
+				g.send(context.WithoutCancel(ctx))
+			}
+		}()
+	}
+
+	start := g.now()
+	next := start
+	for {
+		rate := g.cfg.Rate * g.cfg.Ramp.Factor(next.Sub(start))
+		if rate <= 0 {
+			next = next.Add(idleTick)
+			if !g.sleepUntil(ctx, next) {
+				break
+			}
+			continue
+		}
+		next = next.Add(time.Duration(float64(time.Second) / rate))
+		if !g.sleepUntil(ctx, next) {
+			break
+		}
+		select {
+		case jobs <- struct{}{}:
+		default:
+			g.dropped.Add(1)
+		}
+	}
+	close(jobs)
+	wg.Wait()
+}
+
+func (g *Generator) runClosed(ctx context.Context) {
+	start := g.now()
+	var wg sync.WaitGroup
+	for i := 0; i < g.cfg.Clients; i++ {
+		// Client i joins once the ramp calls for i+1 clients.
+		joinAt := start.Add(g.cfg.Ramp.Until(float64(i+1) / float64(g.cfg.Clients)))
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if !g.sleepUntil(ctx, joinAt) {
+				return
+			}
+			for ctx.Err() == nil {
+				g.send(context.WithoutCancel(ctx))
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// send builds and records one transaction, stamping it just before it is
+// handed over.
+func (g *Generator) send(ctx context.Context) {
+	from, to, nonce := g.pool.Next()
+	tx := &models.Transaction{
+		FromAddress: from,
+		ToAddress:   to,
+		Amount:      g.pool.amount(g.cfg.MinAmount, g.cfg.MaxAmount),
+		Nonce:       nonce,
+		Status:      models.TransactionStatusPending,
+		BenchmarkID: g.cfg.BenchmarkID,
+	}
+	hash, err := chain.TransactionHash(tx)
+	if err != nil {
+		g.fail(err)
+		return
+	}
+	tx.Hash = hash
+	tx.SubmittedAt = g.now()
+	if _, err := g.submit.Create(ctx, tx); err != nil {
+		if errors.Is(err, ErrBenchmarkFinished) {
+			g.rejected.Add(1)
+			g.stop()
+			return
+		}
+		g.fail(err)
+		return
+	}
+	g.submitted.Add(1)
+}
+
+func (g *Generator) fail(err error) {
+	g.failed.Add(1)
+	g.mu.Lock()
+	if g.firstErr == nil {
+		g.firstErr = err
+	}
+	g.mu.Unlock()
+}
+
+func (g *Generator) sleepUntil(ctx context.Context, t time.Time) bool {
+	d := t.Sub(g.now())
+	if d <= 0 {
+		return ctx.Err() == nil
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
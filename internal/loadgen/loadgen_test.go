@@ -0,0 +1,182 @@
+package loadgen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/fffeng99999/hcp-server/internal/chain"
+	"github.com/fffeng99999/hcp-server/internal/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recorder is a Submitter that keeps what it was given and tracks how many
+// submissions overlap.
+type recorder struct {
+	delay time.Duration
+	err   error
+
+	mu       sync.Mutex
+	txs      []*models.Transaction
+	inFlight atomic.Int64
+	peak     atomic.Int64
+}
+
+func (r *recorder) Create(ctx context.Context, tx *models.Transaction) (*models.Transaction, error) {
+	n := r.inFlight.Add(1)
+	defer r.inFlight.Add(-1)
+	for {
+		peak := r.peak.Load()
+		if n <= peak || r.peak.CompareAndSwap(peak, n) {
+			break
+		}
+	}
+	time.Sleep(r.delay)
+	if r.err != nil {
+		return nil, r.err
+	}
+	r.mu.Lock()
+	r.txs = append(r.txs, tx)
+	r.mu.Unlock()
+	return tx, nil
+}
+
+func newPool(t *testing.T) *AddressPool {
+	t.Helper()
+	pool, err := NewAddressPool(GenerateAddresses(4, 1), 1)
+	require.NoError(t, err)
+	return pool
+}
+
+func TestRamp(t *testing.T) {
+	linear := Ramp{Profile: RampLinear, Duration: 10 * time.Second}
+	assert.Equal(t, 0.0, linear.Factor(0))
+	assert.Equal(t, 0.5, linear.Factor(5*time.Second))
+	assert.Equal(t, 1.0, linear.Factor(20*time.Second))
+	assert.Equal(t, 5*time.Second, linear.Until(0.5))
+
+	step := Ramp{Profile: RampStep, Duration: 10 * time.Second, Steps: 4}
+	assert.Equal(t, 0.25, step.Factor(0))
+	assert.Equal(t, 0.5, step.Factor(4*time.Second))
+	assert.Equal(t, 0.75, step.Factor(5*time.Second))
+	assert.Equal(t, 1.0, step.Factor(9*time.Second))
+	assert.Equal(t, time.Duration(0), step.Until(0.25))
+	assert.Equal(t, 5*time.Second, step.Until(0.6))
+	assert.Equal(t, 7500*time.Millisecond, step.Until(1))
+
+	assert.Equal(t, 1.0, Ramp{}.Factor(0))
+	assert.Error(t, Ramp{Profile: RampStep, Duration: time.Second}.validate())
+	assert.Error(t, Ramp{Profile: "exponential"}.validate())
+}
+
+func TestAddressPool(t *testing.T) {
+	addresses := GenerateAddresses(3, 42)
+	assert.Equal(t, addresses, GenerateAddresses(3, 42))
+	assert.NotEqual(t, addresses, GenerateAddresses(3, 43))
+
+	pool, err := NewAddressPool(addresses, 7)
+	require.NoError(t, err)
+	nonces := map[string]int64{}
+	for i := 0; i < 100; i++ {
+		from, to, nonce := pool.Next()
+		assert.NotEqual(t, from, to)
+		assert.Equal(t, nonces[from], nonce)
+		nonces[from]++
+	}
+
+	_, err = NewAddressPool(addresses[:1], 7)
+	assert.Error(t, err)
+	_, err = NewAddressPool([]string{addresses[0], "0x1234"}, 7)
+	assert.Error(t, err)
+}
+
+func TestGenerator_OpenLoop(t *testing.T) {
+	rec := &recorder{}
+	id := uuid.New()
+	g, err := New(Config{BenchmarkID: id, Mode: ModeOpen, Rate: 500, Duration: 200 * time.Millisecond, MinAmount: 1, MaxAmount: 10}, newPool(t), rec)
+	require.NoError(t, err)
+
+	start := time.Now()
+	stats := g.Run(context.Background())
+
+	// About 100 at 500/s for 200ms; timers are coarse on loaded machines.
+	assert.InDelta(t, 100, stats.Submitted, 40)
+	assert.Zero(t, stats.Failed)
+	require.NotEmpty(t, rec.txs)
+	tx := rec.txs[0]
+	assert.Equal(t, id, tx.BenchmarkID)
+	assert.Equal(t, models.TransactionStatusPending, tx.Status)
+	assert.NoError(t, chain.VerifyHash(tx))
+	assert.True(t, tx.Amount >= 1 && tx.Amount <= 10)
+	assert.False(t, tx.SubmittedAt.Before(start))
+}
+
+func TestGenerator_OpenLoopDropsWhenSaturated(t *testing.T) {
+	rec := &recorder{delay: 50 * time.Millisecond}
+	g, err := New(Config{BenchmarkID: uuid.New(), Mode: ModeOpen, Rate: 1000, Workers: 2, Duration: 100 * time.Millisecond}, newPool(t), rec)
+	require.NoError(t, err)
+
+	stats := g.Run(context.Background())
+
+	assert.Positive(t, stats.Dropped)
+	assert.LessOrEqual(t, rec.peak.Load(), int64(2))
+}
+
+func TestGenerator_ClosedLoop(t *testing.T) {
+	rec := &recorder{delay: 5 * time.Millisecond}
+	g, err := New(Config{BenchmarkID: uuid.New(), Mode: ModeClosed, Clients: 3, Duration: 100 * time.Millisecond}, newPool(t), rec)
+	require.NoError(t, err)
+
+	stats := g.Run(context.Background())
+
+	assert.Positive(t, stats.Submitted)
+	assert.Equal(t, int64(3), rec.peak.Load())
+	assert.Zero(t, stats.Dropped)
+}
+
+func TestGenerator_RecordsFailures(t *testing.T) {
+	rec := &recorder{err: errors.New("database is down")}
+	g, err := New(Config{BenchmarkID: uuid.New(), Mode: ModeClosed, Clients: 1, Duration: 20 * time.Millisecond}, newPool(t), rec)
+	require.NoError(t, err)
+
+	stats := g.Run(context.Background())
+
+	assert.Positive(t, stats.Failed)
+	assert.Zero(t, stats.Submitted)
+	assert.EqualError(t, g.Err(), "database is down")
+}
+
+func TestGenerator_StopsWhenBenchmarkFinishes(t *testing.T) {
+	rec := &recorder{err: fmt.Errorf("rpc error: %w", ErrBenchmarkFinished)}
+	g, err := New(Config{BenchmarkID: uuid.New(), Mode: ModeClosed, Clients: 2, Duration: time.Minute}, newPool(t), rec)
+	require.NoError(t, err)
+
+	start := time.Now()
+	stats := g.Run(context.Background())
+
+	assert.Less(t, time.Since(start), 10*time.Second)
+	assert.Positive(t, stats.Rejected)
+	assert.Zero(t, stats.Failed)
+	assert.NoError(t, g.Err())
+}
+
+func TestNew_Validates(t *testing.T) {
+	id := uuid.New()
+	for _, cfg := range []Config{
+		{Mode: ModeOpen, Rate: 1, Duration: time.Second},
+		{BenchmarkID: id, Mode: ModeOpen, Duration: time.Second},
+		{BenchmarkID: id, Mode: ModeClosed, Duration: time.Second},
+		{BenchmarkID: id, Mode: "burst", Rate: 1, Duration: time.Second},
+		{BenchmarkID: id, Mode: ModeOpen, Rate: 1},
+		{BenchmarkID: id, Mode: ModeOpen, Rate: 1, Duration: time.Second, MinAmount: 5, MaxAmount: 1},
+	} {
+		_, err := New(cfg, nil, &recorder{})
+		assert.Error(t, err, "%+v", cfg)
+	}
+}
@@ -0,0 +1,74 @@
+package loadgen
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// Ramp profiles.
+const (
+	RampNone   = "none"
+	RampLinear = "linear"
+	RampStep   = "step"
+)
+
+// Ramp raises the load from nothing to full over Duration. Open-loop runs
+// scale their rate by it, closed-loop runs the number of active clients.
+type Ramp struct {
+	// Profile is RampNone (the default), RampLinear or RampStep.
+	Profile  string
+	Duration time.Duration
+	// Steps is the number of equal steps of RampStep.
+	Steps int
+}
+
+func (r Ramp) validate() error {
+	switch r.Profile {
+	case "", RampNone:
+		return nil
+	case RampLinear:
+	case RampStep:
+		if r.Steps <= 0 {
+			return fmt.Errorf("step ramp needs a positive number of steps")
+		}
+	default:
+		return fmt.Errorf("unknown ramp profile %q", r.Profile)
+	}
+	if r.Duration < 0 {
+		return fmt.Errorf("ramp duration must not be negative")
+	}
+	return nil
+}
+
+// Factor is the share of the full load, between 0 and 1, applied elapsed
+// into the run.
+func (r Ramp) Factor(elapsed time.Duration) float64 {
+	if r.Duration <= 0 || elapsed >= r.Duration {
+		return 1
+	}
+	switch r.Profile {
+	case RampLinear:
+		return float64(elapsed) / float64(r.Duration)
+	case RampStep:
+		step := int(elapsed * time.Duration(r.Steps) / r.Duration)
+		return float64(step+1) / float64(r.Steps)
+	}
+	return 1
+}
+
+// Until is the earliest point into the run at which Factor reaches f.
+func (r Ramp) Until(f float64) time.Duration {
+	if r.Duration <= 0 || f <= 0 {
+		return 0
+	}
+	f = math.Min(f, 1)
+	switch r.Profile {
+	case RampLinear:
+		return time.Duration(f * float64(r.Duration))
+	case RampStep:
+		step := int(math.Ceil(f*float64(r.Steps))) - 1
+		return time.Duration(step) * r.Duration / time.Duration(r.Steps)
+	}
+	return 0
+}
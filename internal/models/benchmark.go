@@ -4,9 +4,38 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"gorm.io/gorm"
 )
 
+// Benchmark lifecycle states. A run moves forward through
+// queued -> provisioning -> warmup -> running -> cooldown -> completed and may
+// drop into failed or cancelled from any non-terminal state.
+const (
+	BenchmarkStatusQueued       = "queued"
+	BenchmarkStatusProvisioning = "provisioning"
+	BenchmarkStatusWarmup       = "warmup"
+	BenchmarkStatusRunning      = "running"
+	BenchmarkStatusCooldown     = "cooldown"
+	BenchmarkStatusCompleted    = "completed"
+	BenchmarkStatusFailed       = "failed"
+	BenchmarkStatusCancelled    = "cancelled"
+)
+
+// ActiveBenchmarkStatuses are the states in which a run occupies its cluster.
+var ActiveBenchmarkStatuses = []string{
+	BenchmarkStatusProvisioning,
+	BenchmarkStatusWarmup,
+	BenchmarkStatusRunning,
+	BenchmarkStatusCooldown,
+}
+
+// DefaultCluster is the cluster runs are queued for when none is given.
+const DefaultCluster = "default"
+
+// SupportedAlgorithms mirrors the chk_algorithm constraint on benchmarks.
+var SupportedAlgorithms = []string{"tPBFT", "Raft", "HotStuff", "Leios", "HybridPBFT"}
+
 type Benchmark struct {
 	ID uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
 
@@ -18,6 +47,45 @@ type Benchmark struct {
 	Duration    int    `gorm:"not null" json:"duration"` // seconds
 	TargetTPS   int    `json:"target_tps"`
 
+	// Warmup and cooldown surround the measured duration. Transactions and
+	// metrics from them are kept but left out of the results.
+	WarmupDuration   int `gorm:"not null;default:0" json:"warmup_duration"`   // seconds
+	CooldownDuration int `gorm:"not null;default:0" json:"cooldown_duration"` // seconds
+
+	// Configuration and environment manifest. ConsensusParams holds
+	// algorithm-specific settings ConsensusConfig has no field for.
+	ConsensusConfig ConsensusConfig   `gorm:"serializer:json;type:jsonb" json:"consensus_config"`
+	ConsensusParams map[string]string `gorm:"serializer:json;type:jsonb" json:"consensus_params"`
+	Environment     Environment       `gorm:"serializer:json;type:jsonb" json:"environment"`
+
+	// RunGroup ties repeated runs of the same logical experiment together so
+	// they can be analysed as one sample.
+	RunGroup string `gorm:"type:varchar(100);index" json:"run_group"`
+
+	// Tags are free-form labels for filtering, e.g. "nightly" or "pr-1234".
+	Tags pq.StringArray `gorm:"type:text[]" json:"tags"`
+
+	// Experiment Relation (set for runs expanded from an experiment matrix)
+	ExperimentID *uuid.UUID `gorm:"type:uuid;index" json:"experiment_id"`
+
+	// Provenance (set for runs created from a template, cloned from a run or
+	// started by a schedule)
+	TemplateID   *uuid.UUID `gorm:"type:uuid" json:"template_id"`
+	ClonedFromID *uuid.UUID `gorm:"type:uuid" json:"cloned_from_id"`
+	ScheduleID   *uuid.UUID `gorm:"type:uuid;index" json:"schedule_id"`
+
+	// Queueing: runs wait in their cluster's queue until the dispatcher has a
+	// free slot; higher priorities go first, then older runs.
+	Priority int    `gorm:"not null;default:0" json:"priority"`
+	Cluster  string `gorm:"type:varchar(100);not null;default:'default'" json:"cluster"`
+
+	// SLA assertions, e.g. "latency_p99 < 500ms", evaluated against the
+	// results when the run completes. SLAStatus stays empty until then and for
+	// runs without assertions.
+	Assertions       pq.StringArray    `gorm:"type:text[]" json:"assertions"`
+	AssertionResults []AssertionResult `gorm:"serializer:json;type:jsonb" json:"assertion_results"`
+	SLAStatus        string            `gorm:"type:varchar(10);index" json:"sla_status"`
+
 	// Performance Metrics
 	ActualTPS   float64 `gorm:"type:decimal(10,2)" json:"actual_tps"`
 	LatencyP50  float64 `gorm:"type:decimal(10,4)" json:"latency_p50"`
@@ -52,12 +120,18 @@ type Benchmark struct {
 	CommitPhaseLatency  float64 `gorm:"type:decimal(10,4)" json:"commit_phase_latency"`
 
 	// Status & Metadata
-	Status       string     `gorm:"type:varchar(20);default:'running'" json:"status"`
+	Status       string     `gorm:"type:varchar(20);default:'queued'" json:"status"`
 	ErrorMessage string     `gorm:"type:text" json:"error_message"`
 	StartedAt    *time.Time `json:"started_at"`
 	CompletedAt  *time.Time `json:"completed_at"`
-	CreatedAt    time.Time  `gorm:"default:CURRENT_TIMESTAMP" json:"created_at"`
-	UpdatedAt    time.Time  `gorm:"default:CURRENT_TIMESTAMP" json:"updated_at"`
+
+	// Measurement window: when the run entered and left the running phase.
+	// Results only count transactions submitted inside it.
+	MeasurementStartedAt *time.Time `json:"measurement_started_at"`
+	MeasurementEndedAt   *time.Time `json:"measurement_ended_at"`
+
+	CreatedAt time.Time `gorm:"default:CURRENT_TIMESTAMP" json:"created_at"`
+	UpdatedAt time.Time `gorm:"default:CURRENT_TIMESTAMP" json:"updated_at"`
 }
 
 func (b *Benchmark) BeforeCreate(tx *gorm.DB) (err error) {
@@ -66,3 +140,12 @@ func (b *Benchmark) BeforeCreate(tx *gorm.DB) (err error) {
 	}
 	return
 }
+
+// IsTerminal reports whether the benchmark has reached a final state.
+func (b *Benchmark) IsTerminal() bool {
+	switch b.Status {
+	case BenchmarkStatusCompleted, BenchmarkStatusFailed, BenchmarkStatusCancelled:
+		return true
+	}
+	return false
+}
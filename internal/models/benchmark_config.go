@@ -0,0 +1,36 @@
+package models
+
+// ConsensusConfig holds the consensus settings a run was started with. Zero
+// values mean the implementation's default was used. Settings without a
+// field here go into Benchmark.ConsensusParams.
+type ConsensusConfig struct {
+	BlockSize           int              `json:"block_size,omitempty"` // max transactions per block
+	BatchSize           int              `json:"batch_size,omitempty"`
+	BlockIntervalMs     int              `json:"block_interval_ms,omitempty"`
+	RequestTimeoutMs    int              `json:"request_timeout_ms,omitempty"`
+	ViewChangeTimeoutMs int              `json:"view_change_timeout_ms,omitempty"`
+	CheckpointInterval  int              `json:"checkpoint_interval,omitempty"`
+	Network             NetworkEmulation `json:"network"`
+}
+
+// NetworkEmulation describes the impairments injected between nodes.
+type NetworkEmulation struct {
+	LatencyMs         float64 `json:"latency_ms,omitempty"`
+	JitterMs          float64 `json:"jitter_ms,omitempty"`
+	PacketLossPercent float64 `json:"packet_loss_percent,omitempty"`
+	BandwidthMbps     float64 `json:"bandwidth_mbps,omitempty"`
+}
+
+// Environment is a snapshot of what a run executed on: the build of the
+// consensus implementation under test and the nodes' hardware and OS.
+type Environment struct {
+	GitCommit    string            `json:"git_commit,omitempty"`
+	Version      string            `json:"version,omitempty"`
+	OS           string            `json:"os,omitempty"`
+	Kernel       string            `json:"kernel,omitempty"`
+	CPUModel     string            `json:"cpu_model,omitempty"`
+	CPUCores     int               `json:"cpu_cores,omitempty"`
+	MemoryGB     float64           `json:"memory_gb,omitempty"`
+	InstanceType string            `json:"instance_type,omitempty"`
+	Extra        map[string]string `json:"extra,omitempty"`
+}
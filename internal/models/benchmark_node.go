@@ -0,0 +1,60 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Roles a node can play in a benchmark run.
+const (
+	BenchmarkNodeRoleLeader    = "leader"
+	BenchmarkNodeRoleValidator = "validator"
+	BenchmarkNodeRoleObserver  = "observer"
+)
+
+// Final states of a node in a benchmark run. Crashed, byzantine and
+// partitioned nodes count as faulty.
+const (
+	BenchmarkNodeStatusActive      = "active"
+	BenchmarkNodeStatusHealthy     = "healthy"
+	BenchmarkNodeStatusCrashed     = "crashed"
+	BenchmarkNodeStatusByzantine   = "byzantine"
+	BenchmarkNodeStatusPartitioned = "partitioned"
+)
+
+// FaultyBenchmarkNodeStatuses lists the final states that count as faulty.
+var FaultyBenchmarkNodeStatuses = []string{
+	BenchmarkNodeStatusCrashed,
+	BenchmarkNodeStatusByzantine,
+	BenchmarkNodeStatusPartitioned,
+}
+
+// BenchmarkNode records a node's participation in a benchmark run.
+type BenchmarkNode struct {
+	BenchmarkID uuid.UUID `gorm:"type:uuid;primaryKey" json:"benchmark_id"`
+	NodeID      string    `gorm:"type:varchar(50);primaryKey;index" json:"node_id"`
+
+	Role        string `gorm:"type:varchar(20);not null" json:"role"`               // leader/validator/observer
+	FinalStatus string `gorm:"type:varchar(20);not null;index" json:"final_status"` // active/healthy/crashed/byzantine/partitioned
+
+	JoinedAt *time.Time `json:"joined_at"`
+	LeftAt   *time.Time `json:"left_at"`
+
+	// Relations
+	Node *Node `gorm:"foreignKey:NodeID" json:"node,omitempty"`
+
+	// Time
+	CreatedAt time.Time `gorm:"default:CURRENT_TIMESTAMP" json:"created_at"`
+	UpdatedAt time.Time `gorm:"default:CURRENT_TIMESTAMP" json:"updated_at"`
+}
+
+// Faulty reports whether the node ended the run in a faulty state.
+func (n *BenchmarkNode) Faulty() bool {
+	for _, s := range FaultyBenchmarkNodeStatuses {
+		if n.FinalStatus == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,59 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+)
+
+// BenchmarkSchedule starts a benchmark whenever its cron expression fires. The
+// run is created from the referenced template or, without one, from the
+// schedule's own configuration.
+type BenchmarkSchedule struct {
+	ID uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+
+	// Basic Info
+	Name        string `gorm:"type:varchar(255);not null;uniqueIndex" json:"name"`
+	Description string `gorm:"type:text" json:"description"`
+
+	// Timing
+	CronExpr string `gorm:"type:varchar(100);not null" json:"cron_expr"`
+	// Timezone is the IANA zone the cron expression is evaluated in.
+	Timezone string `gorm:"type:varchar(64);not null;default:'UTC'" json:"timezone"`
+	Enabled  bool   `gorm:"not null" json:"enabled"`
+
+	// Configuration
+	TemplateID       *uuid.UUID        `gorm:"type:uuid" json:"template_id"`
+	NamePattern      string            `gorm:"type:varchar(255)" json:"name_pattern"`
+	Algorithm        string            `gorm:"type:varchar(50)" json:"algorithm"`
+	NodeCount        int               `json:"node_count"`
+	Duration         int               `json:"duration"` // seconds
+	TargetTPS        int               `json:"target_tps"`
+	WarmupDuration   int               `gorm:"not null;default:0" json:"warmup_duration"`   // seconds
+	CooldownDuration int               `gorm:"not null;default:0" json:"cooldown_duration"` // seconds
+	ConsensusConfig  ConsensusConfig   `gorm:"serializer:json;type:jsonb" json:"consensus_config"`
+	ConsensusParams  map[string]string `gorm:"serializer:json;type:jsonb" json:"consensus_params"`
+	// RunGroup defaults to the schedule name so consecutive runs can be
+	// compared against each other.
+	RunGroup string         `gorm:"type:varchar(100)" json:"run_group"`
+	Tags     pq.StringArray `gorm:"type:text[]" json:"tags"`
+
+	// State
+	NextRunAt       *time.Time `gorm:"index" json:"next_run_at"`
+	LastRunAt       *time.Time `json:"last_run_at"`
+	LastBenchmarkID *uuid.UUID `gorm:"type:uuid" json:"last_benchmark_id"`
+	LastError       string     `gorm:"type:text" json:"last_error"`
+
+	// Time
+	CreatedAt time.Time `gorm:"default:CURRENT_TIMESTAMP" json:"created_at"`
+	UpdatedAt time.Time `gorm:"default:CURRENT_TIMESTAMP" json:"updated_at"`
+}
+
+func (s *BenchmarkSchedule) BeforeCreate(tx *gorm.DB) (err error) {
+	if s.ID == uuid.Nil {
+		s.ID = uuid.New()
+	}
+	return
+}
@@ -0,0 +1,18 @@
+package models
+
+// Outcomes of a run's SLA assertions.
+const (
+	SLAStatusPassed = "passed"
+	SLAStatusFailed = "failed"
+)
+
+// AssertionResult is the outcome of one SLA assertion. Left and Right are the
+// values its two sides evaluated to; Error is set when it could not be
+// evaluated, which counts as a failure.
+type AssertionResult struct {
+	Assertion string  `json:"assertion"`
+	Passed    bool    `json:"passed"`
+	Left      float64 `json:"left"`
+	Right     float64 `json:"right"`
+	Error     string  `json:"error,omitempty"`
+}
@@ -0,0 +1,46 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+)
+
+// BenchmarkTemplate is a reusable benchmark configuration. Runs created from
+// it copy its settings; fields set on the create request take precedence.
+type BenchmarkTemplate struct {
+	ID uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+
+	// Basic Info
+	Name        string `gorm:"type:varchar(255);not null;uniqueIndex" json:"name"`
+	Description string `gorm:"type:text" json:"description"`
+	// NamePattern names the runs created from the template. It may contain the
+	// placeholders {template}, {algorithm}, {node_count}, {target_tps},
+	// {duration}, {date} and {time}.
+	NamePattern string `gorm:"type:varchar(255)" json:"name_pattern"`
+
+	// Configuration
+	Algorithm        string            `gorm:"type:varchar(50);not null" json:"algorithm"`
+	NodeCount        int               `gorm:"not null" json:"node_count"`
+	Duration         int               `gorm:"not null" json:"duration"` // seconds
+	TargetTPS        int               `json:"target_tps"`
+	WarmupDuration   int               `gorm:"not null;default:0" json:"warmup_duration"`   // seconds
+	CooldownDuration int               `gorm:"not null;default:0" json:"cooldown_duration"` // seconds
+	RunGroup         string            `gorm:"type:varchar(100)" json:"run_group"`
+	ConsensusConfig  ConsensusConfig   `gorm:"serializer:json;type:jsonb" json:"consensus_config"`
+	ConsensusParams  map[string]string `gorm:"serializer:json;type:jsonb" json:"consensus_params"`
+	Assertions       pq.StringArray    `gorm:"type:text[]" json:"assertions"`
+
+	// Time
+	CreatedAt time.Time `gorm:"default:CURRENT_TIMESTAMP" json:"created_at"`
+	UpdatedAt time.Time `gorm:"default:CURRENT_TIMESTAMP" json:"updated_at"`
+}
+
+func (t *BenchmarkTemplate) BeforeCreate(tx *gorm.DB) (err error) {
+	if t.ID == uuid.Nil {
+		t.ID = uuid.New()
+	}
+	return
+}
@@ -0,0 +1,58 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Experiment lifecycle states, derived from the states of its benchmarks.
+const (
+	ExperimentStatusQueued    = "queued"
+	ExperimentStatusRunning   = "running"
+	ExperimentStatusCompleted = "completed"
+)
+
+// ExperimentMatrix is the parameter sweep an experiment expands into: one
+// benchmark per algorithm x node count x target TPS x repetition.
+type ExperimentMatrix struct {
+	Algorithms  []string `json:"algorithms"`
+	NodeCounts  []int    `json:"node_counts"`
+	TargetTPS   []int    `json:"target_tps"`
+	Repetitions int      `json:"repetitions"`
+}
+
+// Size is the number of benchmarks the matrix expands into.
+func (m ExperimentMatrix) Size() int {
+	return len(m.Algorithms) * len(m.NodeCounts) * len(m.TargetTPS) * m.Repetitions
+}
+
+type Experiment struct {
+	ID uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+
+	// Basic Info
+	Name        string `gorm:"type:varchar(255);not null" json:"name"`
+	Description string `gorm:"type:text" json:"description"`
+
+	// Sweep
+	Matrix   ExperimentMatrix `gorm:"serializer:json;type:jsonb;not null" json:"matrix"`
+	Duration int              `gorm:"not null" json:"duration"` // seconds, per run
+	// Per-run warmup and cooldown around the measured duration.
+	WarmupDuration   int `gorm:"not null;default:0" json:"warmup_duration"`   // seconds
+	CooldownDuration int `gorm:"not null;default:0" json:"cooldown_duration"` // seconds
+
+	// Status
+	Status string `gorm:"type:varchar(20);default:'queued';index" json:"status"`
+
+	// Time
+	CreatedAt time.Time `gorm:"default:CURRENT_TIMESTAMP" json:"created_at"`
+	UpdatedAt time.Time `gorm:"default:CURRENT_TIMESTAMP" json:"updated_at"`
+}
+
+func (e *Experiment) BeforeCreate(tx *gorm.DB) (err error) {
+	if e.ID == uuid.Nil {
+		e.ID = uuid.New()
+	}
+	return
+}
@@ -6,6 +6,16 @@ import (
 	"github.com/google/uuid"
 )
 
+// Transaction states.
+const (
+	TransactionStatusPending   = "pending"
+	TransactionStatusConfirmed = "confirmed"
+	TransactionStatusFailed    = "failed"
+)
+
+// MaxLatencyMs is the largest latency the latency_ms column holds.
+const MaxLatencyMs = 9999999999.9999
+
 type Transaction struct {
 	Hash string `gorm:"type:varchar(66);primary_key" json:"hash"`
 
@@ -32,7 +42,7 @@ type Transaction struct {
 	ConfirmedAt *time.Time `json:"confirmed_at"`
 
 	// Metrics
-	LatencyMs float64 `gorm:"type:decimal(10,4)" json:"latency_ms"`
+	LatencyMs float64 `gorm:"type:decimal(14,4)" json:"latency_ms"`
 
 	// Benchmark Relation
 	BenchmarkID uuid.UUID `gorm:"type:uuid;index" json:"benchmark_id"`
@@ -0,0 +1,20 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TransactionNonce records a nonce a sender has used in a benchmark and the
+// transaction that used it. The primary key lets each nonce be used once per
+// sender and benchmark, which the partitioned transactions table cannot
+// enforce itself.
+type TransactionNonce struct {
+	BenchmarkID uuid.UUID `gorm:"type:uuid;primaryKey;uniqueIndex:idx_transaction_nonces_hash,priority:1" json:"benchmark_id"`
+	FromAddress string    `gorm:"type:varchar(42);primaryKey" json:"from_address"`
+	Nonce       int64     `gorm:"primaryKey;autoIncrement:false" json:"nonce"`
+	Hash        string    `gorm:"type:varchar(66);not null;uniqueIndex:idx_transaction_nonces_hash,priority:2" json:"hash"`
+
+	CreatedAt time.Time `gorm:"default:CURRENT_TIMESTAMP" json:"created_at"`
+}
@@ -0,0 +1,96 @@
+package report
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"strconv"
+	"time"
+)
+
+// renderCSV writes one CSV file per report section into a zip archive.
+func renderCSV(r *Report) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+
+	files := []struct {
+		name    string
+		records [][]string
+	}{
+		{"configuration.csv", rowRecords([]string{"parameter", "value", "unit"}, r.Configuration())},
+		{"summary.csv", rowRecords([]string{"metric", "value", "unit"}, r.Summary())},
+		{"latency.csv", rowRecords([]string{"percentile", "value", "unit"}, r.Latency())},
+		{"throughput.csv", throughputRecords(r)},
+		{"node_metrics.csv", nodeMetricRecords(r)},
+		{"nodes.csv", nodeRecords(r)},
+		{"anomalies.csv", anomalyRecords(r)},
+	}
+	for _, f := range files {
+		w, err := zw.CreateHeader(&zip.FileHeader{Name: f.name, Method: zip.Deflate, Modified: r.GeneratedAt})
+		if err != nil {
+			return nil, err
+		}
+		cw := csv.NewWriter(w)
+		if err := cw.WriteAll(f.records); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func rowRecords(header []string, rows []Row) [][]string {
+	records := [][]string{header}
+	for _, row := range rows {
+		records = append(records, []string{row.Name, row.Value, row.Unit})
+	}
+	return records
+}
+
+func throughputRecords(r *Report) [][]string {
+	records := [][]string{{"time", "tps"}}
+	for _, p := range r.Throughput {
+		records = append(records, []string{p.Time.UTC().Format(time.RFC3339), formatValue(p.Value)})
+	}
+	return records
+}
+
+func nodeMetricRecords(r *Report) [][]string {
+	records := [][]string{{"node_id", "metric", "unit", "time", "value"}}
+	for _, s := range r.NodeMetrics {
+		for _, p := range s.Points {
+			records = append(records, []string{s.NodeID, s.Metric, s.Unit, p.Time.UTC().Format(time.RFC3339), formatValue(p.Value)})
+		}
+	}
+	return records
+}
+
+func nodeRecords(r *Report) [][]string {
+	records := [][]string{{"node_id", "name", "region", "role", "final_status", "faulty", "joined_at", "left_at"}}
+	for i := range r.Nodes {
+		n := &r.Nodes[i]
+		var name, region string
+		if n.Node != nil {
+			name, region = n.Node.Name, n.Node.Region
+		}
+		records = append(records, []string{
+			n.NodeID, name, region, n.Role, n.FinalStatus,
+			strconv.FormatBool(n.Faulty()), formatTime(n.JoinedAt), formatTime(n.LeftAt),
+		})
+	}
+	return records
+}
+
+func anomalyRecords(r *Report) [][]string {
+	records := [][]string{{"detected_at", "type", "severity", "confidence", "node_id", "transaction_hash", "status", "description"}}
+	for _, a := range r.Anomalies {
+		records = append(records, []string{
+			a.DetectedAt.UTC().Format(time.RFC3339), a.AnomalyType, a.Severity, formatFloat(a.ConfidenceScore),
+			a.NodeID, a.TransactionHash, a.Status, a.Description,
+		})
+	}
+	return records
+}
@@ -0,0 +1,131 @@
+package report
+
+import (
+	"bytes"
+	"html/template"
+	"time"
+)
+
+type htmlChart struct {
+	Title  string
+	SVG    template.HTML
+	Legend []chartLine
+}
+
+type htmlView struct {
+	*Report
+	GeneratedAtText string
+	Charts          []htmlChart
+}
+
+func renderHTML(r *Report) ([]byte, error) {
+	view := htmlView{
+		Report:          r,
+		GeneratedAtText: r.GeneratedAt.UTC().Format(time.RFC3339),
+	}
+
+	throughput := []chartLine{{Label: "TPS", Color: chartColors[0], Points: r.Throughput}}
+	view.Charts = append(view.Charts, htmlChart{
+		Title: "Throughput (confirmed tx/s)",
+		SVG:   lineChart("tx/s", throughput),
+	})
+
+	for _, metric := range r.metricNames() {
+		var lines []chartLine
+		unit := ""
+		for _, s := range r.NodeMetrics {
+			if s.Metric != metric {
+				continue
+			}
+			lines = append(lines, chartLine{
+				Label:  s.NodeID,
+				Color:  chartColors[len(lines)%len(chartColors)],
+				Points: s.Points,
+			})
+			if s.Unit != "" {
+				unit = s.Unit
+			}
+		}
+		view.Charts = append(view.Charts, htmlChart{
+			Title:  metric + " per node",
+			SVG:    lineChart(unit, lines),
+			Legend: lines,
+		})
+	}
+
+	var buf bytes.Buffer
+	if err := htmlTemplate.Execute(&buf, view); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+var htmlTemplate = template.Must(template.New("report").Funcs(template.FuncMap{
+	"rfc3339": func(t *time.Time) string { return formatTime(t) },
+	"value":   formatValue,
+	"float":   formatFloat,
+	"utc":     func(t time.Time) string { return t.UTC().Format(time.RFC3339) },
+}).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>Benchmark report: {{.Benchmark.Name}}</title>
+<style>
+body { font-family: -apple-system, "Segoe UI", Helvetica, Arial, sans-serif; margin: 2rem auto; max-width: 820px; color: #222; }
+h1 { font-size: 1.6rem; } h2 { font-size: 1.2rem; margin-top: 2rem; border-bottom: 1px solid #ddd; }
+table { border-collapse: collapse; width: 100%; font-size: 0.9rem; }
+th, td { text-align: left; padding: 4px 8px; border-bottom: 1px solid #eee; }
+td.num { text-align: right; font-variant-numeric: tabular-nums; }
+.meta, .empty { color: #666; }
+.chart { width: 100%; height: auto; }
+.chart .grid { stroke: #eee; } .chart .tick, .chart .axis { font-size: 11px; fill: #666; }
+.legend span { display: inline-block; margin-right: 1rem; font-size: 0.85rem; }
+.legend i { display: inline-block; width: 10px; height: 10px; margin-right: 4px; }
+tr.faulty td { color: #b00; }
+</style>
+</head>
+<body>
+<h1>Benchmark report: {{.Benchmark.Name}}</h1>
+<p class="meta">Benchmark {{.Benchmark.ID}} &middot; generated {{.GeneratedAtText}}</p>
+
+<h2>Configuration</h2>
+<table>
+<tr><th>Parameter</th><th>Value</th><th>Unit</th></tr>
+{{range .Configuration}}<tr><td>{{.Name}}</td><td>{{.Value}}</td><td>{{.Unit}}</td></tr>
+{{end}}</table>
+
+<h2>Summary</h2>
+<table>
+<tr><th>Metric</th><th>Value</th><th>Unit</th></tr>
+{{range .Summary}}<tr><td>{{.Name}}</td><td class="num">{{.Value}}</td><td>{{.Unit}}</td></tr>
+{{end}}</table>
+
+<h2>Latency percentiles</h2>
+<table>
+<tr><th>Percentile</th><th>Value</th><th>Unit</th></tr>
+{{range .Latency}}<tr><td>{{.Name}}</td><td class="num">{{.Value}}</td><td>{{.Unit}}</td></tr>
+{{end}}</table>
+
+<h2>Time series</h2>
+<p class="meta">Averaged over {{float .BucketSeconds}}s buckets.</p>
+{{range .Charts}}<h3>{{.Title}}</h3>
+{{.SVG}}
+{{if .Legend}}<p class="legend">{{range .Legend}}<span><i style="background: {{.Color}}"></i>{{.Label}}</span>{{end}}</p>{{end}}
+{{end}}
+
+<h2>Nodes</h2>
+{{if .Nodes}}<table>
+<tr><th>Node</th><th>Role</th><th>Final status</th><th>Joined</th><th>Left</th></tr>
+{{range .Nodes}}<tr{{if .Faulty}} class="faulty"{{end}}><td>{{.NodeID}}</td><td>{{.Role}}</td><td>{{.FinalStatus}}</td><td>{{rfc3339 .JoinedAt}}</td><td>{{rfc3339 .LeftAt}}</td></tr>
+{{end}}</table>
+{{else}}<p class="empty">No node membership recorded.</p>{{end}}
+
+<h2>Anomalies</h2>
+{{if .Anomalies}}<table>
+<tr><th>Detected</th><th>Type</th><th>Severity</th><th>Confidence</th><th>Node</th><th>Description</th></tr>
+{{range .Anomalies}}<tr><td>{{utc .DetectedAt}}</td><td>{{.AnomalyType}}</td><td>{{.Severity}}</td><td class="num">{{float .ConfidenceScore}}</td><td>{{.NodeID}}</td><td>{{.Description}}</td></tr>
+{{end}}</table>
+{{else}}<p class="empty">None detected.</p>{{end}}
+</body>
+</html>
+`))
@@ -0,0 +1,88 @@
+package report
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type jsonReport struct {
+	GeneratedAt   time.Time     `json:"generated_at"`
+	BenchmarkID   string        `json:"benchmark_id"`
+	Name          string        `json:"name"`
+	Configuration []Row         `json:"configuration"`
+	Summary       []Row         `json:"summary"`
+	Latency       []Row         `json:"latency"`
+	BucketSeconds float64       `json:"bucket_seconds"`
+	Throughput    []Point       `json:"throughput"`
+	NodeMetrics   []Series      `json:"node_metrics"`
+	Nodes         []jsonNode    `json:"nodes"`
+	Anomalies     []jsonAnomaly `json:"anomalies"`
+}
+
+type jsonNode struct {
+	NodeID      string     `json:"node_id"`
+	Role        string     `json:"role"`
+	FinalStatus string     `json:"final_status"`
+	Faulty      bool       `json:"faulty"`
+	JoinedAt    *time.Time `json:"joined_at,omitempty"`
+	LeftAt      *time.Time `json:"left_at,omitempty"`
+}
+
+type jsonAnomaly struct {
+	DetectedAt      time.Time `json:"detected_at"`
+	Type            string    `json:"type"`
+	Severity        string    `json:"severity"`
+	Confidence      float64   `json:"confidence"`
+	NodeID          string    `json:"node_id,omitempty"`
+	TransactionHash string    `json:"transaction_hash,omitempty"`
+	Status          string    `json:"status"`
+	Description     string    `json:"description,omitempty"`
+}
+
+func renderJSON(r *Report) ([]byte, error) {
+	doc := jsonReport{
+		GeneratedAt:   r.GeneratedAt.UTC(),
+		BenchmarkID:   r.Benchmark.ID.String(),
+		Name:          r.Benchmark.Name,
+		Configuration: r.Configuration(),
+		Summary:       r.Summary(),
+		Latency:       r.Latency(),
+		BucketSeconds: r.BucketSeconds,
+		Throughput:    nonNil(r.Throughput),
+		NodeMetrics:   nonNil(r.NodeMetrics),
+		Nodes:         []jsonNode{},
+		Anomalies:     []jsonAnomaly{},
+	}
+	for i := range r.Nodes {
+		n := &r.Nodes[i]
+		doc.Nodes = append(doc.Nodes, jsonNode{
+			NodeID:      n.NodeID,
+			Role:        n.Role,
+			FinalStatus: n.FinalStatus,
+			Faulty:      n.Faulty(),
+			JoinedAt:    n.JoinedAt,
+			LeftAt:      n.LeftAt,
+		})
+	}
+	for _, a := range r.Anomalies {
+		doc.Anomalies = append(doc.Anomalies, jsonAnomaly{
+			DetectedAt:      a.DetectedAt,
+			Type:            a.AnomalyType,
+			Severity:        a.Severity,
+			Confidence:      a.ConfidenceScore,
+			NodeID:          a.NodeID,
+			TransactionHash: a.TransactionHash,
+			Status:          a.Status,
+			Description:     a.Description,
+		})
+	}
+	return json.MarshalIndent(doc, "", "  ")
+}
+
+// nonNil keeps empty sections as [] rather than null in the output.
+func nonNil[T any](s []T) []T {
+	if s == nil {
+		return []T{}
+	}
+	return s
+}
@@ -0,0 +1,121 @@
+package report
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+func renderMarkdown(r *Report) ([]byte, error) {
+	var buf bytes.Buffer
+	b := r.Benchmark
+
+	fmt.Fprintf(&buf, "# Benchmark report: %s\n\n", mdEscape(b.Name))
+	fmt.Fprintf(&buf, "Generated %s for benchmark `%s`.\n\n", r.GeneratedAt.UTC().Format(time.RFC3339), b.ID)
+
+	buf.WriteString("## Configuration\n\n")
+	writeMarkdownRows(&buf, "Parameter", r.Configuration())
+
+	buf.WriteString("## Summary\n\n")
+	writeMarkdownRows(&buf, "Metric", r.Summary())
+
+	buf.WriteString("## Latency percentiles\n\n")
+	writeMarkdownRows(&buf, "Percentile", r.Latency())
+
+	fmt.Fprintf(&buf, "## Throughput over time\n\nConfirmed transactions per second, in %ss buckets.\n\n", formatFloat(r.BucketSeconds))
+	if len(r.Throughput) == 0 {
+		buf.WriteString("No confirmed transactions.\n\n")
+	} else {
+		var rows [][]string
+		start := r.Throughput[0].Time
+		for _, p := range r.Throughput {
+			rows = append(rows, []string{formatElapsed(p.Time.Sub(start)), formatValue(p.Value)})
+		}
+		writeMarkdownTable(&buf, []string{"Elapsed", "TPS"}, rows)
+	}
+
+	buf.WriteString("## Node resources\n\n")
+	if len(r.NodeMetrics) == 0 {
+		buf.WriteString("No metrics reported.\n\n")
+	} else {
+		var rows [][]string
+		for _, s := range r.NodeMetrics {
+			rows = append(rows, []string{s.NodeID, s.Metric, s.Unit, strconv.Itoa(len(s.Points)), formatValue(s.Mean()), formatValue(s.Max())})
+		}
+		writeMarkdownTable(&buf, []string{"Node", "Metric", "Unit", "Samples", "Mean", "Max"}, rows)
+	}
+
+	buf.WriteString("## Nodes\n\n")
+	if len(r.Nodes) == 0 {
+		buf.WriteString("No node membership recorded.\n\n")
+	} else {
+		var rows [][]string
+		for i := range r.Nodes {
+			n := &r.Nodes[i]
+			faulty := ""
+			if n.Faulty() {
+				faulty = "yes"
+			}
+			rows = append(rows, []string{n.NodeID, n.Role, n.FinalStatus, faulty, formatTime(n.JoinedAt), formatTime(n.LeftAt)})
+		}
+		writeMarkdownTable(&buf, []string{"Node", "Role", "Final status", "Faulty", "Joined", "Left"}, rows)
+	}
+
+	buf.WriteString("## Anomalies\n\n")
+	if len(r.Anomalies) == 0 {
+		buf.WriteString("None detected.\n")
+	} else {
+		var rows [][]string
+		for _, a := range r.Anomalies {
+			rows = append(rows, []string{
+				a.DetectedAt.UTC().Format(time.RFC3339), a.AnomalyType, a.Severity,
+				formatFloat(a.ConfidenceScore), a.NodeID, a.Description,
+			})
+		}
+		writeMarkdownTable(&buf, []string{"Detected", "Type", "Severity", "Confidence", "Node", "Description"}, rows)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func writeMarkdownRows(buf *bytes.Buffer, nameHeader string, rows []Row) {
+	cells := make([][]string, len(rows))
+	for i, row := range rows {
+		cells[i] = []string{row.Name, row.Value, row.Unit}
+	}
+	writeMarkdownTable(buf, []string{nameHeader, "Value", "Unit"}, cells)
+}
+
+func writeMarkdownTable(buf *bytes.Buffer, header []string, rows [][]string) {
+	writeMarkdownLine(buf, header)
+	sep := make([]string, len(header))
+	for i := range sep {
+		sep[i] = "---"
+	}
+	writeMarkdownLine(buf, sep)
+	for _, row := range rows {
+		writeMarkdownLine(buf, row)
+	}
+	buf.WriteString("\n")
+}
+
+func writeMarkdownLine(buf *bytes.Buffer, cells []string) {
+	buf.WriteString("|")
+	for _, c := range cells {
+		buf.WriteString(" " + mdEscape(c) + " |")
+	}
+	buf.WriteString("\n")
+}
+
+// mdEscape keeps a value inside its table cell.
+func mdEscape(s string) string {
+	return strings.NewReplacer("|", `\|`, "\r\n", " ", "\n", " ").Replace(s)
+}
+
+// formatElapsed renders an offset from the start of a series as m:ss.
+func formatElapsed(d time.Duration) string {
+	s := int(d.Round(time.Second).Seconds())
+	return fmt.Sprintf("%d:%02d", s/60, s%60)
+}
@@ -0,0 +1,288 @@
+// Package report renders a finished benchmark run as a self-contained
+// document: configuration, result summary, latency percentiles, throughput and
+// per-node resource series, participating nodes and detected anomalies.
+package report
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fffeng99999/hcp-server/internal/models"
+)
+
+// Output formats.
+const (
+	FormatCSV      = "csv" // zip archive with one CSV file per section
+	FormatJSON     = "json"
+	FormatMarkdown = "markdown"
+	FormatHTML     = "html" // single file with inline SVG charts
+)
+
+// Formats lists the supported output formats.
+var Formats = []string{FormatCSV, FormatJSON, FormatMarkdown, FormatHTML}
+
+var ErrUnknownFormat = errors.New("unknown report format")
+
+// Report is everything known about one benchmark run, ready to be rendered.
+type Report struct {
+	GeneratedAt time.Time
+	Benchmark   *models.Benchmark
+	Nodes       []models.BenchmarkNode
+	// BucketSeconds is the width of the buckets the series are averaged over.
+	BucketSeconds float64
+	// Throughput is confirmed transactions per second over time.
+	Throughput  []Point
+	NodeMetrics []Series
+	Anomalies   []models.Anomaly
+}
+
+type Point struct {
+	Time  time.Time `json:"time"`
+	Value float64   `json:"value"`
+}
+
+// Series is one metric reported by one node over time.
+type Series struct {
+	NodeID string  `json:"node_id"`
+	Metric string  `json:"metric"`
+	Unit   string  `json:"unit,omitempty"`
+	Points []Point `json:"points"`
+}
+
+// Mean and Max summarise the series' points; both are zero for no points.
+func (s Series) Mean() float64 {
+	if len(s.Points) == 0 {
+		return 0
+	}
+	sum := 0.0
+	for _, p := range s.Points {
+		sum += p.Value
+	}
+	return sum / float64(len(s.Points))
+}
+
+func (s Series) Max() float64 {
+	max := 0.0
+	for i, p := range s.Points {
+		if i == 0 || p.Value > max {
+			max = p.Value
+		}
+	}
+	return max
+}
+
+// Row is one line of a name/value table.
+type Row struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+	Unit  string `json:"unit,omitempty"`
+}
+
+// Output is a rendered report.
+type Output struct {
+	Data        []byte
+	ContentType string
+	Filename    string
+}
+
+// Render renders the report in the given format.
+func Render(r *Report, format string) (*Output, error) {
+	var (
+		data []byte
+		err  error
+		out  = &Output{}
+	)
+	switch format {
+	case FormatCSV:
+		data, err = renderCSV(r)
+		out.ContentType, out.Filename = "application/zip", r.basename()+".zip"
+	case FormatJSON:
+		data, err = renderJSON(r)
+		out.ContentType, out.Filename = "application/json", r.basename()+".json"
+	case FormatMarkdown:
+		data, err = renderMarkdown(r)
+		out.ContentType, out.Filename = "text/markdown; charset=utf-8", r.basename()+".md"
+	case FormatHTML:
+		data, err = renderHTML(r)
+		out.ContentType, out.Filename = "text/html; charset=utf-8", r.basename()+".html"
+	default:
+		return nil, fmt.Errorf("%w %q, want one of %s", ErrUnknownFormat, format, strings.Join(Formats, ", "))
+	}
+	if err != nil {
+		return nil, err
+	}
+	out.Data = data
+	return out, nil
+}
+
+func (r *Report) basename() string {
+	return "benchmark-" + r.Benchmark.ID.String() + "-report"
+}
+
+// Configuration lists the settings and environment the run was started with.
+// Unset values are left out.
+func (r *Report) Configuration() []Row {
+	b := r.Benchmark
+	var rows []Row
+	add := func(name, value, unit string) {
+		if value != "" && value != "0" {
+			rows = append(rows, Row{Name: name, Value: value, Unit: unit})
+		}
+	}
+
+	add("id", b.ID.String(), "")
+	add("name", b.Name, "")
+	add("description", b.Description, "")
+	add("algorithm", b.Algorithm, "")
+	add("node_count", strconv.Itoa(b.NodeCount), "")
+	add("duration", strconv.Itoa(b.Duration), "s")
+	add("warmup_duration", strconv.Itoa(b.WarmupDuration), "s")
+	add("cooldown_duration", strconv.Itoa(b.CooldownDuration), "s")
+	add("target_tps", strconv.Itoa(b.TargetTPS), "tx/s")
+	add("status", b.Status, "")
+	add("error_message", b.ErrorMessage, "")
+	add("run_group", b.RunGroup, "")
+	add("tags", strings.Join(b.Tags, ", "), "")
+	add("started_at", formatTime(b.StartedAt), "")
+	add("measurement_started_at", formatTime(b.MeasurementStartedAt), "")
+	add("measurement_ended_at", formatTime(b.MeasurementEndedAt), "")
+	add("completed_at", formatTime(b.CompletedAt), "")
+
+	c := b.ConsensusConfig
+	add("consensus.block_size", strconv.Itoa(c.BlockSize), "tx")
+	add("consensus.batch_size", strconv.Itoa(c.BatchSize), "tx")
+	add("consensus.block_interval_ms", strconv.Itoa(c.BlockIntervalMs), "ms")
+	add("consensus.request_timeout_ms", strconv.Itoa(c.RequestTimeoutMs), "ms")
+	add("consensus.view_change_timeout_ms", strconv.Itoa(c.ViewChangeTimeoutMs), "ms")
+	add("consensus.checkpoint_interval", strconv.Itoa(c.CheckpointInterval), "")
+	add("consensus.network.latency_ms", formatFloat(c.Network.LatencyMs), "ms")
+	add("consensus.network.jitter_ms", formatFloat(c.Network.JitterMs), "ms")
+	add("consensus.network.packet_loss_percent", formatFloat(c.Network.PacketLossPercent), "%")
+	add("consensus.network.bandwidth_mbps", formatFloat(c.Network.BandwidthMbps), "Mbps")
+	for _, k := range sortedKeys(b.ConsensusParams) {
+		add("params."+k, b.ConsensusParams[k], "")
+	}
+
+	e := b.Environment
+	add("environment.git_commit", e.GitCommit, "")
+	add("environment.version", e.Version, "")
+	add("environment.os", e.OS, "")
+	add("environment.kernel", e.Kernel, "")
+	add("environment.cpu_model", e.CPUModel, "")
+	add("environment.cpu_cores", strconv.Itoa(e.CPUCores), "")
+	add("environment.memory_gb", formatFloat(e.MemoryGB), "GB")
+	add("environment.instance_type", e.InstanceType, "")
+	for _, k := range sortedKeys(e.Extra) {
+		add("environment.extra."+k, e.Extra[k], "")
+	}
+	return rows
+}
+
+// Summary lists the run's result summary.
+func (r *Report) Summary() []Row {
+	b := r.Benchmark
+	successRate := 0.0
+	if b.TransactionCount > 0 {
+		successRate = float64(b.SuccessfulTx) * 100 / float64(b.TransactionCount)
+	}
+	faulty := 0
+	for i := range r.Nodes {
+		if r.Nodes[i].Faulty() {
+			faulty++
+		}
+	}
+
+	rows := []Row{
+		{"actual_tps", formatFloat(b.ActualTPS), "tx/s"},
+		{"transactions", strconv.Itoa(b.TransactionCount), ""},
+		{"successful_tx", strconv.Itoa(b.SuccessfulTx), ""},
+		{"failed_tx", strconv.Itoa(b.FailedTx), ""},
+		{"success_rate", formatValue(successRate), "%"},
+		{"block_count", strconv.Itoa(b.BlockCount), ""},
+		{"block_size_avg", formatFloat(b.BlockSizeAvg), "tx"},
+		{"block_propagation_time", formatFloat(b.BlockPropagationTime), "ms"},
+		{"cpu_usage_avg", formatFloat(b.CPUUsageAvg), "%"},
+		{"cpu_usage_max", formatFloat(b.CPUUsageMax), "%"},
+		{"memory_usage_avg", formatFloat(b.MemoryUsageAvg), "MB"},
+		{"memory_usage_max", formatFloat(b.MemoryUsageMax), "MB"},
+		{"network_in_mbps", formatFloat(b.NetworkInMbps), "Mbps"},
+		{"network_out_mbps", formatFloat(b.NetworkOutMbps), "Mbps"},
+		{"disk_io_read", formatFloat(b.DiskIORead), "MB/s"},
+		{"disk_io_write", formatFloat(b.DiskIOWrite), "MB/s"},
+		{"prepare_phase_latency", formatFloat(b.PreparePhaseLatency), "ms"},
+		{"commit_phase_latency", formatFloat(b.CommitPhaseLatency), "ms"},
+		{"view_change_count", strconv.Itoa(b.ViewChangeCount), ""},
+		{"faulty_nodes", strconv.Itoa(faulty), ""},
+		{"anomalies", strconv.Itoa(len(r.Anomalies)), ""},
+	}
+	if b.SLAStatus != "" {
+		passed := 0
+		for _, a := range b.AssertionResults {
+			if a.Passed {
+				passed++
+			}
+		}
+		rows = append(rows,
+			Row{"sla_status", b.SLAStatus, ""},
+			Row{"assertions_passed", fmt.Sprintf("%d/%d", passed, len(b.AssertionResults)), ""},
+		)
+	}
+	return rows
+}
+
+// Latency lists the run's latency percentiles.
+func (r *Report) Latency() []Row {
+	b := r.Benchmark
+	return []Row{
+		{"p50", formatFloat(b.LatencyP50), "ms"},
+		{"p90", formatFloat(b.LatencyP90), "ms"},
+		{"p99", formatFloat(b.LatencyP99), "ms"},
+		{"p99.9", formatFloat(b.LatencyP999), "ms"},
+		{"avg", formatFloat(b.LatencyAvg), "ms"},
+		{"min", formatFloat(b.LatencyMin), "ms"},
+		{"max", formatFloat(b.LatencyMax), "ms"},
+	}
+}
+
+// metricNames lists the metrics present in the node series, sorted.
+func (r *Report) metricNames() []string {
+	seen := map[string]bool{}
+	var names []string
+	for _, s := range r.NodeMetrics {
+		if !seen[s.Metric] {
+			seen[s.Metric] = true
+			names = append(names, s.Metric)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+// formatValue rounds a measured value for display.
+func formatValue(v float64) string {
+	return strconv.FormatFloat(v, 'f', 2, 64)
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
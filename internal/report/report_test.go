@@ -0,0 +1,119 @@
+package report
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fffeng99999/hcp-server/internal/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testReport() *Report {
+	start := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	completed := start.Add(2 * time.Minute)
+	joined := start
+	return &Report{
+		GeneratedAt: completed,
+		Benchmark: &models.Benchmark{
+			ID:               uuid.MustParse("6f1c7a52-0d7e-4a3c-9d55-3f0f1b1e2a10"),
+			Name:             "tpbft | 4 nodes",
+			Algorithm:        "tPBFT",
+			NodeCount:        4,
+			Duration:         120,
+			TargetTPS:        1000,
+			Status:           models.BenchmarkStatusCompleted,
+			StartedAt:        &start,
+			CompletedAt:      &completed,
+			ActualTPS:        950.5,
+			LatencyP99:       120,
+			TransactionCount: 1000,
+			SuccessfulTx:     990,
+			FailedTx:         10,
+			ConsensusParams:  map[string]string{"view_timeout": "2s"},
+		},
+		Nodes: []models.BenchmarkNode{
+			{NodeID: "node-1", Role: models.BenchmarkNodeRoleLeader, FinalStatus: models.BenchmarkNodeStatusHealthy, JoinedAt: &joined},
+			{NodeID: "node-2", Role: models.BenchmarkNodeRoleValidator, FinalStatus: models.BenchmarkNodeStatusCrashed},
+		},
+		BucketSeconds: 10,
+		Throughput: []Point{
+			{Time: start, Value: 900},
+			{Time: start.Add(10 * time.Second), Value: 1000},
+		},
+		NodeMetrics: []Series{
+			{NodeID: "node-1", Metric: "cpu_usage", Unit: "%", Points: []Point{{start, 40}, {start.Add(10 * time.Second), 60}}},
+			{NodeID: "node-2", Metric: "cpu_usage", Unit: "%", Points: []Point{{start, 30}}},
+		},
+		Anomalies: []models.Anomaly{
+			{AnomalyType: "latency_spike", Severity: "high", ConfidenceScore: 0.9, NodeID: "node-2", DetectedAt: start.Add(time.Minute), Description: "p99 <3x> baseline"},
+		},
+	}
+}
+
+func TestRenderCSV(t *testing.T) {
+	out, err := Render(testReport(), FormatCSV)
+	require.NoError(t, err)
+	assert.Equal(t, "benchmark-6f1c7a52-0d7e-4a3c-9d55-3f0f1b1e2a10-report.zip", out.Filename)
+
+	zr, err := zip.NewReader(bytes.NewReader(out.Data), int64(len(out.Data)))
+	require.NoError(t, err)
+	var names []string
+	for _, f := range zr.File {
+		names = append(names, f.Name)
+	}
+	assert.Equal(t, []string{
+		"configuration.csv", "summary.csv", "latency.csv", "throughput.csv",
+		"node_metrics.csv", "nodes.csv", "anomalies.csv",
+	}, names)
+}
+
+func TestRenderJSON(t *testing.T) {
+	r := testReport()
+	r.Anomalies = nil
+	out, err := Render(r, FormatJSON)
+	require.NoError(t, err)
+
+	var decoded map[string]any
+	require.NoError(t, json.Unmarshal(out.Data, &decoded))
+	assert.Len(t, decoded["throughput"], 2)
+	assert.Equal(t, []any{}, decoded["anomalies"])
+}
+
+func TestRenderMarkdown(t *testing.T) {
+	out, err := Render(testReport(), FormatMarkdown)
+	require.NoError(t, err)
+	md := string(out.Data)
+
+	assert.Contains(t, md, `tpbft \| 4 nodes`)
+	assert.Contains(t, md, "| params.view_timeout | 2s |")
+	assert.Contains(t, md, "0:10")
+}
+
+func TestRenderHTML(t *testing.T) {
+	out, err := Render(testReport(), FormatHTML)
+	require.NoError(t, err)
+	html := string(out.Data)
+
+	// One throughput chart and one per metric.
+	assert.Equal(t, 2, strings.Count(html, "<svg"))
+	assert.Contains(t, html, "p99 &lt;3x&gt; baseline")
+	assert.NotContains(t, html, "<3x>")
+}
+
+func TestRenderUnknownFormat(t *testing.T) {
+	_, err := Render(testReport(), "pdf")
+	assert.ErrorIs(t, err, ErrUnknownFormat)
+}
+
+func TestNiceCeil(t *testing.T) {
+	assert.Equal(t, 1.0, niceCeil(0))
+	assert.Equal(t, 1000.0, niceCeil(950))
+	assert.Equal(t, 2.0, niceCeil(1.2))
+	assert.Equal(t, 50.0, niceCeil(50))
+}
@@ -0,0 +1,114 @@
+package report
+
+import (
+	"fmt"
+	"html/template"
+	"math"
+	"strings"
+)
+
+// Chart geometry, in SVG user units.
+const (
+	chartWidth  = 760
+	chartHeight = 280
+	chartLeft   = 64
+	chartRight  = 16
+	chartTop    = 16
+	chartBottom = 36
+	chartTicks  = 5
+)
+
+var chartColors = []string{
+	"#1f77b4", "#ff7f0e", "#2ca02c", "#d62728", "#9467bd",
+	"#8c564b", "#e377c2", "#7f7f7f", "#bcbd22", "#17becf",
+}
+
+type chartLine struct {
+	Label  string
+	Color  string
+	Points []Point
+}
+
+// lineChart draws the lines on shared axes: elapsed time since the earliest
+// point on x, and zero up to a rounded maximum on y.
+func lineChart(unit string, lines []chartLine) template.HTML {
+	var first, last float64
+	maxValue := 0.0
+	havePoints := false
+	for _, l := range lines {
+		for _, p := range l.Points {
+			t := float64(p.Time.UnixNano()) / 1e9
+			if !havePoints || t < first {
+				first = t
+			}
+			if !havePoints || t > last {
+				last = t
+			}
+			maxValue = math.Max(maxValue, p.Value)
+			havePoints = true
+		}
+	}
+	if !havePoints {
+		return template.HTML(`<p class="empty">No data.</p>`)
+	}
+
+	span := math.Max(last-first, 1)
+	top := niceCeil(maxValue)
+	plotW := float64(chartWidth - chartLeft - chartRight)
+	plotH := float64(chartHeight - chartTop - chartBottom)
+	x := func(t float64) float64 { return chartLeft + (t-first)/span*plotW }
+	y := func(v float64) float64 { return chartTop + plotH - v/top*plotH }
+
+	var b strings.Builder
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 %d %d" class="chart" role="img">`, chartWidth, chartHeight)
+
+	for i := 0; i <= chartTicks; i++ {
+		v := top * float64(i) / chartTicks
+		fmt.Fprintf(&b, `<line x1="%d" x2="%d" y1="%.1f" y2="%.1f" class="grid"/>`, chartLeft, chartWidth-chartRight, y(v), y(v))
+		fmt.Fprintf(&b, `<text x="%d" y="%.1f" class="tick" text-anchor="end">%s</text>`, chartLeft-6, y(v)+4, formatTick(v))
+
+		t := first + span*float64(i)/chartTicks
+		fmt.Fprintf(&b, `<text x="%.1f" y="%d" class="tick" text-anchor="middle">%s</text>`, x(t), chartHeight-chartBottom+16, formatSeconds(t-first))
+	}
+	fmt.Fprintf(&b, `<text x="%d" y="%d" class="axis" text-anchor="end">elapsed</text>`, chartWidth-chartRight, chartHeight-4)
+	if unit != "" {
+		fmt.Fprintf(&b, `<text x="4" y="%d" class="axis">%s</text>`, chartTop-4, template.HTMLEscapeString(unit))
+	}
+
+	for _, l := range lines {
+		coords := make([]string, len(l.Points))
+		for i, p := range l.Points {
+			coords[i] = fmt.Sprintf("%.1f,%.1f", x(float64(p.Time.UnixNano())/1e9), y(p.Value))
+		}
+		fmt.Fprintf(&b, `<polyline fill="none" stroke="%s" stroke-width="1.5" points="%s"><title>%s</title></polyline>`,
+			l.Color, strings.Join(coords, " "), template.HTMLEscapeString(l.Label))
+	}
+	b.WriteString(`</svg>`)
+	return template.HTML(b.String())
+}
+
+// niceCeil rounds v up to 1, 2 or 5 times a power of ten.
+func niceCeil(v float64) float64 {
+	if v <= 0 {
+		return 1
+	}
+	exp := math.Pow(10, math.Floor(math.Log10(v)))
+	for _, m := range []float64{1, 2, 5, 10} {
+		if v <= m*exp {
+			return m * exp
+		}
+	}
+	return 10 * exp
+}
+
+func formatTick(v float64) string {
+	if v >= 1000 {
+		return fmt.Sprintf("%.0f", v)
+	}
+	return strings.TrimSuffix(strings.TrimRight(fmt.Sprintf("%.2f", v), "0"), ".")
+}
+
+func formatSeconds(s float64) string {
+	total := int(math.Round(s))
+	return fmt.Sprintf("%d:%02d", total/60, total%60)
+}
@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/fffeng99999/hcp-server/internal/models"
+	"gorm.io/gorm"
+)
+
+type anomalyRepository struct {
+	db *gorm.DB
+}
+
+func NewAnomalyRepository(db *gorm.DB) AnomalyRepository {
+	return &anomalyRepository{db: db}
+}
+
+func (r *anomalyRepository) ListByBenchmark(ctx context.Context, benchmarkID string) ([]models.Anomaly, error) {
+	var anomalies []models.Anomaly
+	err := r.db.WithContext(ctx).
+		Where("benchmark_id = ?", benchmarkID).
+		Order("detected_at ASC").
+		Find(&anomalies).Error
+	if err != nil {
+		return nil, err
+	}
+	return anomalies, nil
+}
@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/fffeng99999/hcp-server/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type benchmarkNodeRepository struct {
+	db *gorm.DB
+}
+
+func NewBenchmarkNodeRepository(db *gorm.DB) BenchmarkNodeRepository {
+	return &benchmarkNodeRepository{db: db}
+}
+
+func (r *benchmarkNodeRepository) Upsert(ctx context.Context, memberships []models.BenchmarkNode) error {
+	if len(memberships) == 0 {
+		return nil
+	}
+	// Join and leave are usually reported separately, so a report never
+	// clears the times an earlier one recorded.
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "benchmark_id"}, {Name: "node_id"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"role":         gorm.Expr("EXCLUDED.role"),
+			"joined_at":    gorm.Expr("COALESCE(EXCLUDED.joined_at, benchmark_nodes.joined_at)"),
+			"left_at":      gorm.Expr("COALESCE(EXCLUDED.left_at, benchmark_nodes.left_at)"),
+			"final_status": gorm.Expr("EXCLUDED.final_status"),
+		}),
+	}).Omit("Node").Create(&memberships).Error
+}
+
+func (r *benchmarkNodeRepository) ListByBenchmark(ctx context.Context, benchmarkID string, filter BenchmarkNodeFilter) ([]models.BenchmarkNode, error) {
+	var memberships []models.BenchmarkNode
+
+	query := r.db.WithContext(ctx).Preload("Node").Where("benchmark_id = ?", benchmarkID)
+	if filter.Role != "" {
+		query = query.Where("role = ?", filter.Role)
+	}
+	if filter.FaultyOnly {
+		query = query.Where("final_status IN ?", models.FaultyBenchmarkNodeStatuses)
+	}
+
+	if err := query.Order("role ASC, node_id ASC").Find(&memberships).Error; err != nil {
+		return nil, err
+	}
+	return memberships, nil
+}
@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/fffeng99999/hcp-server/internal/models"
+	"gorm.io/gorm"
+)
+
+// queueLockSpace is the first key of the advisory locks taken on clusters
+// while dispatching; the second is derived from the cluster name.
+const queueLockSpace = 0x48435051 // "HCPQ"
+
+// queueOrder is the dispatch order within a cluster's queue. The ID breaks
+// ties so positions are stable.
+const queueOrder = "priority DESC, created_at ASC, id ASC"
+
+type benchmarkQueueRepository struct {
+	db *gorm.DB
+}
+
+func NewBenchmarkQueueRepository(db *gorm.DB) BenchmarkQueueRepository {
+	return &benchmarkQueueRepository{db: db}
+}
+
+func (r *benchmarkQueueRepository) ClusterCounts(ctx context.Context) ([]ClusterCount, error) {
+	var counts []ClusterCount
+	err := r.db.WithContext(ctx).Model(&models.Benchmark{}).
+		Select("cluster, COUNT(*) FILTER (WHERE status = ?) AS queued, COUNT(*) FILTER (WHERE status IN ?) AS active",
+			models.BenchmarkStatusQueued, models.ActiveBenchmarkStatuses).
+		Where("status = ? OR status IN ?", models.BenchmarkStatusQueued, models.ActiveBenchmarkStatuses).
+		Group("cluster").
+		Order("cluster").
+		Scan(&counts).Error
+	if err != nil {
+		return nil, err
+	}
+	return counts, nil
+}
+
+func (r *benchmarkQueueRepository) CountActive(ctx context.Context, cluster string) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&models.Benchmark{}).
+		Where("cluster = ? AND status IN ?", cluster, models.ActiveBenchmarkStatuses).
+		Count(&count).Error
+	return count, err
+}
+
+func (r *benchmarkQueueRepository) ListQueued(ctx context.Context, cluster string) ([]models.Benchmark, error) {
+	query := r.db.WithContext(ctx).Where("status = ?", models.BenchmarkStatusQueued)
+	if cluster != "" {
+		query = query.Where("cluster = ?", cluster)
+	}
+	var benchmarks []models.Benchmark
+	if err := query.Order("cluster ASC, " + queueOrder).Find(&benchmarks).Error; err != nil {
+		return nil, err
+	}
+	return benchmarks, nil
+}
+
+func (r *benchmarkQueueRepository) Position(ctx context.Context, id string) (int64, error) {
+	var position int64
+	err := r.db.WithContext(ctx).Raw(`
+		SELECT COUNT(*) FROM benchmarks q, benchmarks b
+		WHERE b.id = ? AND b.status = ? AND q.status = ? AND q.cluster = b.cluster
+		  AND (q.priority > b.priority OR (q.priority = b.priority AND (q.created_at, q.id) <= (b.created_at, b.id)))`,
+		id, models.BenchmarkStatusQueued, models.BenchmarkStatusQueued).
+		Scan(&position).Error
+	return position, err
+}
+
+func (r *benchmarkQueueRepository) NextDispatchable(ctx context.Context, cluster string) (*models.Benchmark, error) {
+	var benchmark models.Benchmark
+	err := r.db.WithContext(ctx).
+		Where("cluster = ? AND status = ?", cluster, models.BenchmarkStatusQueued).
+		Where(`(experiment_id IS NULL OR NOT EXISTS (
+			SELECT 1 FROM benchmarks a WHERE a.experiment_id = benchmarks.experiment_id AND a.status IN ?))`,
+			models.ActiveBenchmarkStatuses).
+		Order(queueOrder).
+		First(&benchmark).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &benchmark, nil
+}
+
+func (r *benchmarkQueueRepository) WithClusterLock(ctx context.Context, cluster string, fn func(ctx context.Context) error) (bool, error) {
+	locked := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// The lock lives as long as the transaction, which only holds it:
+		// fn works through its own connections and its writes are visible
+		// to the next instance as soon as they are made.
+		if err := tx.Raw("SELECT pg_try_advisory_xact_lock(?, hashtext(?))", queueLockSpace, cluster).Scan(&locked).Error; err != nil {
+			return err
+		}
+		if !locked {
+			return nil
+		}
+		return fn(ctx)
+	})
+	return locked, err
+}
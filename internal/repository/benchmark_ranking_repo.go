@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/fffeng99999/hcp-server/internal/models"
+	"gorm.io/gorm"
+)
+
+type benchmarkRankingRepository struct {
+	db *gorm.DB
+}
+
+func NewBenchmarkRankingRepository(db *gorm.DB) BenchmarkRankingRepository {
+	return &benchmarkRankingRepository{db: db}
+}
+
+// ranked selects the runs that count towards rankings. The algorithm and
+// status conditions go through idx_benchmarks_algorithm_status and the
+// throughput condition through idx_benchmarks_actual_tps.
+func (r *benchmarkRankingRepository) ranked(ctx context.Context, filter RankingFilter) *gorm.DB {
+	algorithms := filter.Algorithms
+	if len(algorithms) == 0 {
+		algorithms = models.SupportedAlgorithms
+	}
+	query := r.db.WithContext(ctx).Model(&models.Benchmark{}).
+		Where("algorithm IN ? AND status = ?", algorithms, models.BenchmarkStatusCompleted).
+		Where("actual_tps > 0")
+	if filter.NodeCount > 0 {
+		query = query.Where("node_count = ?", filter.NodeCount)
+	}
+	if filter.CreatedAfter != nil {
+		query = query.Where("created_at >= ?", *filter.CreatedAfter)
+	}
+	if filter.CreatedBefore != nil {
+		query = query.Where("created_at < ?", *filter.CreatedBefore)
+	}
+	return query
+}
+
+func (r *benchmarkRankingRepository) Leaderboard(ctx context.Context, filter RankingFilter) ([]LeaderboardRow, error) {
+	var rows []LeaderboardRow
+	err := r.ranked(ctx, filter).
+		Select(`
+			algorithm,
+			node_count,
+			COUNT(*) AS runs,
+			MAX(actual_tps) AS best_tps,
+			percentile_cont(0.5) WITHIN GROUP (ORDER BY actual_tps) AS median_tps,
+			COALESCE(MIN(latency_p99) FILTER (WHERE latency_p99 > 0), 0) AS best_latency_p99,
+			COALESCE(percentile_cont(0.5) WITHIN GROUP (ORDER BY latency_p99) FILTER (WHERE latency_p99 > 0), 0) AS median_latency_p99,
+			(array_agg(id::text ORDER BY actual_tps DESC, created_at ASC))[1] AS best_benchmark_id`).
+		Group("algorithm, node_count").
+		Order("node_count ASC, algorithm ASC").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+func (r *benchmarkRankingRepository) Trend(ctx context.Context, filter RankingFilter, metric, groupBy, interval string) ([]TrendRow, error) {
+	if !slices.Contains(TrendMetrics, metric) {
+		return nil, fmt.Errorf("%w: no trend for %q", ErrInvalidFilter, metric)
+	}
+
+	var label string
+	switch groupBy {
+	case TrendByTime:
+		if !slices.Contains(TrendIntervals, interval) {
+			return nil, fmt.Errorf("%w: unknown interval %q", ErrInvalidFilter, interval)
+		}
+		// interval is one of TrendIntervals, so it is safe to inline.
+		label = fmt.Sprintf("to_char(date_trunc('%s', created_at), 'YYYY-MM-DD\"T\"HH24:MI:SS\"Z\"')", interval)
+	case TrendByVersion:
+		// Runs without a version fall back to the commit they were built from.
+		label = "COALESCE(NULLIF(environment->>'version', ''), NULLIF(environment->>'git_commit', ''), 'unknown')"
+	default:
+		return nil, fmt.Errorf("%w: unknown grouping %q", ErrInvalidFilter, groupBy)
+	}
+
+	var rows []TrendRow
+	err := r.ranked(ctx, filter).
+		Select(fmt.Sprintf(`
+			%[1]s AS label,
+			MIN(created_at) AS first_run_at,
+			COUNT(*) AS runs,
+			AVG(%[2]s) AS mean,
+			percentile_cont(0.5) WITHIN GROUP (ORDER BY %[2]s) AS median,
+			MIN(%[2]s) AS min,
+			MAX(%[2]s) AS max`, label, metric)).
+		Group("label").
+		Order("first_run_at ASC").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
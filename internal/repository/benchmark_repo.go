@@ -2,8 +2,14 @@ package repository
 
 import (
 	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/fffeng99999/hcp-server/internal/models"
+	"github.com/lib/pq"
 	"gorm.io/gorm"
 )
 
@@ -27,27 +33,200 @@ func (r *benchmarkRepository) GetByID(ctx context.Context, id string) (*models.B
 	return &benchmark, nil
 }
 
-func (r *benchmarkRepository) List(ctx context.Context, page, pageSize int) ([]models.Benchmark, int64, error) {
+func (r *benchmarkRepository) List(ctx context.Context, filter BenchmarkFilter, page, pageSize int) ([]models.Benchmark, int64, error) {
 	var benchmarks []models.Benchmark
 	var total int64
 
-	offset := (page - 1) * pageSize
+	order, err := benchmarkOrder(filter)
+	if err != nil {
+		return nil, 0, err
+	}
+	query, err := filterBenchmarks(r.db.WithContext(ctx).Model(&models.Benchmark{}), filter)
+	if err != nil {
+		return nil, 0, err
+	}
 
-	if err := r.db.WithContext(ctx).Model(&models.Benchmark{}).Count(&total).Error; err != nil {
+	if err := query.Count(&total).Error; err != nil {
 		return nil, 0, err
 	}
 
-	if err := r.db.WithContext(ctx).Offset(offset).Limit(pageSize).Find(&benchmarks).Error; err != nil {
+	offset := (page - 1) * pageSize
+	if err := query.Order(order).Offset(offset).Limit(pageSize).Find(&benchmarks).Error; err != nil {
 		return nil, 0, err
 	}
 
 	return benchmarks, total, nil
 }
 
+// filterBenchmarks adds the conditions of filter to query; all of them must
+// match.
+func filterBenchmarks(query *gorm.DB, filter BenchmarkFilter) (*gorm.DB, error) {
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.Algorithm != "" {
+		query = query.Where("algorithm = ?", filter.Algorithm)
+	}
+	if filter.RunGroup != "" {
+		query = query.Where("run_group = ?", filter.RunGroup)
+	}
+	if filter.MinNodeCount > 0 {
+		query = query.Where("node_count >= ?", filter.MinNodeCount)
+	}
+	if filter.MaxNodeCount > 0 {
+		query = query.Where("node_count <= ?", filter.MaxNodeCount)
+	}
+	if filter.CreatedAfter != nil {
+		query = query.Where("created_at >= ?", *filter.CreatedAfter)
+	}
+	if filter.CreatedBefore != nil {
+		query = query.Where("created_at < ?", *filter.CreatedBefore)
+	}
+	if filter.SLAStatus != "" {
+		query = query.Where("sla_status = ?", filter.SLAStatus)
+	}
+	if filter.Experiment != "" {
+		query = query.Where("experiment_id IN (SELECT id FROM experiments WHERE name = ?)", filter.Experiment)
+	}
+	if len(filter.Tags) > 0 {
+		query = query.Where("tags @> ?", pq.StringArray(filter.Tags))
+	}
+	if filter.Search != "" {
+		query = query.Where(benchmarkSearchVector+" @@ websearch_to_tsquery('simple', ?)", filter.Search)
+	}
+	for path, value := range filter.Parameters {
+		column, jsonPath, err := parameterPath(path)
+		if err != nil {
+			return nil, err
+		}
+		query = query.Where(column+" #>> ?::text[] = ?", jsonPath, value)
+	}
+	return query, nil
+}
+
+// benchmarkSearchVector is the document searched by BenchmarkFilter.Search.
+// It matches the expression of the idx_benchmarks_search index.
+const benchmarkSearchVector = "to_tsvector('simple', coalesce(name, '') || ' ' || coalesce(description, ''))"
+
+// benchmarkOrder builds the ORDER BY clause for a filter. The id tie-breaker
+// keeps pages stable when many rows share the sort value.
+func benchmarkOrder(filter BenchmarkFilter) (string, error) {
+	column := filter.SortBy
+	if column == "" {
+		column = "created_at"
+	}
+	if !slices.Contains(BenchmarkSortColumns, column) {
+		return "", fmt.Errorf("%w: cannot sort by %q", ErrInvalidFilter, column)
+	}
+
+	direction := "DESC"
+	if filter.SortAscending {
+		direction = "ASC"
+	}
+	return fmt.Sprintf("%s %s, id %s", column, direction, direction), nil
+}
+
 func (r *benchmarkRepository) Update(ctx context.Context, benchmark *models.Benchmark) error {
 	return r.db.WithContext(ctx).Save(benchmark).Error
 }
 
+func (r *benchmarkRepository) UpdateFields(ctx context.Context, id string, expectedUpdatedAt *time.Time, updates map[string]interface{}) error {
+	query := r.db.WithContext(ctx).Model(&models.Benchmark{}).Where("id = ?", id)
+	if expectedUpdatedAt != nil {
+		query = query.Where("updated_at = ?", *expectedUpdatedAt)
+	}
+
+	result := query.Updates(updates)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		// Tell a stale write apart from a missing row.
+		if _, err := r.GetByID(ctx, id); err != nil {
+			return err
+		}
+		return ErrConflict
+	}
+	return nil
+}
+
 func (r *benchmarkRepository) Delete(ctx context.Context, id string) error {
-	return r.db.WithContext(ctx).Delete(&models.Benchmark{}, "id = ?", id).Error
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// anomalies.benchmark_id has no cascade; keep the anomaly history but
+		// drop the reference so the delete is not blocked.
+		if err := tx.Model(&models.Anomaly{}).Where("benchmark_id = ?", id).Update("benchmark_id", nil).Error; err != nil {
+			return err
+		}
+
+		result := tx.Delete(&models.Benchmark{}, "id = ?", id)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return nil
+	})
+}
+
+func (r *benchmarkRepository) UpdateStatus(ctx context.Context, id, from, to string, expectedUpdatedAt *time.Time, updates map[string]interface{}) error {
+	values := map[string]interface{}{"status": to}
+	for k, v := range updates {
+		values[k] = v
+	}
+
+	query := r.db.WithContext(ctx).Model(&models.Benchmark{}).Where("id = ? AND status = ?", id, from)
+	if expectedUpdatedAt != nil {
+		query = query.Where("updated_at = ?", *expectedUpdatedAt)
+	}
+	result := query.Updates(values)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrConflict
+	}
+	return nil
+}
+
+func (r *benchmarkRepository) ListByStatus(ctx context.Context, statuses ...string) ([]models.Benchmark, error) {
+	var benchmarks []models.Benchmark
+	if err := r.db.WithContext(ctx).Where("status IN ?", statuses).Order("created_at ASC").Find(&benchmarks).Error; err != nil {
+		return nil, err
+	}
+	return benchmarks, nil
+}
+
+func (r *benchmarkRepository) ListByRunGroup(ctx context.Context, runGroup string) ([]models.Benchmark, error) {
+	var benchmarks []models.Benchmark
+	if err := r.db.WithContext(ctx).Where("run_group = ?", runGroup).Order("created_at ASC").Find(&benchmarks).Error; err != nil {
+		return nil, err
+	}
+	return benchmarks, nil
+}
+
+// parameterColumns maps the roots of BenchmarkFilter.Parameters paths onto
+// the JSONB columns they address.
+var parameterColumns = map[string]string{
+	"consensus":   "consensus_config",
+	"environment": "environment",
+	"params":      "consensus_params",
+}
+
+var parameterKey = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// parameterPath splits a dotted parameter path into its column and a
+// Postgres text[] path literal for the #>> operator.
+func parameterPath(path string) (string, string, error) {
+	parts := strings.Split(path, ".")
+	column, ok := parameterColumns[parts[0]]
+	if !ok || len(parts) < 2 {
+		return "", "", fmt.Errorf("%w: unknown parameter %q", ErrInvalidFilter, path)
+	}
+	for _, p := range parts[1:] {
+		if !parameterKey.MatchString(p) {
+			return "", "", fmt.Errorf("%w: malformed parameter %q", ErrInvalidFilter, path)
+		}
+	}
+	return column, "{" + strings.Join(parts[1:], ",") + "}", nil
 }
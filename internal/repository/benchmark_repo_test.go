@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/fffeng99999/hcp-server/internal/models"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// dryRunDB builds statements without a database behind it.
+func dryRunDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(postgres.New(postgres.Config{DSN: "host=localhost"}), &gorm.Config{
+		DryRun:               true,
+		DisableAutomaticPing: true,
+	})
+	require.NoError(t, err)
+	return db
+}
+
+func TestBenchmarkOrder(t *testing.T) {
+	order, err := benchmarkOrder(BenchmarkFilter{})
+	require.NoError(t, err)
+	assert.Equal(t, "created_at DESC, id DESC", order)
+
+	order, err = benchmarkOrder(BenchmarkFilter{SortBy: "actual_tps", SortAscending: true})
+	require.NoError(t, err)
+	assert.Equal(t, "actual_tps ASC, id ASC", order)
+
+	for _, column := range []string{"name", "created_at; DROP TABLE benchmarks", "CREATED_AT"} {
+		_, err := benchmarkOrder(BenchmarkFilter{SortBy: column})
+		assert.ErrorIs(t, err, ErrInvalidFilter, column)
+	}
+}
+
+func TestFilterBenchmarks_ComposesConditions(t *testing.T) {
+	db := dryRunDB(t)
+	filter := BenchmarkFilter{
+		Status:       models.BenchmarkStatusCompleted,
+		MinNodeCount: 4,
+		Tags:         []string{"nightly"},
+		Search:       `"view change" -pbft`,
+		Parameters:   map[string]string{"consensus.block_size": "500"},
+	}
+
+	query, err := filterBenchmarks(db.Model(&models.Benchmark{}), filter)
+	require.NoError(t, err)
+	stmt := query.Find(&[]models.Benchmark{}).Statement
+
+	assert.Equal(t, `SELECT * FROM "benchmarks" WHERE status = $1 AND node_count >= $2 AND tags @> $3 AND `+
+		benchmarkSearchVector+` @@ websearch_to_tsquery('simple', $4) AND consensus_config #>> $5::text[] = $6`,
+		stmt.SQL.String())
+	assert.Equal(t, []interface{}{
+		models.BenchmarkStatusCompleted, 4, pq.StringArray{"nightly"}, `"view change" -pbft`, "{block_size}", "500",
+	}, stmt.Vars)
+}
+
+func TestFilterBenchmarks_EmptyFilterMatchesAll(t *testing.T) {
+	query, err := filterBenchmarks(dryRunDB(t).Model(&models.Benchmark{}), BenchmarkFilter{})
+	require.NoError(t, err)
+	stmt := query.Find(&[]models.Benchmark{}).Statement
+	assert.Equal(t, `SELECT * FROM "benchmarks"`, stmt.SQL.String())
+}
+
+func TestFilterBenchmarks_RejectsUnknownParameters(t *testing.T) {
+	for _, path := range []string{"consensus", "nodes.count", "params.batch size", "environment.a'b"} {
+		_, err := filterBenchmarks(dryRunDB(t).Model(&models.Benchmark{}), BenchmarkFilter{Parameters: map[string]string{path: "1"}})
+		assert.ErrorIs(t, err, ErrInvalidFilter, path)
+	}
+}
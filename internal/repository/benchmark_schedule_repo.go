@@ -0,0 +1,127 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/fffeng99999/hcp-server/internal/models"
+	"gorm.io/gorm"
+)
+
+// scheduleLockSpace is the first key of the advisory locks taken on schedules;
+// the second is derived from the schedule ID.
+const scheduleLockSpace = 0x48435053 // "HCPS"
+
+// scheduleDefinitionColumns are the columns Update writes.
+var scheduleDefinitionColumns = []string{
+	"name", "description", "cron_expr", "timezone", "enabled", "template_id",
+	"name_pattern", "algorithm", "node_count", "duration", "target_tps",
+	"warmup_duration", "cooldown_duration", "consensus_config", "consensus_params",
+	"run_group", "tags", "next_run_at",
+}
+
+type benchmarkScheduleRepository struct {
+	db *gorm.DB
+}
+
+func NewBenchmarkScheduleRepository(db *gorm.DB) BenchmarkScheduleRepository {
+	return &benchmarkScheduleRepository{db: db}
+}
+
+func (r *benchmarkScheduleRepository) Create(ctx context.Context, schedule *models.BenchmarkSchedule) error {
+	return r.db.WithContext(ctx).Create(schedule).Error
+}
+
+func (r *benchmarkScheduleRepository) GetByID(ctx context.Context, id string) (*models.BenchmarkSchedule, error) {
+	var schedule models.BenchmarkSchedule
+	if err := r.db.WithContext(ctx).First(&schedule, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &schedule, nil
+}
+
+func (r *benchmarkScheduleRepository) List(ctx context.Context, page, pageSize int) ([]models.BenchmarkSchedule, int64, error) {
+	var schedules []models.BenchmarkSchedule
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&models.BenchmarkSchedule{})
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * pageSize
+	if err := query.Order("name ASC").Offset(offset).Limit(pageSize).Find(&schedules).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return schedules, total, nil
+}
+
+func (r *benchmarkScheduleRepository) Update(ctx context.Context, schedule *models.BenchmarkSchedule) error {
+	result := r.db.WithContext(ctx).Model(schedule).Select(scheduleDefinitionColumns).Updates(schedule)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *benchmarkScheduleRepository) Delete(ctx context.Context, id string) error {
+	result := r.db.WithContext(ctx).Delete(&models.BenchmarkSchedule{}, "id = ?", id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *benchmarkScheduleRepository) ListDue(ctx context.Context, now time.Time) ([]models.BenchmarkSchedule, error) {
+	var schedules []models.BenchmarkSchedule
+	err := r.db.WithContext(ctx).
+		Where("enabled AND next_run_at <= ?", now).
+		Order("next_run_at ASC").
+		Find(&schedules).Error
+	if err != nil {
+		return nil, err
+	}
+	return schedules, nil
+}
+
+func (r *benchmarkScheduleRepository) Claim(ctx context.Context, id string, due time.Time, next *time.Time, now time.Time) (bool, error) {
+	claimed := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// The lock is released when the transaction ends. An instance that
+		// does not get it skips the run; one that gets it after the winner
+		// committed finds next_run_at already moved on.
+		var locked bool
+		if err := tx.Raw("SELECT pg_try_advisory_xact_lock(?, hashtext(?))", scheduleLockSpace, id).Scan(&locked).Error; err != nil {
+			return err
+		}
+		if !locked {
+			return nil
+		}
+		result := tx.Model(&models.BenchmarkSchedule{}).
+			Where("id = ? AND enabled AND next_run_at = ?", id, due).
+			Updates(map[string]interface{}{"next_run_at": next, "last_run_at": now})
+		if result.Error != nil {
+			return result.Error
+		}
+		claimed = result.RowsAffected == 1
+		return nil
+	})
+	return claimed, err
+}
+
+func (r *benchmarkScheduleRepository) RecordRun(ctx context.Context, id, benchmarkID, runErr string) error {
+	var lastBenchmarkID interface{}
+	if benchmarkID != "" {
+		lastBenchmarkID = benchmarkID
+	}
+	return r.db.WithContext(ctx).Model(&models.BenchmarkSchedule{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{"last_benchmark_id": lastBenchmarkID, "last_error": runErr}).Error
+}
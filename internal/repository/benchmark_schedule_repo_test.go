@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/fffeng99999/hcp-server/internal/models"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestBenchmarkSchedule_CreateKeepsDisabled(t *testing.T) {
+	schedule := models.BenchmarkSchedule{Name: "nightly", CronExpr: "0 2 * * *", Timezone: "UTC"}
+
+	sql := dryRunDB(t).ToSQL(func(tx *gorm.DB) *gorm.DB {
+		return tx.Create(&schedule)
+	})
+
+	// A zero value must not be swapped for the column default.
+	assert.Contains(t, sql, `'0 2 * * *','UTC',false,`)
+	assert.False(t, schedule.Enabled)
+}
@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/fffeng99999/hcp-server/internal/models"
+	"gorm.io/gorm"
+)
+
+type benchmarkTemplateRepository struct {
+	db *gorm.DB
+}
+
+func NewBenchmarkTemplateRepository(db *gorm.DB) BenchmarkTemplateRepository {
+	return &benchmarkTemplateRepository{db: db}
+}
+
+func (r *benchmarkTemplateRepository) Create(ctx context.Context, template *models.BenchmarkTemplate) error {
+	return r.db.WithContext(ctx).Create(template).Error
+}
+
+func (r *benchmarkTemplateRepository) GetByID(ctx context.Context, id string) (*models.BenchmarkTemplate, error) {
+	var template models.BenchmarkTemplate
+	if err := r.db.WithContext(ctx).First(&template, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &template, nil
+}
+
+func (r *benchmarkTemplateRepository) List(ctx context.Context, page, pageSize int) ([]models.BenchmarkTemplate, int64, error) {
+	var templates []models.BenchmarkTemplate
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&models.BenchmarkTemplate{})
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * pageSize
+	if err := query.Order("name ASC").Offset(offset).Limit(pageSize).Find(&templates).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return templates, total, nil
+}
+
+func (r *benchmarkTemplateRepository) Delete(ctx context.Context, id string) error {
+	result := r.db.WithContext(ctx).Delete(&models.BenchmarkTemplate{}, "id = ?", id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/fffeng99999/hcp-server/internal/models"
+	"gorm.io/gorm"
+)
+
+type experimentRepository struct {
+	db *gorm.DB
+}
+
+func NewExperimentRepository(db *gorm.DB) ExperimentRepository {
+	return &experimentRepository{db: db}
+}
+
+func (r *experimentRepository) Create(ctx context.Context, experiment *models.Experiment, benchmarks []*models.Benchmark) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(experiment).Error; err != nil {
+			return err
+		}
+		for _, b := range benchmarks {
+			b.ExperimentID = &experiment.ID
+		}
+		if len(benchmarks) == 0 {
+			return nil
+		}
+		return tx.CreateInBatches(benchmarks, 100).Error
+	})
+}
+
+func (r *experimentRepository) GetByID(ctx context.Context, id string) (*models.Experiment, error) {
+	var experiment models.Experiment
+	if err := r.db.WithContext(ctx).First(&experiment, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &experiment, nil
+}
+
+func (r *experimentRepository) UpdateStatus(ctx context.Context, id, status string) error {
+	return r.db.WithContext(ctx).Model(&models.Experiment{}).Where("id = ?", id).Update("status", status).Error
+}
+
+func (r *experimentRepository) CountByStatus(ctx context.Context, id string) (map[string]int64, error) {
+	var rows []struct {
+		Status string
+		Count  int64
+	}
+	err := r.db.WithContext(ctx).Model(&models.Benchmark{}).
+		Select("status, COUNT(*) as count").
+		Where("experiment_id = ?", id).
+		Group("status").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		counts[row.Status] = row.Count
+	}
+	return counts, nil
+}
+
+func (r *experimentRepository) GetGrid(ctx context.Context, id string, axes []string) ([]GridCell, error) {
+	for _, axis := range axes {
+		switch axis {
+		case AxisAlgorithm, AxisNodeCount, AxisTargetTPS:
+		default:
+			return nil, fmt.Errorf("unknown grid axis %q", axis)
+		}
+	}
+
+	selects := append([]string(nil), axes...)
+	selects = append(selects,
+		"COUNT(*) as runs",
+		"COUNT(*) FILTER (WHERE status = 'completed') as completed_runs",
+		"COALESCE(AVG(actual_tps) FILTER (WHERE status = 'completed'), 0) as tps_mean",
+		"COALESCE(STDDEV_SAMP(actual_tps) FILTER (WHERE status = 'completed'), 0) as tps_stddev",
+		"COALESCE(MIN(actual_tps) FILTER (WHERE status = 'completed'), 0) as tps_min",
+		"COALESCE(MAX(actual_tps) FILTER (WHERE status = 'completed'), 0) as tps_max",
+		"COALESCE(AVG(latency_p50) FILTER (WHERE status = 'completed'), 0) as latency_p50",
+		"COALESCE(AVG(latency_p99) FILTER (WHERE status = 'completed'), 0) as latency_p99",
+	)
+
+	query := r.db.WithContext(ctx).Model(&models.Benchmark{}).
+		Select(strings.Join(selects, ", ")).
+		Where("experiment_id = ?", id)
+	if len(axes) > 0 {
+		grouping := strings.Join(axes, ", ")
+		query = query.Group(grouping).Order(grouping)
+	}
+
+	var cells []GridCell
+	if err := query.Scan(&cells).Error; err != nil {
+		return nil, err
+	}
+	return cells, nil
+}
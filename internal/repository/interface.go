@@ -2,24 +2,350 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/fffeng99999/hcp-server/internal/models"
 )
 
+// ErrConflict is returned when a conditional write loses a race with another
+// writer, e.g. a status transition whose expected current status no longer holds.
+var ErrConflict = errors.New("repository: concurrent modification")
+
+// ErrInvalidFilter is returned for list filters that cannot be translated
+// into a query.
+var ErrInvalidFilter = errors.New("repository: invalid filter")
+
+// ErrNonceUsed is returned for a transaction whose sender already used its
+// nonce in the benchmark.
+var ErrNonceUsed = errors.New("repository: nonce already used")
+
+// ErrDuplicateTransaction is returned for a transaction whose hash was
+// already recorded in the benchmark.
+var ErrDuplicateTransaction = errors.New("repository: transaction already exists")
+
+// ErrBenchmarkFinished is returned for a transaction of a benchmark that has
+// reached a terminal state.
+var ErrBenchmarkFinished = errors.New("repository: benchmark has finished")
+
 type BenchmarkRepository interface {
 	Create(ctx context.Context, benchmark *models.Benchmark) error
 	GetByID(ctx context.Context, id string) (*models.Benchmark, error)
-	List(ctx context.Context, page, pageSize int) ([]models.Benchmark, int64, error)
+	List(ctx context.Context, filter BenchmarkFilter, page, pageSize int) ([]models.Benchmark, int64, error)
 	Update(ctx context.Context, benchmark *models.Benchmark) error
+	// UpdateFields writes the given columns. If expectedUpdatedAt is non-nil the
+	// write only happens when updated_at still matches, otherwise ErrConflict is
+	// returned. A missing benchmark yields gorm.ErrRecordNotFound.
+	UpdateFields(ctx context.Context, id string, expectedUpdatedAt *time.Time, updates map[string]interface{}) error
+	// Delete removes a benchmark; transactions and metrics go with it through
+	// their ON DELETE CASCADE keys. A missing benchmark yields gorm.ErrRecordNotFound.
+	Delete(ctx context.Context, id string) error
+	// UpdateStatus moves a benchmark from status `from` to `to` and applies the
+	// extra column updates in the same statement. It returns ErrConflict if the
+	// benchmark is no longer in `from` or, when expectedUpdatedAt is non-nil,
+	// its updated_at no longer matches.
+	UpdateStatus(ctx context.Context, id, from, to string, expectedUpdatedAt *time.Time, updates map[string]interface{}) error
+	ListByStatus(ctx context.Context, statuses ...string) ([]models.Benchmark, error)
+	ListByRunGroup(ctx context.Context, runGroup string) ([]models.Benchmark, error)
+}
+
+type BenchmarkFilter struct {
+	Status    string
+	Algorithm string
+	RunGroup  string
+	// Node count range, inclusive; zero leaves a bound open.
+	MinNodeCount int
+	MaxNodeCount int
+	// Creation time range: CreatedAfter inclusive, CreatedBefore exclusive.
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+	// Tags that must all be present.
+	Tags []string
+	// SLAStatus matches runs whose assertions were evaluated with that
+	// outcome.
+	SLAStatus string
+	// Experiment matches the runs of experiments with this name.
+	Experiment string
+	// Search is a full-text query over name and description, in web search
+	// syntax ("quoted phrase", -excluded, or).
+	Search string
+	// Parameters matches configuration values by dotted path, e.g.
+	// "consensus.block_size", "consensus.network.latency_ms",
+	// "environment.git_commit" or "params.batch_size". Values are compared
+	// as text. Unknown roots or malformed paths yield ErrInvalidFilter.
+	Parameters map[string]string
+	// SortBy is one of BenchmarkSortColumns; it defaults to created_at.
+	// Results are descending unless SortAscending is set.
+	SortBy        string
+	SortAscending bool
+}
+
+// BenchmarkSortColumns are the indexed columns List can sort on.
+var BenchmarkSortColumns = []string{"created_at", "algorithm", "status", "node_count", "actual_tps"}
+
+// BenchmarkQueueRepository reads the benchmark queue: queued runs waiting for
+// a slot on their cluster, in dispatch order (highest priority first, then
+// oldest first).
+type BenchmarkQueueRepository interface {
+	// ClusterCounts returns the number of queued and active runs of every
+	// cluster that has any.
+	ClusterCounts(ctx context.Context) ([]ClusterCount, error)
+	// CountActive returns the number of active runs on a cluster.
+	CountActive(ctx context.Context, cluster string) (int64, error)
+	// ListQueued returns the queued runs of a cluster, or of every cluster
+	// when cluster is empty, grouped by cluster in dispatch order.
+	ListQueued(ctx context.Context, cluster string) ([]models.Benchmark, error)
+	// Position returns the 1-based place of a benchmark in its cluster's
+	// queue, or 0 if it is not queued.
+	Position(ctx context.Context, id string) (int64, error)
+	// NextDispatchable returns the first queued run of a cluster that may
+	// start now, or nil if there is none. Runs of an experiment wait while
+	// another run of the same experiment is active.
+	NextDispatchable(ctx context.Context, cluster string) (*models.Benchmark, error)
+	// WithClusterLock calls fn while holding a Postgres advisory lock on the
+	// cluster, so that of several server instances only one dispatches to it
+	// at a time. It reports false without calling fn when another instance
+	// holds the lock.
+	WithClusterLock(ctx context.Context, cluster string, fn func(ctx context.Context) error) (bool, error)
+}
+
+type ClusterCount struct {
+	Cluster string
+	Queued  int64
+	Active  int64
+}
+
+// BenchmarkRankingRepository aggregates completed runs across benchmarks for
+// the leaderboard and trends. Only completed runs with a measured throughput
+// count, so both read through idx_benchmarks_algorithm_status and
+// idx_benchmarks_actual_tps.
+type BenchmarkRankingRepository interface {
+	// Leaderboard aggregates the runs matching filter per algorithm and node
+	// count, ordered by node count and algorithm.
+	Leaderboard(ctx context.Context, filter RankingFilter) ([]LeaderboardRow, error)
+	// Trend aggregates one result column of an algorithm's runs per time
+	// bucket or per implementation version, in chronological order. The
+	// column must be one of TrendMetrics; unknown columns, groupings and
+	// intervals yield ErrInvalidFilter.
+	Trend(ctx context.Context, filter RankingFilter, metric, groupBy, interval string) ([]TrendRow, error)
+}
+
+// RankingFilter selects the runs the leaderboard and trends are computed
+// over. Zero values leave a criterion open.
+type RankingFilter struct {
+	Algorithms    []string
+	NodeCount     int
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+}
+
+type LeaderboardRow struct {
+	Algorithm        string
+	NodeCount        int
+	Runs             int64
+	BestTPS          float64
+	MedianTPS        float64
+	BestLatencyP99   float64
+	MedianLatencyP99 float64
+	// BestBenchmarkID is the run with the highest throughput.
+	BestBenchmarkID string
+}
+
+// Trend groupings and bucket intervals.
+const (
+	TrendByTime    = "time"
+	TrendByVersion = "version"
+)
+
+var TrendIntervals = []string{"hour", "day", "week", "month"}
+
+// TrendMetrics are the result columns a trend can follow.
+var TrendMetrics = []string{
+	"actual_tps", "latency_p50", "latency_p90", "latency_p99", "latency_p999", "latency_avg",
+	"cpu_usage_avg", "cpu_usage_max", "memory_usage_avg", "memory_usage_max",
+	"block_propagation_time", "view_change_count",
+}
+
+// TrendRow aggregates the runs of one time bucket or version. Label is the
+// version, or the bucket start in RFC 3339; FirstRunAt is when the earliest of
+// the runs was created.
+type TrendRow struct {
+	Label      string
+	FirstRunAt time.Time
+	Runs       int64
+	Mean       float64
+	Median     float64
+	Min        float64
+	Max        float64
+}
+
+type BenchmarkNodeRepository interface {
+	// Upsert records memberships keyed by (benchmark, node). Unset join and
+	// leave times keep the stored values.
+	Upsert(ctx context.Context, memberships []models.BenchmarkNode) error
+	// ListByBenchmark returns a run's memberships with their nodes loaded.
+	ListByBenchmark(ctx context.Context, benchmarkID string, filter BenchmarkNodeFilter) ([]models.BenchmarkNode, error)
+}
+
+type BenchmarkNodeFilter struct {
+	Role       string
+	FaultyOnly bool
+}
+
+type BenchmarkScheduleRepository interface {
+	// Create stores a schedule; a taken name yields gorm.ErrDuplicatedKey.
+	Create(ctx context.Context, schedule *models.BenchmarkSchedule) error
+	GetByID(ctx context.Context, id string) (*models.BenchmarkSchedule, error)
+	List(ctx context.Context, page, pageSize int) ([]models.BenchmarkSchedule, int64, error)
+	// Update saves the schedule's definition and next run time.
+	Update(ctx context.Context, schedule *models.BenchmarkSchedule) error
 	Delete(ctx context.Context, id string) error
+	// ListDue returns the enabled schedules whose next run is at or before now.
+	ListDue(ctx context.Context, now time.Time) ([]models.BenchmarkSchedule, error)
+	// Claim moves a due schedule's next run from due to next while holding a
+	// Postgres advisory lock on it, so that of several server instances only
+	// one fires each run. It reports whether the caller won the claim.
+	Claim(ctx context.Context, id string, due time.Time, next *time.Time, now time.Time) (bool, error)
+	// RecordRun stores the outcome of a fired run. benchmarkID is empty when
+	// no benchmark could be created.
+	RecordRun(ctx context.Context, id, benchmarkID, runErr string) error
+}
+
+type BenchmarkTemplateRepository interface {
+	// Create stores a template; a taken name yields gorm.ErrDuplicatedKey.
+	Create(ctx context.Context, template *models.BenchmarkTemplate) error
+	GetByID(ctx context.Context, id string) (*models.BenchmarkTemplate, error)
+	List(ctx context.Context, page, pageSize int) ([]models.BenchmarkTemplate, int64, error)
+	// Delete removes a template. Runs created from it keep their settings.
+	Delete(ctx context.Context, id string) error
+}
+
+type ExperimentRepository interface {
+	// Create stores the experiment and its benchmarks in one transaction.
+	Create(ctx context.Context, experiment *models.Experiment, benchmarks []*models.Benchmark) error
+	GetByID(ctx context.Context, id string) (*models.Experiment, error)
+	UpdateStatus(ctx context.Context, id, status string) error
+	// CountByStatus returns the number of the experiment's benchmarks per status.
+	CountByStatus(ctx context.Context, id string) (map[string]int64, error)
+	// GetGrid rolls the experiment's results up along the given axes.
+	GetGrid(ctx context.Context, id string, axes []string) ([]GridCell, error)
+}
+
+// Experiment grid axes.
+const (
+	AxisAlgorithm = "algorithm"
+	AxisNodeCount = "node_count"
+	AxisTargetTPS = "target_tps"
+)
+
+// GridCell aggregates the runs sharing the same values on the grouped axes.
+// Axes that were not grouped on are left zero.
+type GridCell struct {
+	Algorithm     string
+	NodeCount     int
+	TargetTPS     int
+	Runs          int64
+	CompletedRuns int64
+	TPSMean       float64
+	TPSStddev     float64
+	TPSMin        float64
+	TPSMax        float64
+	LatencyP50    float64 // mean across completed runs
+	LatencyP99    float64 // mean across completed runs
 }
 
 type TransactionRepository interface {
+	// Create stores a transaction and claims its sender's nonce in the
+	// benchmark. It fails with ErrNonceUsed or ErrDuplicateTransaction if the
+	// nonce or hash was already recorded there, and with ErrBenchmarkFinished
+	// if the benchmark has reached a terminal state. The benchmark's status
+	// cannot change while the transaction is stored.
 	Create(ctx context.Context, tx *models.Transaction) error
+	// CreateBatch stores transactions as Create does, with as few statements
+	// as the bind parameter limit allows, in one database transaction. The
+	// result holds for each row nil if it was stored, or ErrNonceUsed,
+	// ErrDuplicateTransaction or ErrBenchmarkFinished.
+	CreateBatch(ctx context.Context, txs []*models.Transaction) ([]error, error)
+	// NextNonce returns one past the highest nonce the sender has used in the
+	// benchmark, or 0 if it has sent nothing.
+	NextNonce(ctx context.Context, benchmarkID, fromAddress string) (int64, error)
+	// GetNonceGaps counts the nonces the benchmark's senders skipped, taking
+	// every sender to start at nonce 0.
+	GetNonceGaps(ctx context.Context, benchmarkID string) (*NonceGaps, error)
 	GetByHash(ctx context.Context, hash string) (*models.Transaction, error)
 	List(ctx context.Context, filter TransactionFilter, page, pageSize int) ([]models.Transaction, int64, error)
-	GetStats(ctx context.Context, benchmarkID string) (*TransactionStats, error)
+	// GetStats counts the benchmark's transactions submitted inside window.
+	GetStats(ctx context.Context, benchmarkID string, window TimeWindow) (*TransactionStats, error)
+	// GetSummary aggregates the benchmark's transactions submitted inside window.
+	GetSummary(ctx context.Context, benchmarkID string, window TimeWindow) (*TransactionSummary, error)
+	// GetLatencySamples returns up to limit latencies of confirmed transactions
+	// drawn at random from the benchmarks' measurement windows.
+	GetLatencySamples(ctx context.Context, benchmarkIDs []string, limit int) ([]float64, error)
+	// GetThroughputSeries returns confirmed transactions per second in
+	// consecutive buckets of the given width, by confirmation time. Buckets
+	// between the first and last confirmation that have none are zero.
+	GetThroughputSeries(ctx context.Context, benchmarkID string, bucket time.Duration) ([]SeriesPoint, error)
+	// FailPending marks a benchmark's pending transactions failed with the
+	// given error message and returns how many were changed.
+	FailPending(ctx context.Context, benchmarkID, reason string) (int64, error)
+	// Settle applies the settlements to transactions that are still pending,
+	// in one database transaction. The result holds the updated rows in the
+	// order given, with nil where no pending transaction matched.
+	Settle(ctx context.Context, settlements []TransactionSettlement) ([]*models.Transaction, error)
+}
+
+// TransactionSettlement moves a pending transaction to confirmed or failed.
+type TransactionSettlement struct {
+	Hash string
+	// SubmittedAt narrows the update to the partition holding the
+	// transaction; second precision is enough. When zero it is looked up by
+	// hash within BenchmarkID first, so one of the two must be set.
+	SubmittedAt time.Time
+	// BenchmarkID, when set, restricts the update to that benchmark's
+	// transactions.
+	BenchmarkID string
+	// Status is TransactionStatusConfirmed or TransactionStatusFailed.
+	Status           string
+	BlockNumber      int64
+	BlockHash        string
+	TransactionIndex int
+	GasUsed          int64
+	// ConfirmedAt is required for confirmations; latency_ms is computed from
+	// it. Failures leave both unset.
+	ConfirmedAt  time.Time
+	ErrorMessage string
+}
+
+// SeriesPoint is one bucket of a time series, stamped with the bucket start.
+type SeriesPoint struct {
+	Time  time.Time
+	Value float64
+}
+
+// TimeWindow bounds a time-range query to [Start, End). Zero values leave that
+// side open.
+type TimeWindow struct {
+	Start time.Time
+	End   time.Time
+}
+
+type TransactionSummary struct {
+	TotalTransactions int64
+	ConfirmedCount    int64
+	FailedCount       int64
+	BlockCount        int64
+
+	// Latency percentiles over confirmed transactions, in milliseconds.
+	LatencyP50  float64
+	LatencyP90  float64
+	LatencyP99  float64
+	LatencyP999 float64
+	LatencyAvg  float64
+	LatencyMin  float64
+	LatencyMax  float64
+
+	FirstSubmittedAt *time.Time
+	LastConfirmedAt  *time.Time
 }
 
 type TransactionFilter struct {
@@ -35,6 +361,16 @@ type TransactionStats struct {
 	ConfirmedCount    int64
 	FailedCount       int64
 	AvgLatencyMs      float64
+	// NonceGaps covers the whole run, whatever the window.
+	NonceGaps NonceGaps
+}
+
+type NonceGaps struct {
+	Senders         int64
+	SendersWithGaps int64
+	// MissingNonces is the number of nonces below each sender's highest
+	// that were never used, summed over the senders.
+	MissingNonces int64
 }
 
 type NodeRepository interface {
@@ -55,5 +391,34 @@ type MetricRepository interface {
 	Create(ctx context.Context, metric *models.Metric) error
 	CreateBatch(ctx context.Context, metrics []*models.Metric) error
 	GetNodeMetrics(ctx context.Context, nodeID, metricName string, startTime, endTime time.Time, page, pageSize int) ([]models.Metric, int64, error)
-	GetBenchmarkMetrics(ctx context.Context, benchmarkID, metricName string, page, pageSize int) ([]models.Metric, int64, error)
+	// GetBenchmarkMetrics lists the benchmark's metrics reported inside window.
+	GetBenchmarkMetrics(ctx context.Context, benchmarkID, metricName string, window TimeWindow, page, pageSize int) ([]models.Metric, int64, error)
+	// GetBenchmarkAggregates summarises every metric reported for a benchmark
+	// inside window, one row per metric name.
+	GetBenchmarkAggregates(ctx context.Context, benchmarkID string, window TimeWindow) ([]MetricAggregate, error)
+	// GetNodeSeries averages every metric reported for a benchmark per node in
+	// buckets of the given width, ordered by node, metric and time. Buckets
+	// inside a series that have no reports are zero.
+	GetNodeSeries(ctx context.Context, benchmarkID string, bucket time.Duration) ([]NodeSeriesPoint, error)
+}
+
+type NodeSeriesPoint struct {
+	NodeID     string
+	MetricName string
+	MetricUnit string
+	Time       time.Time
+	Value      float64
+}
+
+type AnomalyRepository interface {
+	ListByBenchmark(ctx context.Context, benchmarkID string) ([]models.Anomaly, error)
+}
+
+type MetricAggregate struct {
+	MetricName string
+	Count      int64
+	Avg        float64
+	Min        float64
+	Max        float64
+	Sum        float64
 }
@@ -52,7 +52,7 @@ func (r *metricRepository) GetNodeMetrics(ctx context.Context, nodeID, metricNam
 	return metrics, total, nil
 }
 
-func (r *metricRepository) GetBenchmarkMetrics(ctx context.Context, benchmarkID, metricName string, page, pageSize int) ([]models.Metric, int64, error) {
+func (r *metricRepository) GetBenchmarkMetrics(ctx context.Context, benchmarkID, metricName string, window TimeWindow, page, pageSize int) ([]models.Metric, int64, error) {
 	var metrics []models.Metric
 	var total int64
 
@@ -61,6 +61,7 @@ func (r *metricRepository) GetBenchmarkMetrics(ctx context.Context, benchmarkID,
 	if metricName != "" {
 		query = query.Where("metric_name = ?", metricName)
 	}
+	query = applyWindow(query, "timestamp", window)
 
 	if err := query.Count(&total).Error; err != nil {
 		return nil, 0, err
@@ -73,3 +74,60 @@ func (r *metricRepository) GetBenchmarkMetrics(ctx context.Context, benchmarkID,
 
 	return metrics, total, nil
 }
+
+func (r *metricRepository) GetBenchmarkAggregates(ctx context.Context, benchmarkID string, window TimeWindow) ([]MetricAggregate, error) {
+	var aggregates []MetricAggregate
+
+	query := r.db.WithContext(ctx).Table("metrics").Select(`
+			metric_name,
+			COUNT(*) as count,
+			AVG(metric_value) as avg,
+			MIN(metric_value) as min,
+			MAX(metric_value) as max,
+			SUM(metric_value) as sum`).
+		Where("benchmark_id = ?", benchmarkID)
+	query = applyWindow(query, "timestamp", window)
+
+	if err := query.Group("metric_name").Scan(&aggregates).Error; err != nil {
+		return nil, err
+	}
+	return aggregates, nil
+}
+
+func (r *metricRepository) GetNodeSeries(ctx context.Context, benchmarkID string, bucket time.Duration) ([]NodeSeriesPoint, error) {
+	seconds := bucket.Seconds()
+	var points []NodeSeriesPoint
+	// Each series runs from its first to its last bucket with data; the
+	// buckets in between that have none are generated as zeros.
+	err := r.db.WithContext(ctx).Raw(`
+		WITH buckets AS (
+			SELECT
+				node_id,
+				metric_name,
+				MAX(metric_unit) AS metric_unit,
+				floor(extract(epoch FROM timestamp) / ?)::bigint AS bucket,
+				AVG(metric_value) AS value
+			FROM metrics
+			WHERE benchmark_id = ?
+			GROUP BY node_id, metric_name, 4
+		), series AS (
+			SELECT node_id, metric_name, MAX(metric_unit) AS metric_unit, MIN(bucket) AS first, MAX(bucket) AS last
+			FROM buckets
+			GROUP BY node_id, metric_name
+		)
+		SELECT
+			s.node_id,
+			s.metric_name,
+			s.metric_unit,
+			to_timestamp(g.bucket * ?) AT TIME ZONE 'UTC' AS time,
+			COALESCE(b.value, 0) AS value
+		FROM series s
+		CROSS JOIN LATERAL generate_series(s.first, s.last) AS g(bucket)
+		LEFT JOIN buckets b ON b.node_id = s.node_id AND b.metric_name = s.metric_name AND b.bucket = g.bucket
+		ORDER BY s.node_id, s.metric_name, g.bucket
+	`, seconds, benchmarkID, seconds).Scan(&points).Error
+	if err != nil {
+		return nil, err
+	}
+	return points, nil
+}
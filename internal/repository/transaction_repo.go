@@ -3,9 +3,13 @@ package repository
 import (
 	"context"
 	"errors"
+	"strings"
+	"time"
 
 	"github.com/fffeng99999/hcp-server/internal/models"
+	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type transactionRepository struct {
@@ -17,7 +21,237 @@ func NewTransactionRepository(db *gorm.DB) TransactionRepository {
 }
 
 func (r *transactionRepository) Create(ctx context.Context, tx *models.Transaction) error {
-	return r.db.WithContext(ctx).Create(tx).Error
+	errs, err := r.CreateBatch(ctx, []*models.Transaction{tx})
+	if err != nil {
+		return err
+	}
+	return errs[0]
+}
+
+// maxBindParams is the most bind parameters Postgres accepts per statement.
+const maxBindParams = 65535
+
+// transactionColumns are the columns CreateBatch writes, in the order of
+// transactionValues.
+var transactionColumns = []string{
+	"hash", "from_address", "to_address", "amount", "gas_price", "gas_limit", "gas_used", "nonce",
+	"block_number", "block_hash", "transaction_index", "status", "error_message",
+	"submitted_at", "confirmed_at", "latency_ms", "benchmark_id",
+}
+
+func transactionValues(tx *models.Transaction) []interface{} {
+	return []interface{}{
+		tx.Hash, tx.FromAddress, tx.ToAddress, tx.Amount, tx.GasPrice, tx.GasLimit, tx.GasUsed, tx.Nonce,
+		tx.BlockNumber, tx.BlockHash, tx.TransactionIndex, tx.Status, tx.ErrorMessage,
+		tx.SubmittedAt, tx.ConfirmedAt, tx.LatencyMs, tx.BenchmarkID,
+	}
+}
+
+var nonceColumns = []string{"benchmark_id", "from_address", "nonce", "hash"}
+
+// claimKey identifies a transaction within its benchmark.
+type claimKey struct {
+	BenchmarkID uuid.UUID
+	Hash        string
+}
+
+func (r *transactionRepository) CreateBatch(ctx context.Context, txs []*models.Transaction) ([]error, error) {
+	errs := make([]error, len(txs))
+	err := r.db.WithContext(ctx).Transaction(func(db *gorm.DB) error {
+		finished, err := lockBenchmarks(db, txs)
+		if err != nil {
+			return err
+		}
+		var open []*models.Transaction
+		var openIndex []int
+		for i, tx := range txs {
+			if finished[tx.BenchmarkID] {
+				errs[i] = ErrBenchmarkFinished
+				continue
+			}
+			open = append(open, tx)
+			openIndex = append(openIndex, i)
+		}
+		claimErrs, err := claimNonces(db, open)
+		if err != nil {
+			return err
+		}
+		for j, i := range openIndex {
+			errs[i] = claimErrs[j]
+		}
+
+		var claimed []*models.Transaction
+		var rows [][]interface{}
+		for i, tx := range txs {
+			if errs[i] == nil {
+				claimed = append(claimed, tx)
+				rows = append(rows, transactionValues(tx))
+			}
+		}
+		hashes, err := insertRows[string](db, "transactions", transactionColumns, rows,
+			"ON CONFLICT (hash, submitted_at) DO NOTHING RETURNING hash")
+		if err != nil {
+			return err
+		}
+		// Claimed hashes are unique within a benchmark, so a conflict here
+		// is a transaction of another benchmark submitted at the same time.
+		inserted := make(map[string]bool, len(hashes))
+		for _, h := range hashes {
+			inserted[h] = true
+		}
+		for i, tx := range txs {
+			if errs[i] == nil && !inserted[tx.Hash] {
+				errs[i] = ErrDuplicateTransaction
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return errs, nil
+}
+
+// lockBenchmarks locks the benchmarks of txs against status changes until the
+// database transaction ends, so that no transaction is stored after its
+// benchmark finished and FailPending ran. It returns the benchmarks that have
+// already finished.
+func lockBenchmarks(db *gorm.DB, txs []*models.Transaction) (map[uuid.UUID]bool, error) {
+	seen := make(map[uuid.UUID]bool)
+	var ids []uuid.UUID
+	for _, tx := range txs {
+		if !seen[tx.BenchmarkID] {
+			seen[tx.BenchmarkID] = true
+			ids = append(ids, tx.BenchmarkID)
+		}
+	}
+	var benchmarks []models.Benchmark
+	err := db.Select("id, status").Clauses(clause.Locking{Strength: "SHARE"}).
+		Where("id IN ?", ids).Order("id").Find(&benchmarks).Error
+	if err != nil {
+		return nil, err
+	}
+	finished := make(map[uuid.UUID]bool)
+	for i := range benchmarks {
+		if benchmarks[i].IsTerminal() {
+			finished[benchmarks[i].ID] = true
+		}
+	}
+	return finished, nil
+}
+
+// claimNonces records the nonces of txs and returns for each row nil if its
+// nonce was claimed, or why not.
+func claimNonces(db *gorm.DB, txs []*models.Transaction) ([]error, error) {
+	rows := make([][]interface{}, len(txs))
+	for i, tx := range txs {
+		rows[i] = []interface{}{tx.BenchmarkID, tx.FromAddress, tx.Nonce, tx.Hash}
+	}
+	claimed, err := insertRows[claimKey](db, "transaction_nonces", nonceColumns, rows,
+		"ON CONFLICT DO NOTHING RETURNING benchmark_id, hash")
+	if err != nil {
+		return nil, err
+	}
+
+	won := make(map[claimKey]bool, len(claimed))
+	for _, k := range claimed {
+		won[k] = true
+	}
+	errs := make([]error, len(txs))
+	var lost [][]interface{}
+	for i, tx := range txs {
+		k := claimKey{tx.BenchmarkID, tx.Hash}
+		if won[k] {
+			// Only the first of identical rows gets the claim.
+			delete(won, k)
+			continue
+		}
+		errs[i] = ErrNonceUsed
+		lost = append(lost, []interface{}{tx.BenchmarkID, tx.Hash})
+	}
+	if len(lost) == 0 {
+		return errs, nil
+	}
+
+	// Rows whose hash is already recorded are duplicates rather than
+	// replays of a nonce.
+	var existing []claimKey
+	err = db.Model(&models.TransactionNonce{}).Select("benchmark_id, hash").Where("(benchmark_id, hash) IN ?", lost).Scan(&existing).Error
+	if err != nil {
+		return nil, err
+	}
+	known := make(map[claimKey]bool, len(existing))
+	for _, k := range existing {
+		known[k] = true
+	}
+	for i, tx := range txs {
+		if errs[i] != nil && known[claimKey{tx.BenchmarkID, tx.Hash}] {
+			errs[i] = ErrDuplicateTransaction
+		}
+	}
+	return errs, nil
+}
+
+// insertRows writes rows into table with multi-row INSERTs that stay under the
+// bind parameter limit, each followed by suffix, and collects what the
+// statements return.
+func insertRows[T any](db *gorm.DB, table string, columns []string, rows [][]interface{}, suffix string) ([]T, error) {
+	var returned []T
+	chunk := maxBindParams / len(columns)
+	placeholders := "(" + strings.TrimSuffix(strings.Repeat("?, ", len(columns)), ", ") + ")"
+	for start := 0; start < len(rows); start += chunk {
+		end := start + chunk
+		if end > len(rows) {
+			end = len(rows)
+		}
+		var sql strings.Builder
+		sql.WriteString("INSERT INTO " + table + " (" + strings.Join(columns, ", ") + ") VALUES ")
+		args := make([]interface{}, 0, (end-start)*len(columns))
+		for i, row := range rows[start:end] {
+			if i > 0 {
+				sql.WriteString(", ")
+			}
+			sql.WriteString(placeholders)
+			args = append(args, row...)
+		}
+		sql.WriteString(" " + suffix)
+
+		var part []T
+		if err := db.Raw(sql.String(), args...).Scan(&part).Error; err != nil {
+			return nil, err
+		}
+		returned = append(returned, part...)
+	}
+	return returned, nil
+}
+
+func (r *transactionRepository) NextNonce(ctx context.Context, benchmarkID, fromAddress string) (int64, error) {
+	var next int64
+	err := r.db.WithContext(ctx).Model(&models.TransactionNonce{}).
+		Select("COALESCE(MAX(nonce) + 1, 0)").
+		Where("benchmark_id = ? AND from_address = ?", benchmarkID, fromAddress).
+		Scan(&next).Error
+	return next, err
+}
+
+func (r *transactionRepository) GetNonceGaps(ctx context.Context, benchmarkID string) (*NonceGaps, error) {
+	var gaps NonceGaps
+	err := r.db.WithContext(ctx).Raw(`
+		SELECT
+			COUNT(*) AS senders,
+			COUNT(*) FILTER (WHERE missing > 0) AS senders_with_gaps,
+			COALESCE(SUM(missing), 0) AS missing_nonces
+		FROM (
+			SELECT MAX(nonce) + 1 - COUNT(*) AS missing
+			FROM transaction_nonces
+			WHERE benchmark_id = ?
+			GROUP BY from_address
+		) s
+	`, benchmarkID).Scan(&gaps).Error
+	if err != nil {
+		return nil, err
+	}
+	return &gaps, nil
 }
 
 func (r *transactionRepository) GetByHash(ctx context.Context, hash string) (*models.Transaction, error) {
@@ -62,7 +296,7 @@ func (r *transactionRepository) List(ctx context.Context, filter TransactionFilt
 	return txs, total, nil
 }
 
-func (r *transactionRepository) GetStats(ctx context.Context, benchmarkID string) (*TransactionStats, error) {
+func (r *transactionRepository) GetStats(ctx context.Context, benchmarkID string, window TimeWindow) (*TransactionStats, error) {
 	var stats TransactionStats
 	var result struct {
 		Total        int64
@@ -79,16 +313,15 @@ func (r *transactionRepository) GetStats(ctx context.Context, benchmarkID string
 	// We can do this in one query using FILTER or CASE WHEN (Postgres)
 	// Or multiple queries. For simplicity and GORM compatibility:
 	
-	err := r.db.WithContext(ctx).Raw(`
-		SELECT 
+	query := r.db.WithContext(ctx).Table("transactions").Select(`
 			COUNT(*) as total,
 			COUNT(*) FILTER (WHERE status = 'pending') as pending,
 			COUNT(*) FILTER (WHERE status = 'confirmed') as confirmed,
 			COUNT(*) FILTER (WHERE status = 'failed') as failed,
-			COALESCE(AVG(latency_ms), 0) as avg_latency_ms
-		FROM transactions
-		WHERE benchmark_id = ?
-	`, benchmarkID).Scan(&result).Error
+			COALESCE(AVG(latency_ms), 0) as avg_latency_ms`).
+		Where("benchmark_id = ?", benchmarkID)
+	query = applyWindow(query, "submitted_at", window)
+	err := query.Scan(&result).Error
 
 	if err != nil {
 		return nil, err
@@ -102,3 +335,164 @@ func (r *transactionRepository) GetStats(ctx context.Context, benchmarkID string
 
 	return &stats, nil
 }
+
+func (r *transactionRepository) GetSummary(ctx context.Context, benchmarkID string, window TimeWindow) (*TransactionSummary, error) {
+	var summary TransactionSummary
+
+	query := r.db.WithContext(ctx).Table("transactions").Select(`
+			COUNT(*) as total_transactions,
+			COUNT(*) FILTER (WHERE status = 'confirmed') as confirmed_count,
+			COUNT(*) FILTER (WHERE status = 'failed') as failed_count,
+			COUNT(DISTINCT block_number) FILTER (WHERE status = 'confirmed' AND block_number > 0) as block_count,
+			COALESCE(percentile_cont(0.5) WITHIN GROUP (ORDER BY latency_ms) FILTER (WHERE status = 'confirmed'), 0) as latency_p50,
+			COALESCE(percentile_cont(0.9) WITHIN GROUP (ORDER BY latency_ms) FILTER (WHERE status = 'confirmed'), 0) as latency_p90,
+			COALESCE(percentile_cont(0.99) WITHIN GROUP (ORDER BY latency_ms) FILTER (WHERE status = 'confirmed'), 0) as latency_p99,
+			COALESCE(percentile_cont(0.999) WITHIN GROUP (ORDER BY latency_ms) FILTER (WHERE status = 'confirmed'), 0) as latency_p999,
+			COALESCE(AVG(latency_ms) FILTER (WHERE status = 'confirmed'), 0) as latency_avg,
+			COALESCE(MIN(latency_ms) FILTER (WHERE status = 'confirmed'), 0) as latency_min,
+			COALESCE(MAX(latency_ms) FILTER (WHERE status = 'confirmed'), 0) as latency_max,
+			MIN(submitted_at) as first_submitted_at,
+			MAX(confirmed_at) as last_confirmed_at`).
+		Where("benchmark_id = ?", benchmarkID)
+	query = applyWindow(query, "submitted_at", window)
+
+	if err := query.Scan(&summary).Error; err != nil {
+		return nil, err
+	}
+	return &summary, nil
+}
+
+func (r *transactionRepository) GetLatencySamples(ctx context.Context, benchmarkIDs []string, limit int) ([]float64, error) {
+	var samples []float64
+	err := r.db.WithContext(ctx).Raw(`
+		SELECT t.latency_ms
+		FROM transactions t
+		JOIN benchmarks b ON b.id = t.benchmark_id
+		WHERE t.benchmark_id IN ?
+			AND t.status = 'confirmed'
+			AND t.latency_ms IS NOT NULL
+			AND (b.measurement_started_at IS NULL OR t.submitted_at >= b.measurement_started_at)
+			AND (b.measurement_ended_at IS NULL OR t.submitted_at < b.measurement_ended_at)
+		ORDER BY random()
+		LIMIT ?
+	`, benchmarkIDs, limit).Scan(&samples).Error
+	if err != nil {
+		return nil, err
+	}
+	return samples, nil
+}
+
+func (r *transactionRepository) GetThroughputSeries(ctx context.Context, benchmarkID string, bucket time.Duration) ([]SeriesPoint, error) {
+	seconds := bucket.Seconds()
+	var points []SeriesPoint
+	// Buckets without confirmations are generated so that charts drop to
+	// zero over idle periods instead of interpolating across them.
+	err := r.db.WithContext(ctx).Raw(`
+		WITH buckets AS (
+			SELECT floor(extract(epoch FROM confirmed_at) / ?)::bigint AS bucket, COUNT(*) AS n
+			FROM transactions
+			WHERE benchmark_id = ? AND status = 'confirmed' AND confirmed_at IS NOT NULL
+			GROUP BY 1
+		)
+		SELECT
+			to_timestamp(g.bucket * ?) AT TIME ZONE 'UTC' AS time,
+			COALESCE(b.n, 0) / ?::float AS value
+		FROM generate_series((SELECT MIN(bucket) FROM buckets), (SELECT MAX(bucket) FROM buckets)) AS g(bucket)
+		LEFT JOIN buckets b ON b.bucket = g.bucket
+		ORDER BY g.bucket
+	`, seconds, benchmarkID, seconds, seconds).Scan(&points).Error
+	if err != nil {
+		return nil, err
+	}
+	return points, nil
+}
+
+func (r *transactionRepository) FailPending(ctx context.Context, benchmarkID, reason string) (int64, error) {
+	result := r.db.WithContext(ctx).Model(&models.Transaction{}).
+		Where("benchmark_id = ? AND status = ?", benchmarkID, models.TransactionStatusPending).
+		Updates(map[string]interface{}{
+			"status":        models.TransactionStatusFailed,
+			"error_message": reason,
+		})
+	return result.RowsAffected, result.Error
+}
+
+func (r *transactionRepository) Settle(ctx context.Context, settlements []TransactionSettlement) ([]*models.Transaction, error) {
+	settled := make([]*models.Transaction, len(settlements))
+	err := r.db.WithContext(ctx).Transaction(func(db *gorm.DB) error {
+		for i, s := range settlements {
+			tx, err := settle(db, s)
+			if err != nil {
+				return err
+			}
+			settled[i] = tx
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return settled, nil
+}
+
+// settle updates one pending transaction and returns the new row, or nil if
+// none matched. The primary key is (hash, submitted_at) and the table is
+// partitioned by submitted_at, so the update is always bounded on both to
+// touch a single partition.
+func settle(db *gorm.DB, s TransactionSettlement) (*models.Transaction, error) {
+	query := db.Where("hash = ? AND status = ?", s.Hash, models.TransactionStatusPending)
+	if s.BenchmarkID != "" {
+		query = query.Where("benchmark_id = ?", s.BenchmarkID)
+	}
+	if s.SubmittedAt.IsZero() {
+		var submittedAt []time.Time
+		err := db.Model(&models.Transaction{}).Where("hash = ? AND benchmark_id = ?", s.Hash, s.BenchmarkID).Limit(1).Pluck("submitted_at", &submittedAt).Error
+		if err != nil {
+			return nil, err
+		}
+		if len(submittedAt) == 0 {
+			return nil, nil
+		}
+		query = query.Where("submitted_at = ?", submittedAt[0])
+	} else {
+		// Clients see submitted_at with second precision only.
+		start := s.SubmittedAt.Truncate(time.Second)
+		query = query.Where("submitted_at >= ? AND submitted_at < ?", start, start.Add(time.Second))
+	}
+
+	updates := map[string]interface{}{
+		"status":            s.Status,
+		"block_number":      s.BlockNumber,
+		"block_hash":        s.BlockHash,
+		"transaction_index": s.TransactionIndex,
+		"gas_used":          s.GasUsed,
+		"error_message":     s.ErrorMessage,
+	}
+	if !s.ConfirmedAt.IsZero() {
+		updates["confirmed_at"] = s.ConfirmedAt
+		// Capped so that a latency the column cannot hold does not abort
+		// the batch; the service rejects those it can tell beforehand.
+		updates["latency_ms"] = gorm.Expr("LEAST(GREATEST(EXTRACT(EPOCH FROM (CAST(? AS timestamp) - submitted_at)) * 1000, 0), ?)", s.ConfirmedAt, models.MaxLatencyMs)
+	}
+
+	var tx models.Transaction
+	result := query.Model(&tx).Clauses(clause.Returning{}).Updates(updates)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, nil
+	}
+	return &tx, nil
+}
+
+// applyWindow restricts column to the half-open window [Start, End).
+func applyWindow(query *gorm.DB, column string, window TimeWindow) *gorm.DB {
+	if !window.Start.IsZero() {
+		query = query.Where(column+" >= ?", window.Start)
+	}
+	if !window.End.IsZero() {
+		query = query.Where(column+" < ?", window.End)
+	}
+	return query
+}
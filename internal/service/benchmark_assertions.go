@@ -0,0 +1,123 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/fffeng99999/hcp-server/internal/models"
+	"github.com/fffeng99999/hcp-server/internal/repository"
+	"github.com/fffeng99999/hcp-server/internal/sla"
+)
+
+// assertionFields are the values SLA assertions may refer to by name: the
+// result summary columns plus the configuration they are judged against.
+func assertionFields(b *models.Benchmark) map[string]float64 {
+	fields := map[string]float64{
+		"target_tps":        float64(b.TargetTPS),
+		"node_count":        float64(b.NodeCount),
+		"duration":          float64(b.Duration),
+		"warmup_duration":   float64(b.WarmupDuration),
+		"cooldown_duration": float64(b.CooldownDuration),
+	}
+	for name, v := range resultColumns(b) {
+		switch v := v.(type) {
+		case int:
+			fields[name] = float64(v)
+		case float64:
+			fields[name] = v
+		}
+	}
+	return fields
+}
+
+// validateAssertions checks that every assertion parses and names only known
+// fields, so mistakes surface when the run is created rather than when it
+// completes.
+func validateAssertions(assertions []string) error {
+	known := assertionFields(&models.Benchmark{})
+	for _, s := range assertions {
+		a, err := sla.Parse(s)
+		if err != nil {
+			return err
+		}
+		for _, name := range a.Fields() {
+			if _, ok := known[name]; !ok {
+				return fmt.Errorf("%w %q: unknown field %q (available: %s)", sla.ErrInvalid, s, name, strings.Join(sortedKeys(known), ", "))
+			}
+		}
+	}
+	return nil
+}
+
+// evaluateAssertions checks a run's assertions against its computed results
+// and the metric aggregates over its measurement window. It returns no
+// status for runs without assertions.
+func evaluateAssertions(b *models.Benchmark, aggregates []repository.MetricAggregate) ([]models.AssertionResult, string) {
+	if len(b.Assertions) == 0 {
+		return nil, ""
+	}
+	env := &benchmarkEnv{fields: assertionFields(b), aggregates: make(map[string]repository.MetricAggregate, len(aggregates))}
+	for _, a := range aggregates {
+		env.aggregates[a.MetricName] = a
+	}
+
+	status := models.SLAStatusPassed
+	results := make([]models.AssertionResult, 0, len(b.Assertions))
+	for _, s := range b.Assertions {
+		result := models.AssertionResult{Assertion: s}
+		a, err := sla.Parse(s)
+		if err == nil {
+			res := a.Evaluate(env)
+			result.Passed, result.Left, result.Right, err = res.Passed, res.Left, res.Right, res.Err
+		}
+		if err != nil {
+			result.Error = err.Error()
+		}
+		if !result.Passed {
+			status = models.SLAStatusFailed
+		}
+		results = append(results, result)
+	}
+	return results, status
+}
+
+// benchmarkEnv resolves assertion values for one run.
+type benchmarkEnv struct {
+	fields     map[string]float64
+	aggregates map[string]repository.MetricAggregate
+}
+
+func (e *benchmarkEnv) Field(name string) (float64, bool) {
+	v, ok := e.fields[name]
+	return v, ok
+}
+
+func (e *benchmarkEnv) Aggregate(fn, metric string) (float64, bool) {
+	a, ok := e.aggregates[metric]
+	if !ok || a.Count == 0 {
+		return 0, false
+	}
+	switch fn {
+	case "max":
+		return a.Max, true
+	case "min":
+		return a.Min, true
+	case "avg":
+		return a.Avg, true
+	case "sum":
+		return a.Sum, true
+	case "count":
+		return float64(a.Count), true
+	}
+	return 0, false
+}
+
+func sortedKeys(m map[string]float64) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
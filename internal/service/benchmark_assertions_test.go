@@ -0,0 +1,55 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/fffeng99999/hcp-server/internal/models"
+	"github.com/fffeng99999/hcp-server/internal/repository"
+	"github.com/fffeng99999/hcp-server/internal/sla"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvaluateAssertions(t *testing.T) {
+	b := &models.Benchmark{
+		TargetTPS:        1000,
+		ActualTPS:        950,
+		LatencyP99:       620,
+		TransactionCount: 1000,
+		FailedTx:         5,
+		Assertions: []string{
+			"actual_tps >= 0.9*target_tps",
+			"latency_p99 < 500ms",
+			"failed_tx/transaction_count < 0.01",
+			"max(cpu_usage) < 80",
+			"avg(memory_usage) < 4096",
+		},
+	}
+	aggregates := []repository.MetricAggregate{{MetricName: "cpu_usage", Count: 4, Avg: 50, Max: 72}}
+
+	results, status := evaluateAssertions(b, aggregates)
+
+	assert.Equal(t, models.SLAStatusFailed, status)
+	assert.Equal(t, models.AssertionResult{Assertion: "actual_tps >= 0.9*target_tps", Passed: true, Left: 950, Right: 900}, results[0])
+	assert.Equal(t, models.AssertionResult{Assertion: "latency_p99 < 500ms", Left: 620, Right: 500}, results[1])
+	assert.True(t, results[2].Passed)
+	assert.True(t, results[3].Passed)
+	assert.Equal(t, 72.0, results[3].Left)
+	// A metric that was never reported cannot show the SLA was met.
+	assert.False(t, results[4].Passed)
+	assert.Contains(t, results[4].Error, "memory_usage")
+
+	b.Assertions = b.Assertions[:1]
+	_, status = evaluateAssertions(b, aggregates)
+	assert.Equal(t, models.SLAStatusPassed, status)
+
+	b.Assertions = nil
+	results, status = evaluateAssertions(b, aggregates)
+	assert.Nil(t, results)
+	assert.Empty(t, status)
+}
+
+func TestValidateAssertions(t *testing.T) {
+	assert.NoError(t, validateAssertions([]string{"actual_tps >= 0.9*target_tps", "max(any_metric) < 1"}))
+	assert.ErrorIs(t, validateAssertions([]string{"latency_p99 <"}), sla.ErrInvalid)
+	assert.ErrorIs(t, validateAssertions([]string{"latency_p100 < 500ms"}), sla.ErrInvalid)
+}
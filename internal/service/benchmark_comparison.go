@@ -0,0 +1,161 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/fffeng99999/hcp-server/internal/models"
+	"github.com/fffeng99999/hcp-server/internal/repository"
+)
+
+var ErrInvalidComparison = errors.New("comparison needs at least two distinct benchmarks")
+
+// ComparisonDimension describes one metric benchmarks are compared on.
+type ComparisonDimension struct {
+	Name           string
+	Category       string // throughput/latency/resources/consensus
+	Unit           string
+	HigherIsBetter bool
+	// ZeroIsMeasured marks counts, for which zero is a result. Elsewhere zero
+	// means the metric was not measured.
+	ZeroIsMeasured bool
+	Value          func(b *models.Benchmark) float64
+}
+
+// comparisonDimensions are compared in this order.
+var comparisonDimensions = []ComparisonDimension{
+	{"actual_tps", "throughput", "tx/s", true, false, func(b *models.Benchmark) float64 { return b.ActualTPS }},
+	{"latency_p50", "latency", "ms", false, false, func(b *models.Benchmark) float64 { return b.LatencyP50 }},
+	{"latency_p90", "latency", "ms", false, false, func(b *models.Benchmark) float64 { return b.LatencyP90 }},
+	{"latency_p99", "latency", "ms", false, false, func(b *models.Benchmark) float64 { return b.LatencyP99 }},
+	{"latency_p999", "latency", "ms", false, false, func(b *models.Benchmark) float64 { return b.LatencyP999 }},
+	{"latency_avg", "latency", "ms", false, false, func(b *models.Benchmark) float64 { return b.LatencyAvg }},
+	{"cpu_usage_avg", "resources", "%", false, false, func(b *models.Benchmark) float64 { return b.CPUUsageAvg }},
+	{"cpu_usage_max", "resources", "%", false, false, func(b *models.Benchmark) float64 { return b.CPUUsageMax }},
+	{"memory_usage_avg", "resources", "MB", false, false, func(b *models.Benchmark) float64 { return b.MemoryUsageAvg }},
+	{"memory_usage_max", "resources", "MB", false, false, func(b *models.Benchmark) float64 { return b.MemoryUsageMax }},
+	{"network_in_mbps", "resources", "Mbps", false, false, func(b *models.Benchmark) float64 { return b.NetworkInMbps }},
+	{"network_out_mbps", "resources", "Mbps", false, false, func(b *models.Benchmark) float64 { return b.NetworkOutMbps }},
+	{"disk_io_read", "resources", "MB/s", false, false, func(b *models.Benchmark) float64 { return b.DiskIORead }},
+	{"disk_io_write", "resources", "MB/s", false, false, func(b *models.Benchmark) float64 { return b.DiskIOWrite }},
+	{"prepare_phase_latency", "consensus", "ms", false, false, func(b *models.Benchmark) float64 { return b.PreparePhaseLatency }},
+	{"commit_phase_latency", "consensus", "ms", false, false, func(b *models.Benchmark) float64 { return b.CommitPhaseLatency }},
+	{"view_change_count", "consensus", "", false, true, func(b *models.Benchmark) float64 { return float64(b.ViewChangeCount) }},
+}
+
+// ComparisonValue is one benchmark's value on a dimension, relative to the
+// baseline (the first benchmark compared).
+type ComparisonValue struct {
+	BenchmarkID   string
+	Value         float64
+	AbsoluteDelta float64
+	// RelativeDelta is AbsoluteDelta as a fraction of the baseline value; it is
+	// zero when the baseline is zero.
+	RelativeDelta float64
+	Winner        bool
+}
+
+type DimensionComparison struct {
+	ComparisonDimension
+	Values []ComparisonValue
+	// WinnerID is empty when no benchmark has data or the best value is tied.
+	WinnerID string
+}
+
+type BenchmarkComparison struct {
+	Benchmarks []*models.Benchmark
+	// Recomputed lists the benchmarks whose summary was missing and was
+	// derived from raw transactions and metrics for this comparison.
+	Recomputed []string
+	Dimensions []DimensionComparison
+}
+
+type BenchmarkComparator interface {
+	// Compare aligns the benchmarks' results; the first ID is the baseline.
+	Compare(ctx context.Context, ids []string) (*BenchmarkComparison, error)
+}
+
+type benchmarkComparator struct {
+	repo      repository.BenchmarkRepository
+	finalizer BenchmarkFinalizer
+}
+
+func NewBenchmarkComparator(repo repository.BenchmarkRepository, finalizer BenchmarkFinalizer) BenchmarkComparator {
+	return &benchmarkComparator{repo: repo, finalizer: finalizer}
+}
+
+func (c *benchmarkComparator) Compare(ctx context.Context, ids []string) (*BenchmarkComparison, error) {
+	seen := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		if seen[id] {
+			return nil, ErrInvalidComparison
+		}
+		seen[id] = true
+	}
+	if len(ids) < 2 {
+		return nil, ErrInvalidComparison
+	}
+
+	result := &BenchmarkComparison{}
+	for _, id := range ids {
+		b, err := c.repo.GetByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if !hasSummary(b) {
+			if err := c.finalizer.Compute(ctx, b); err != nil {
+				return nil, err
+			}
+			result.Recomputed = append(result.Recomputed, id)
+		}
+		result.Benchmarks = append(result.Benchmarks, b)
+	}
+
+	for _, dim := range comparisonDimensions {
+		result.Dimensions = append(result.Dimensions, compareDimension(dim, result.Benchmarks))
+	}
+	return result, nil
+}
+
+func compareDimension(dim ComparisonDimension, benchmarks []*models.Benchmark) DimensionComparison {
+	cmp := DimensionComparison{ComparisonDimension: dim}
+	baseline := dim.Value(benchmarks[0])
+
+	best, winner, tied := 0.0, -1, false
+	for i, b := range benchmarks {
+		v := dim.Value(b)
+		value := ComparisonValue{
+			BenchmarkID:   b.ID.String(),
+			Value:         v,
+			AbsoluteDelta: v - baseline,
+		}
+		if baseline != 0 {
+			value.RelativeDelta = (v - baseline) / baseline
+		}
+		cmp.Values = append(cmp.Values, value)
+
+		// Unmeasured values never win.
+		if v == 0 && !dim.ZeroIsMeasured {
+			continue
+		}
+		switch {
+		case winner < 0 || better(v, best, dim.HigherIsBetter):
+			best, winner, tied = v, i, false
+		case v == best:
+			tied = true
+		}
+	}
+
+	if winner >= 0 && !tied {
+		cmp.Values[winner].Winner = true
+		cmp.WinnerID = cmp.Values[winner].BenchmarkID
+	}
+	return cmp
+}
+
+func better(v, best float64, higherIsBetter bool) bool {
+	if higherIsBetter {
+		return v > best
+	}
+	return v < best
+}
@@ -0,0 +1,66 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fffeng99999/hcp-server/internal/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompareDimension_DeltasAndWinner(t *testing.T) {
+	baseline := &models.Benchmark{ID: uuid.New(), ActualTPS: 1000, LatencyP99: 200}
+	candidate := &models.Benchmark{ID: uuid.New(), ActualTPS: 1250, LatencyP99: 250}
+	benchmarks := []*models.Benchmark{baseline, candidate}
+
+	tps := compareDimension(comparisonDimensions[0], benchmarks)
+	assert.Equal(t, "actual_tps", tps.Name)
+	assert.Equal(t, 250.0, tps.Values[1].AbsoluteDelta)
+	assert.InDelta(t, 0.25, tps.Values[1].RelativeDelta, 1e-9)
+	assert.Equal(t, candidate.ID.String(), tps.WinnerID)
+	assert.True(t, tps.Values[1].Winner)
+
+	p99 := compareDimension(comparisonDimensions[3], benchmarks)
+	assert.Equal(t, "latency_p99", p99.Name)
+	assert.Equal(t, baseline.ID.String(), p99.WinnerID)
+}
+
+func TestCompareDimension_NoDataOrTieHasNoWinner(t *testing.T) {
+	a := &models.Benchmark{ID: uuid.New(), ActualTPS: 500}
+	b := &models.Benchmark{ID: uuid.New(), ActualTPS: 500}
+
+	tie := compareDimension(comparisonDimensions[0], []*models.Benchmark{a, b})
+	assert.Empty(t, tie.WinnerID)
+
+	empty := compareDimension(comparisonDimensions[6], []*models.Benchmark{a, b})
+	assert.Empty(t, empty.WinnerID)
+	assert.Zero(t, empty.Values[1].RelativeDelta)
+}
+
+func TestCompareDimension_ZeroCountWins(t *testing.T) {
+	calm := &models.Benchmark{ID: uuid.New(), ViewChangeCount: 0}
+	unstable := &models.Benchmark{ID: uuid.New(), ViewChangeCount: 3}
+	dim := comparisonDimensions[len(comparisonDimensions)-1]
+	require.Equal(t, "view_change_count", dim.Name)
+
+	cmp := compareDimension(dim, []*models.Benchmark{unstable, calm})
+	assert.Equal(t, calm.ID.String(), cmp.WinnerID)
+
+	// Unlike latency, where zero is no data.
+	cmp = compareDimension(comparisonDimensions[3], []*models.Benchmark{{ID: uuid.New(), LatencyP99: 80}, {ID: uuid.New()}})
+	assert.Equal(t, 80.0, cmp.Values[0].Value)
+	assert.True(t, cmp.Values[0].Winner)
+}
+
+func TestBenchmarkComparator_RejectsSingleOrDuplicate(t *testing.T) {
+	cmp := NewBenchmarkComparator(new(MockBenchmarkRepository), nil)
+	id := uuid.New().String()
+
+	_, err := cmp.Compare(context.Background(), []string{id})
+	assert.ErrorIs(t, err, ErrInvalidComparison)
+
+	_, err = cmp.Compare(context.Background(), []string{id, id})
+	assert.ErrorIs(t, err, ErrInvalidComparison)
+}
@@ -0,0 +1,231 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/fffeng99999/hcp-server/internal/models"
+	"github.com/fffeng99999/hcp-server/internal/repository"
+	"go.uber.org/zap"
+)
+
+// BenchmarkFinalizer computes a benchmark's result summary from the
+// transactions and metrics recorded for it.
+type BenchmarkFinalizer interface {
+	// Finalize aggregates the recorded data and persists the summary columns.
+	Finalize(ctx context.Context, id string) (*models.Benchmark, error)
+	// Compute fills b's result fields from the recorded data without saving them.
+	Compute(ctx context.Context, b *models.Benchmark) error
+	// OnTransition finalizes runs as they reach a terminal state. It is meant
+	// to be registered with BenchmarkOrchestrator.Subscribe.
+	OnTransition(ctx context.Context, b *models.Benchmark, from string)
+}
+
+type benchmarkFinalizer struct {
+	benchmarkRepo   repository.BenchmarkRepository
+	transactionRepo repository.TransactionRepository
+	metricRepo      repository.MetricRepository
+}
+
+func NewBenchmarkFinalizer(benchmarkRepo repository.BenchmarkRepository, transactionRepo repository.TransactionRepository, metricRepo repository.MetricRepository) BenchmarkFinalizer {
+	return &benchmarkFinalizer{
+		benchmarkRepo:   benchmarkRepo,
+		transactionRepo: transactionRepo,
+		metricRepo:      metricRepo,
+	}
+}
+
+// metricSummaryFields maps reported metric names onto the benchmark fields
+// that summarise them. Fields whose metric was never reported are left as is.
+var metricSummaryFields = map[string]func(*models.Benchmark, repository.MetricAggregate){
+	"cpu_usage": func(b *models.Benchmark, a repository.MetricAggregate) {
+		b.CPUUsageAvg, b.CPUUsageMax = a.Avg, a.Max
+	},
+	"memory_usage": func(b *models.Benchmark, a repository.MetricAggregate) {
+		b.MemoryUsageAvg, b.MemoryUsageMax = a.Avg, a.Max
+	},
+	"network_in_mbps":        func(b *models.Benchmark, a repository.MetricAggregate) { b.NetworkInMbps = a.Avg },
+	"network_out_mbps":       func(b *models.Benchmark, a repository.MetricAggregate) { b.NetworkOutMbps = a.Avg },
+	"disk_io_read":           func(b *models.Benchmark, a repository.MetricAggregate) { b.DiskIORead = a.Avg },
+	"disk_io_write":          func(b *models.Benchmark, a repository.MetricAggregate) { b.DiskIOWrite = a.Avg },
+	"block_propagation_time": func(b *models.Benchmark, a repository.MetricAggregate) { b.BlockPropagationTime = a.Avg },
+	"prepare_phase_latency":  func(b *models.Benchmark, a repository.MetricAggregate) { b.PreparePhaseLatency = a.Avg },
+	"commit_phase_latency":   func(b *models.Benchmark, a repository.MetricAggregate) { b.CommitPhaseLatency = a.Avg },
+	// Nodes report a cumulative counter, so the largest value is the total.
+	"view_change_count": func(b *models.Benchmark, a repository.MetricAggregate) { b.ViewChangeCount = int(a.Max) },
+}
+
+func (f *benchmarkFinalizer) Finalize(ctx context.Context, id string) (*models.Benchmark, error) {
+	b, err := f.benchmarkRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	aggregates, err := f.compute(ctx, b)
+	if err != nil {
+		return nil, err
+	}
+	columns := resultColumns(b)
+	// Only a run that completed is held to its SLA; failed and cancelled
+	// runs are already marked as such.
+	if b.Status == models.BenchmarkStatusCompleted && len(b.Assertions) > 0 {
+		results, status := evaluateAssertions(b, aggregates)
+		encoded, err := json.Marshal(results)
+		if err != nil {
+			return nil, err
+		}
+		// Column updates given as a map bypass the model's json serializer.
+		columns["assertion_results"] = string(encoded)
+		columns["sla_status"] = status
+	}
+	if err := f.benchmarkRepo.UpdateFields(ctx, id, nil, columns); err != nil {
+		return nil, err
+	}
+	return f.benchmarkRepo.GetByID(ctx, id)
+}
+
+func (f *benchmarkFinalizer) Compute(ctx context.Context, b *models.Benchmark) error {
+	_, err := f.compute(ctx, b)
+	return err
+}
+
+// compute fills b's result fields and returns the metric aggregates over its
+// measurement window they were taken from.
+func (f *benchmarkFinalizer) compute(ctx context.Context, b *models.Benchmark) ([]repository.MetricAggregate, error) {
+	id := b.ID.String()
+	window := measurementWindow(b)
+	summary, err := f.transactionRepo.GetSummary(ctx, id, window)
+	if err != nil {
+		return nil, err
+	}
+	aggregates, err := f.metricRepo.GetBenchmarkAggregates(ctx, id, window)
+	if err != nil {
+		return nil, err
+	}
+	applySummary(b, summary, aggregates)
+	return aggregates, nil
+}
+
+func (f *benchmarkFinalizer) OnTransition(ctx context.Context, b *models.Benchmark, from string) {
+	if !b.IsTerminal() {
+		return
+	}
+	if _, err := f.Finalize(ctx, b.ID.String()); err != nil {
+		serviceLogger().Warn("Failed to compute benchmark results", zap.String("benchmark_id", b.ID.String()), zap.Error(err))
+	}
+}
+
+// measurementWindow is the part of the run whose transactions and metrics
+// count towards the results: the running phase, which leaves out warmup and
+// cooldown. A run that has not reached it is measured from where its warmup
+// ends; runs that never started are measured whole.
+func measurementWindow(b *models.Benchmark) repository.TimeWindow {
+	var window repository.TimeWindow
+	switch {
+	case b.MeasurementStartedAt != nil:
+		window.Start = *b.MeasurementStartedAt
+	case b.StartedAt != nil:
+		window.Start = b.StartedAt.Add(time.Duration(b.WarmupDuration) * time.Second)
+	}
+	if b.MeasurementEndedAt != nil {
+		window.End = *b.MeasurementEndedAt
+	}
+	return window
+}
+
+// benchmarkWindow returns the measurement window of a benchmark, or an open
+// window when data outside it is wanted too.
+func benchmarkWindow(ctx context.Context, repo repository.BenchmarkRepository, id string, includeUnmeasured bool) (repository.TimeWindow, error) {
+	if includeUnmeasured {
+		return repository.TimeWindow{}, nil
+	}
+	b, err := repo.GetByID(ctx, id)
+	if err != nil {
+		return repository.TimeWindow{}, err
+	}
+	return measurementWindow(b), nil
+}
+
+// measuredSeconds is the length of the window throughput is computed over:
+// the measurement window if the run recorded one, else the span of the
+// observed transactions, else the configured duration.
+func measuredSeconds(b *models.Benchmark, summary *repository.TransactionSummary) float64 {
+	if b.MeasurementStartedAt != nil && b.MeasurementEndedAt != nil {
+		if d := b.MeasurementEndedAt.Sub(*b.MeasurementStartedAt).Seconds(); d > 0 {
+			return d
+		}
+	}
+	if summary.FirstSubmittedAt != nil && summary.LastConfirmedAt != nil {
+		if d := summary.LastConfirmedAt.Sub(*summary.FirstSubmittedAt).Seconds(); d > 0 {
+			return d
+		}
+	}
+	return float64(b.Duration)
+}
+
+// applySummary fills a benchmark's result fields from its aggregated
+// transactions and metrics.
+func applySummary(b *models.Benchmark, summary *repository.TransactionSummary, aggregates []repository.MetricAggregate) {
+	b.ActualTPS = 0
+	if seconds := measuredSeconds(b, summary); seconds > 0 {
+		b.ActualTPS = float64(summary.ConfirmedCount) / seconds
+	}
+	b.BlockSizeAvg = 0
+	if summary.BlockCount > 0 {
+		b.BlockSizeAvg = float64(summary.ConfirmedCount) / float64(summary.BlockCount)
+	}
+
+	b.LatencyP50 = summary.LatencyP50
+	b.LatencyP90 = summary.LatencyP90
+	b.LatencyP99 = summary.LatencyP99
+	b.LatencyP999 = summary.LatencyP999
+	b.LatencyAvg = summary.LatencyAvg
+	b.LatencyMin = summary.LatencyMin
+	b.LatencyMax = summary.LatencyMax
+	b.TransactionCount = int(summary.TotalTransactions)
+	b.SuccessfulTx = int(summary.ConfirmedCount)
+	b.FailedTx = int(summary.FailedCount)
+	b.BlockCount = int(summary.BlockCount)
+
+	for _, a := range aggregates {
+		if apply, ok := metricSummaryFields[a.MetricName]; ok && a.Count > 0 {
+			apply(b, a)
+		}
+	}
+}
+
+// hasSummary reports whether results have been computed for the benchmark.
+func hasSummary(b *models.Benchmark) bool {
+	return b.TransactionCount > 0 || b.ActualTPS > 0
+}
+
+// resultColumns lists the result summary columns of a benchmark for persisting.
+func resultColumns(b *models.Benchmark) map[string]interface{} {
+	return map[string]interface{}{
+		"actual_tps":             b.ActualTPS,
+		"latency_p50":            b.LatencyP50,
+		"latency_p90":            b.LatencyP90,
+		"latency_p99":            b.LatencyP99,
+		"latency_p999":           b.LatencyP999,
+		"latency_avg":            b.LatencyAvg,
+		"latency_min":            b.LatencyMin,
+		"latency_max":            b.LatencyMax,
+		"transaction_count":      b.TransactionCount,
+		"successful_tx":          b.SuccessfulTx,
+		"failed_tx":              b.FailedTx,
+		"block_count":            b.BlockCount,
+		"block_size_avg":         b.BlockSizeAvg,
+		"block_propagation_time": b.BlockPropagationTime,
+		"cpu_usage_avg":          b.CPUUsageAvg,
+		"cpu_usage_max":          b.CPUUsageMax,
+		"memory_usage_avg":       b.MemoryUsageAvg,
+		"memory_usage_max":       b.MemoryUsageMax,
+		"network_in_mbps":        b.NetworkInMbps,
+		"network_out_mbps":       b.NetworkOutMbps,
+		"disk_io_read":           b.DiskIORead,
+		"disk_io_write":          b.DiskIOWrite,
+		"view_change_count":      b.ViewChangeCount,
+		"prepare_phase_latency":  b.PreparePhaseLatency,
+		"commit_phase_latency":   b.CommitPhaseLatency,
+	}
+}
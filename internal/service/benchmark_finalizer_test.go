@@ -0,0 +1,70 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fffeng99999/hcp-server/internal/models"
+	"github.com/fffeng99999/hcp-server/internal/repository"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplySummary_UsesMeasurementWindow(t *testing.T) {
+	start := time.Now()
+	end := start.Add(10 * time.Second)
+	b := &models.Benchmark{Duration: 60, MeasurementStartedAt: &start, MeasurementEndedAt: &end, CPUUsageAvg: 12}
+	summary := &repository.TransactionSummary{
+		TotalTransactions: 1100,
+		ConfirmedCount:    1000,
+		FailedCount:       100,
+		BlockCount:        50,
+		LatencyP99:        42.5,
+	}
+
+	applySummary(b, summary, nil)
+
+	assert.InDelta(t, 100.0, b.ActualTPS, 1e-9)
+	assert.InDelta(t, 20.0, b.BlockSizeAvg, 1e-9)
+	assert.Equal(t, 42.5, b.LatencyP99)
+	assert.Equal(t, 1000, b.SuccessfulTx)
+	assert.Equal(t, 100, b.FailedTx)
+	// Not reported during the run, so the stored value is kept.
+	assert.Equal(t, 12.0, b.CPUUsageAvg)
+}
+
+func TestApplySummary_FallsBackToDuration(t *testing.T) {
+	b := &models.Benchmark{Duration: 20}
+	summary := &repository.TransactionSummary{ConfirmedCount: 400}
+	aggregates := []repository.MetricAggregate{
+		{MetricName: "cpu_usage", Count: 3, Avg: 40, Max: 75},
+		{MetricName: "view_change_count", Count: 3, Max: 2},
+	}
+
+	applySummary(b, summary, aggregates)
+
+	assert.InDelta(t, 20.0, b.ActualTPS, 1e-9)
+	assert.Equal(t, 40.0, b.CPUUsageAvg)
+	assert.Equal(t, 75.0, b.CPUUsageMax)
+	assert.Equal(t, 2, b.ViewChangeCount)
+	assert.True(t, hasSummary(b))
+}
+
+func TestMeasurementWindow_LeavesOutWarmupAndCooldown(t *testing.T) {
+	started := time.Date(2026, 5, 1, 10, 0, 0, 0, time.UTC)
+	b := &models.Benchmark{WarmupDuration: 30, Duration: 60, CooldownDuration: 15, StartedAt: &started}
+
+	// Still warming up: only what comes after the warmup will count.
+	window := measurementWindow(b)
+	assert.Equal(t, started.Add(30*time.Second), window.Start)
+	assert.True(t, window.End.IsZero())
+
+	measuring := started.Add(31 * time.Second)
+	cooling := measuring.Add(60 * time.Second)
+	b.MeasurementStartedAt, b.MeasurementEndedAt = &measuring, &cooling
+	window = measurementWindow(b)
+	assert.Equal(t, measuring, window.Start)
+	assert.Equal(t, cooling, window.End)
+
+	// Queued runs have nothing to leave out.
+	assert.Equal(t, repository.TimeWindow{}, measurementWindow(&models.Benchmark{WarmupDuration: 30}))
+}
@@ -0,0 +1,61 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/fffeng99999/hcp-server/internal/models"
+)
+
+var ErrInvalidTransition = errors.New("invalid benchmark status transition")
+
+// benchmarkTransitions lists the legal next states for every benchmark status.
+// Terminal states have no outgoing edges.
+var benchmarkTransitions = map[string][]string{
+	models.BenchmarkStatusQueued: {
+		models.BenchmarkStatusProvisioning,
+		models.BenchmarkStatusFailed,
+		models.BenchmarkStatusCancelled,
+	},
+	models.BenchmarkStatusProvisioning: {
+		models.BenchmarkStatusWarmup,
+		models.BenchmarkStatusFailed,
+		models.BenchmarkStatusCancelled,
+	},
+	models.BenchmarkStatusWarmup: {
+		models.BenchmarkStatusRunning,
+		models.BenchmarkStatusFailed,
+		models.BenchmarkStatusCancelled,
+	},
+	models.BenchmarkStatusRunning: {
+		models.BenchmarkStatusCooldown,
+		models.BenchmarkStatusFailed,
+		models.BenchmarkStatusCancelled,
+	},
+	models.BenchmarkStatusCooldown: {
+		models.BenchmarkStatusCompleted,
+		models.BenchmarkStatusFailed,
+		models.BenchmarkStatusCancelled,
+	},
+}
+
+// activeBenchmarkStatuses are the states in which a run holds resources and
+// is subject to the duration timeout.
+var activeBenchmarkStatuses = models.ActiveBenchmarkStatuses
+
+// CanTransition reports whether a benchmark may move from one status to another.
+func CanTransition(from, to string) bool {
+	for _, next := range benchmarkTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+func validateTransition(from, to string) error {
+	if !CanTransition(from, to) {
+		return fmt.Errorf("%w: %s -> %s", ErrInvalidTransition, from, to)
+	}
+	return nil
+}
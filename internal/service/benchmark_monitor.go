@@ -0,0 +1,398 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/fffeng99999/hcp-server/internal/models"
+	"github.com/fffeng99999/hcp-server/internal/repository"
+	"github.com/fffeng99999/hcp-server/internal/stats"
+)
+
+const (
+	defaultWatchInterval = time.Second
+	minWatchInterval     = 100 * time.Millisecond
+	defaultWatchWindow   = 10 * time.Second
+	// maxWatchWindow bounds how much confirmation history is kept per run.
+	maxWatchWindow = time.Minute
+	// nodeStaleAfter is how long a node may go without reporting a metric
+	// before it is reported as stale.
+	nodeStaleAfter = 10 * time.Second
+)
+
+const (
+	NodeHealthHealthy = "healthy"
+	NodeHealthStale   = "stale"
+)
+
+// WatchOptions control a progress stream. Zero values select the defaults.
+type WatchOptions struct {
+	// Interval between frames; transitions are pushed immediately.
+	Interval time.Duration
+	// Window the rolling TPS and latency percentiles are computed over.
+	Window time.Duration
+}
+
+// ProgressFrame is a snapshot of a benchmark's progress.
+type ProgressFrame struct {
+	BenchmarkID string
+	Status      string
+	Time        time.Time
+	Elapsed     time.Duration
+	Window      time.Duration
+	RollingTPS  float64
+	LatencyP50  float64
+	LatencyP99  float64
+	Pending     int64
+	Confirmed   int64
+	Failed      int64
+	Nodes       []NodeHealth
+	// Transitions lists the status changes since the previous frame.
+	Transitions []StatusTransition
+}
+
+type NodeHealth struct {
+	NodeID     string
+	Health     string
+	LastSeenAt time.Time
+	// Metrics holds the latest value of every metric the node reported.
+	Metrics map[string]float64
+}
+
+type StatusTransition struct {
+	From string
+	To   string
+	At   time.Time
+}
+
+// BenchmarkMonitor keeps live progress for watched benchmarks in memory,
+// updated as transactions, metrics and status transitions come in, so
+// watching a run does not re-query the transactions and metrics tables. A run
+// is loaded from the database when its first watcher arrives and forgotten
+// when its last one leaves.
+type BenchmarkMonitor interface {
+	// Watch streams progress frames for a benchmark until it reaches a
+	// terminal state or ctx is done. The channel is closed at the end.
+	Watch(ctx context.Context, id string, opts WatchOptions) (<-chan ProgressFrame, error)
+	// ObserveTransaction records a stored transaction. previousStatus is the
+	// status it had before this change, or empty for a new transaction.
+	ObserveTransaction(tx *models.Transaction, previousStatus string)
+	// ObserveStatusChange records count transactions of a benchmark moving
+	// from one status to another in bulk.
+	ObserveStatusChange(benchmarkID, from, to string, count int64)
+	ObserveMetric(m *models.Metric)
+	// OnTransition records status changes. It is meant to be registered with
+	// BenchmarkOrchestrator.Subscribe.
+	OnTransition(ctx context.Context, b *models.Benchmark, from string)
+}
+
+type benchmarkMonitor struct {
+	benchmarkRepo   repository.BenchmarkRepository
+	transactionRepo repository.TransactionRepository
+	now             func() time.Time
+
+	mu   sync.Mutex
+	runs map[string]*liveRun
+}
+
+func NewBenchmarkMonitor(benchmarkRepo repository.BenchmarkRepository, transactionRepo repository.TransactionRepository) BenchmarkMonitor {
+	return &benchmarkMonitor{
+		benchmarkRepo:   benchmarkRepo,
+		transactionRepo: transactionRepo,
+		now:             time.Now,
+		runs:            make(map[string]*liveRun),
+	}
+}
+
+// liveRun is the in-memory progress of one benchmark.
+type liveRun struct {
+	id string
+
+	mu        sync.Mutex
+	seeded    bool
+	benchmark models.Benchmark
+	pending   int64
+	confirmed int64
+	failed    int64
+	// confirmations is ordered by observation time and trimmed to
+	// maxWatchWindow.
+	confirmations []confirmation
+	nodes         map[string]*NodeHealth
+	transitions   []StatusTransition
+	// changed is closed and replaced on every transition to wake watchers.
+	changed  chan struct{}
+	watchers int
+}
+
+type confirmation struct {
+	at        time.Time
+	latencyMs float64
+}
+
+func newLiveRun(id string) *liveRun {
+	return &liveRun{
+		id:      id,
+		nodes:   make(map[string]*NodeHealth),
+		changed: make(chan struct{}),
+	}
+}
+
+func (m *benchmarkMonitor) Watch(ctx context.Context, id string, opts WatchOptions) (<-chan ProgressFrame, error) {
+	if opts.Interval <= 0 {
+		opts.Interval = defaultWatchInterval
+	}
+	if opts.Interval < minWatchInterval {
+		opts.Interval = minWatchInterval
+	}
+	if opts.Window <= 0 {
+		opts.Window = defaultWatchWindow
+	}
+	if opts.Window > maxWatchWindow {
+		opts.Window = maxWatchWindow
+	}
+
+	run := m.attach(id)
+	if err := m.seed(ctx, run); err != nil {
+		m.detach(run)
+		return nil, err
+	}
+
+	frames := make(chan ProgressFrame)
+	go m.stream(ctx, run, opts, frames)
+	return frames, nil
+}
+
+// attach returns the live run for id, registering it if needed, and counts
+// the caller as a watcher.
+func (m *benchmarkMonitor) attach(id string) *liveRun {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	run, ok := m.runs[id]
+	if !ok {
+		run = newLiveRun(id)
+		m.runs[id] = run
+	}
+	run.mu.Lock()
+	run.watchers++
+	run.mu.Unlock()
+	return run
+}
+
+// detach drops a watcher and forgets the run once nobody watches it.
+func (m *benchmarkMonitor) detach(run *liveRun) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	run.mu.Lock()
+	defer run.mu.Unlock()
+	run.watchers--
+	if run.watchers == 0 && m.runs[run.id] == run {
+		delete(m.runs, run.id)
+	}
+}
+
+// seed loads the state of a newly watched run. The run is registered before
+// the database is read so no transition can fall between the two;
+// transactions stored while seeding may be counted twice.
+func (m *benchmarkMonitor) seed(ctx context.Context, run *liveRun) error {
+	run.mu.Lock()
+	defer run.mu.Unlock()
+	if run.seeded {
+		return nil
+	}
+	b, err := m.benchmarkRepo.GetByID(ctx, run.id)
+	if err != nil {
+		return err
+	}
+	// Live progress covers the whole run, warmup and cooldown included.
+	counts, err := m.transactionRepo.GetStats(ctx, run.id, repository.TimeWindow{})
+	if err != nil {
+		return err
+	}
+	if run.benchmark.Status == "" {
+		run.benchmark = *b
+	}
+	run.pending += counts.PendingCount
+	run.confirmed += counts.ConfirmedCount
+	run.failed += counts.FailedCount
+	run.seeded = true
+	return nil
+}
+
+func (m *benchmarkMonitor) stream(ctx context.Context, run *liveRun, opts WatchOptions, out chan<- ProgressFrame) {
+	defer close(out)
+	defer m.detach(run)
+
+	ticker := time.NewTicker(opts.Interval)
+	defer ticker.Stop()
+
+	run.mu.Lock()
+	seen := len(run.transitions)
+	run.mu.Unlock()
+
+	for {
+		frame, changed, done := run.frame(m.now(), opts.Window, &seen)
+		select {
+		case out <- frame:
+		case <-ctx.Done():
+			return
+		}
+		if done {
+			return
+		}
+		select {
+		case <-ticker.C:
+		case <-changed:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// frame snapshots the run. seen is the number of transitions already sent and
+// is advanced past the ones included.
+func (r *liveRun) frame(now time.Time, window time.Duration, seen *int) (ProgressFrame, <-chan struct{}, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	b := &r.benchmark
+	f := ProgressFrame{
+		BenchmarkID: r.id,
+		Status:      b.Status,
+		Time:        now,
+		Window:      window,
+		Pending:     r.pending,
+		Confirmed:   r.confirmed,
+		Failed:      r.failed,
+		Transitions: append([]StatusTransition(nil), r.transitions[*seen:]...),
+	}
+	*seen = len(r.transitions)
+
+	end := now
+	if b.CompletedAt != nil {
+		end = *b.CompletedAt
+	}
+	if b.StartedAt != nil {
+		f.Elapsed = end.Sub(*b.StartedAt)
+	}
+
+	// A run younger than the window is measured over its age.
+	span := window
+	if f.Elapsed > 0 && f.Elapsed < span {
+		span = f.Elapsed
+	}
+	var latencies []float64
+	since := end.Add(-span)
+	for i := len(r.confirmations) - 1; i >= 0 && r.confirmations[i].at.After(since); i-- {
+		latencies = append(latencies, r.confirmations[i].latencyMs)
+	}
+	f.RollingTPS = float64(len(latencies)) / span.Seconds()
+	f.LatencyP50 = stats.Quantile(latencies, 0.5)
+	f.LatencyP99 = stats.P99(latencies)
+
+	for _, n := range r.nodes {
+		health := *n
+		health.Health = NodeHealthHealthy
+		if now.Sub(n.LastSeenAt) > nodeStaleAfter {
+			health.Health = NodeHealthStale
+		}
+		health.Metrics = make(map[string]float64, len(n.Metrics))
+		for k, v := range n.Metrics {
+			health.Metrics[k] = v
+		}
+		f.Nodes = append(f.Nodes, health)
+	}
+	sort.Slice(f.Nodes, func(i, j int) bool { return f.Nodes[i].NodeID < f.Nodes[j].NodeID })
+
+	return f, r.changed, b.IsTerminal()
+}
+
+// lookup returns the live run for a benchmark, or nil if it is not watched.
+func (m *benchmarkMonitor) lookup(id string) *liveRun {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.runs[id]
+}
+
+func (m *benchmarkMonitor) ObserveTransaction(tx *models.Transaction, previousStatus string) {
+	run := m.lookup(tx.BenchmarkID.String())
+	if run == nil || tx.Status == previousStatus {
+		return
+	}
+	now := m.now()
+
+	run.mu.Lock()
+	defer run.mu.Unlock()
+	*run.counter(previousStatus)--
+	*run.counter(tx.Status)++
+	if tx.Status != models.TransactionStatusConfirmed {
+		return
+	}
+	run.confirmations = append(run.confirmations, confirmation{at: now, latencyMs: tx.LatencyMs})
+	cutoff := now.Add(-maxWatchWindow)
+	i := 0
+	for i < len(run.confirmations) && !run.confirmations[i].at.After(cutoff) {
+		i++
+	}
+	run.confirmations = run.confirmations[i:]
+}
+
+func (m *benchmarkMonitor) ObserveStatusChange(benchmarkID, from, to string, count int64) {
+	run := m.lookup(benchmarkID)
+	if run == nil || from == to {
+		return
+	}
+
+	run.mu.Lock()
+	defer run.mu.Unlock()
+	*run.counter(from) -= count
+	*run.counter(to) += count
+}
+
+// counter returns the count kept for a transaction status. Unknown and empty
+// statuses share a scratch counter.
+func (r *liveRun) counter(status string) *int64 {
+	switch status {
+	case models.TransactionStatusPending:
+		return &r.pending
+	case models.TransactionStatusConfirmed:
+		return &r.confirmed
+	case models.TransactionStatusFailed:
+		return &r.failed
+	}
+	return new(int64)
+}
+
+func (m *benchmarkMonitor) ObserveMetric(metric *models.Metric) {
+	run := m.lookup(metric.BenchmarkID.String())
+	if run == nil {
+		return
+	}
+
+	run.mu.Lock()
+	defer run.mu.Unlock()
+	n, ok := run.nodes[metric.NodeID]
+	if !ok {
+		n = &NodeHealth{NodeID: metric.NodeID, Metrics: make(map[string]float64)}
+		run.nodes[metric.NodeID] = n
+	}
+	if metric.Timestamp.After(n.LastSeenAt) {
+		n.LastSeenAt = metric.Timestamp
+	}
+	n.Metrics[metric.MetricName] = metric.MetricValue
+}
+
+func (m *benchmarkMonitor) OnTransition(ctx context.Context, b *models.Benchmark, from string) {
+	run := m.lookup(b.ID.String())
+	if run == nil {
+		return
+	}
+
+	run.mu.Lock()
+	defer run.mu.Unlock()
+	run.benchmark = *b
+	run.transitions = append(run.transitions, StatusTransition{From: from, To: b.Status, At: m.now()})
+	close(run.changed)
+	run.changed = make(chan struct{})
+}
@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fffeng99999/hcp-server/internal/models"
+	"github.com/fffeng99999/hcp-server/internal/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBenchmarkMonitor_Watch(t *testing.T) {
+	start := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	now := start
+	benchmarkRepo := new(MockBenchmarkRepository)
+	transactionRepo := new(MockTransactionRepository)
+	m := NewBenchmarkMonitor(benchmarkRepo, transactionRepo).(*benchmarkMonitor)
+	m.now = func() time.Time { return now }
+
+	ctx := context.Background()
+	id := uuid.New()
+	b := &models.Benchmark{ID: id, Status: models.BenchmarkStatusProvisioning, StartedAt: &start}
+	benchmarkRepo.On("GetByID", ctx, id.String()).Return(b, nil).Once()
+	transactionRepo.On("GetStats", ctx, id.String(), repository.TimeWindow{}).
+		Return(&repository.TransactionStats{PendingCount: 4}, nil).Once()
+
+	// Runs nobody watches are not kept.
+	other := uuid.New()
+	m.OnTransition(ctx, &models.Benchmark{ID: other, Status: models.BenchmarkStatusProvisioning}, models.BenchmarkStatusQueued)
+	m.ObserveTransaction(&models.Transaction{BenchmarkID: other, Status: models.TransactionStatusPending}, "")
+	assert.Nil(t, m.lookup(other.String()))
+
+	frames, err := m.Watch(ctx, id.String(), WatchOptions{Interval: time.Hour})
+	require.NoError(t, err)
+
+	f := <-frames
+	assert.Equal(t, models.BenchmarkStatusProvisioning, f.Status)
+	assert.Equal(t, int64(4), f.Pending, "counts are seeded from the database")
+
+	now = start.Add(5 * time.Second)
+	for _, latency := range []float64{10, 20, 30} {
+		tx := &models.Transaction{BenchmarkID: id, Status: models.TransactionStatusConfirmed, LatencyMs: latency}
+		m.ObserveTransaction(tx, models.TransactionStatusPending)
+	}
+	m.ObserveMetric(&models.Metric{BenchmarkID: id, NodeID: "node-2", MetricName: "cpu_usage", MetricValue: 40, Timestamp: now})
+	m.ObserveMetric(&models.Metric{BenchmarkID: id, NodeID: "node-1", MetricName: "cpu_usage", MetricValue: 55, Timestamp: start})
+	warmup := *b
+	warmup.Status = models.BenchmarkStatusWarmup
+	m.OnTransition(ctx, &warmup, models.BenchmarkStatusProvisioning)
+
+	f = <-frames
+	assert.Equal(t, models.BenchmarkStatusWarmup, f.Status)
+	assert.Equal(t, 5*time.Second, f.Elapsed)
+	assert.Equal(t, int64(1), f.Pending)
+	assert.Equal(t, int64(3), f.Confirmed)
+	// The run is younger than the window, so TPS is over its age.
+	assert.InDelta(t, 0.6, f.RollingTPS, 1e-9)
+	assert.Equal(t, 20.0, f.LatencyP50)
+	assert.Equal(t, []StatusTransition{{From: models.BenchmarkStatusProvisioning, To: models.BenchmarkStatusWarmup, At: now}}, f.Transitions)
+	require.Len(t, f.Nodes, 2)
+	assert.Equal(t, "node-1", f.Nodes[0].NodeID)
+	assert.Equal(t, NodeHealthHealthy, f.Nodes[0].Health)
+
+	now = start.Add(20 * time.Second)
+	completed := now
+	done := &models.Benchmark{ID: id, Status: models.BenchmarkStatusCompleted, StartedAt: &start, CompletedAt: &completed}
+	m.OnTransition(ctx, done, models.BenchmarkStatusCooldown)
+
+	f = <-frames
+	assert.Equal(t, models.BenchmarkStatusCompleted, f.Status)
+	assert.Equal(t, 20*time.Second, f.Elapsed)
+	assert.Equal(t, []StatusTransition{{From: models.BenchmarkStatusCooldown, To: models.BenchmarkStatusCompleted, At: now}}, f.Transitions)
+	assert.Equal(t, NodeHealthStale, f.Nodes[0].Health)
+
+	_, open := <-frames
+	assert.False(t, open)
+	assert.Nil(t, m.lookup(id.String()), "runs are forgotten once unwatched")
+}
+
+func TestBenchmarkMonitor_ForgetsRunWhenWatcherLeaves(t *testing.T) {
+	benchmarkRepo := new(MockBenchmarkRepository)
+	transactionRepo := new(MockTransactionRepository)
+	m := NewBenchmarkMonitor(benchmarkRepo, transactionRepo).(*benchmarkMonitor)
+
+	id := uuid.New()
+	benchmarkRepo.On("GetByID", mock.Anything, id.String()).Return(&models.Benchmark{ID: id, Status: models.BenchmarkStatusRunning}, nil)
+	transactionRepo.On("GetStats", mock.Anything, id.String(), repository.TimeWindow{}).Return(&repository.TransactionStats{}, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	frames, err := m.Watch(ctx, id.String(), WatchOptions{Interval: time.Hour})
+	require.NoError(t, err)
+	<-frames
+	cancel()
+	for range frames {
+	}
+	assert.Nil(t, m.lookup(id.String()), "a run orphaned mid-flight is not kept")
+}
@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/fffeng99999/hcp-server/internal/models"
+	"github.com/fffeng99999/hcp-server/internal/repository"
+	"github.com/google/uuid"
+)
+
+var ErrInvalidMembership = errors.New("invalid benchmark node membership")
+
+// BenchmarkNodeService tracks which nodes took part in a benchmark run.
+type BenchmarkNodeService interface {
+	// Record stores join/leave reports for a run's nodes. A report may leave
+	// the role or final status empty to keep what was recorded before; new
+	// memberships need a role and start out active.
+	Record(ctx context.Context, benchmarkID string, memberships []models.BenchmarkNode) ([]models.BenchmarkNode, error)
+	List(ctx context.Context, benchmarkID string, filter repository.BenchmarkNodeFilter) ([]models.BenchmarkNode, error)
+}
+
+type benchmarkNodeService struct {
+	benchmarkRepo repository.BenchmarkRepository
+	repo          repository.BenchmarkNodeRepository
+}
+
+func NewBenchmarkNodeService(benchmarkRepo repository.BenchmarkRepository, repo repository.BenchmarkNodeRepository) BenchmarkNodeService {
+	return &benchmarkNodeService{benchmarkRepo: benchmarkRepo, repo: repo}
+}
+
+func (s *benchmarkNodeService) Record(ctx context.Context, benchmarkID string, memberships []models.BenchmarkNode) ([]models.BenchmarkNode, error) {
+	b, err := s.benchmarkRepo.GetByID(ctx, benchmarkID)
+	if err != nil {
+		return nil, err
+	}
+	existing, err := s.repo.ListByBenchmark(ctx, benchmarkID, repository.BenchmarkNodeFilter{})
+	if err != nil {
+		return nil, err
+	}
+	if err := mergeMemberships(b.ID, memberships, existing); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Upsert(ctx, memberships); err != nil {
+		return nil, err
+	}
+	return s.repo.ListByBenchmark(ctx, benchmarkID, repository.BenchmarkNodeFilter{})
+}
+
+func (s *benchmarkNodeService) List(ctx context.Context, benchmarkID string, filter repository.BenchmarkNodeFilter) ([]models.BenchmarkNode, error) {
+	if _, err := s.benchmarkRepo.GetByID(ctx, benchmarkID); err != nil {
+		return nil, err
+	}
+	return s.repo.ListByBenchmark(ctx, benchmarkID, filter)
+}
+
+// mergeMemberships validates the reports and fills in the role and final
+// status they leave empty from the recorded memberships.
+func mergeMemberships(benchmarkID uuid.UUID, reports, existing []models.BenchmarkNode) error {
+	recorded := make(map[string]models.BenchmarkNode, len(existing))
+	for _, m := range existing {
+		recorded[m.NodeID] = m
+	}
+
+	seen := make(map[string]bool, len(reports))
+	for i := range reports {
+		m := &reports[i]
+		if m.NodeID == "" {
+			return fmt.Errorf("%w: node_id is required", ErrInvalidMembership)
+		}
+		if seen[m.NodeID] {
+			return fmt.Errorf("%w: node %s reported twice", ErrInvalidMembership, m.NodeID)
+		}
+		seen[m.NodeID] = true
+		m.BenchmarkID = benchmarkID
+
+		prev, ok := recorded[m.NodeID]
+		if m.Role == "" {
+			if !ok {
+				return fmt.Errorf("%w: node %s needs a role", ErrInvalidMembership, m.NodeID)
+			}
+			m.Role = prev.Role
+		}
+		if m.FinalStatus == "" {
+			m.FinalStatus = models.BenchmarkNodeStatusActive
+			if ok {
+				m.FinalStatus = prev.FinalStatus
+			}
+		}
+
+		switch m.Role {
+		case models.BenchmarkNodeRoleLeader, models.BenchmarkNodeRoleValidator, models.BenchmarkNodeRoleObserver:
+		default:
+			return fmt.Errorf("%w: unknown role %q", ErrInvalidMembership, m.Role)
+		}
+		switch m.FinalStatus {
+		case models.BenchmarkNodeStatusActive, models.BenchmarkNodeStatusHealthy, models.BenchmarkNodeStatusCrashed,
+			models.BenchmarkNodeStatusByzantine, models.BenchmarkNodeStatusPartitioned:
+		default:
+			return fmt.Errorf("%w: unknown final status %q", ErrInvalidMembership, m.FinalStatus)
+		}
+		// Join and leave are usually reported separately, so compare with the
+		// times recorded before as well.
+		joinedAt, leftAt := m.JoinedAt, m.LeftAt
+		if joinedAt == nil && ok {
+			joinedAt = prev.JoinedAt
+		}
+		if leftAt == nil && ok {
+			leftAt = prev.LeftAt
+		}
+		if joinedAt != nil && leftAt != nil && leftAt.Before(*joinedAt) {
+			return fmt.Errorf("%w: node %s left before it joined", ErrInvalidMembership, m.NodeID)
+		}
+	}
+	return nil
+}
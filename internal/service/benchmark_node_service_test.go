@@ -0,0 +1,51 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fffeng99999/hcp-server/internal/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeMemberships_KeepsRecordedRoleAndStatus(t *testing.T) {
+	id := uuid.New()
+	existing := []models.BenchmarkNode{
+		{BenchmarkID: id, NodeID: "node-1", Role: models.BenchmarkNodeRoleLeader, FinalStatus: models.BenchmarkNodeStatusActive},
+	}
+	left := time.Now()
+	reports := []models.BenchmarkNode{
+		{NodeID: "node-1", FinalStatus: models.BenchmarkNodeStatusCrashed, LeftAt: &left},
+		{NodeID: "node-2", Role: models.BenchmarkNodeRoleValidator},
+	}
+
+	require.NoError(t, mergeMemberships(id, reports, existing))
+	assert.Equal(t, models.BenchmarkNodeRoleLeader, reports[0].Role)
+	assert.True(t, reports[0].Faulty())
+	assert.Equal(t, id, reports[1].BenchmarkID)
+	assert.Equal(t, models.BenchmarkNodeStatusActive, reports[1].FinalStatus)
+	assert.False(t, reports[1].Faulty())
+}
+
+func TestMergeMemberships_Rejects(t *testing.T) {
+	id := uuid.New()
+	joined := time.Now()
+	left := joined.Add(-time.Minute)
+
+	cases := map[string][]models.BenchmarkNode{
+		"new node without role": {{NodeID: "node-1"}},
+		"unknown role":          {{NodeID: "node-1", Role: "follower"}},
+		"unknown status":        {{NodeID: "node-1", Role: "validator", FinalStatus: "offline"}},
+		"duplicate node":        {{NodeID: "node-1", Role: "validator"}, {NodeID: "node-1", Role: "observer"}},
+		"left before joined":    {{NodeID: "node-1", Role: "validator", JoinedAt: &joined, LeftAt: &left}},
+	}
+	for name, reports := range cases {
+		assert.ErrorIs(t, mergeMemberships(id, reports, nil), ErrInvalidMembership, name)
+	}
+
+	existing := []models.BenchmarkNode{{NodeID: "node-1", Role: "validator", JoinedAt: &joined}}
+	err := mergeMemberships(id, []models.BenchmarkNode{{NodeID: "node-1", LeftAt: &left}}, existing)
+	assert.ErrorIs(t, err, ErrInvalidMembership, "leave reported later, before the recorded join")
+}
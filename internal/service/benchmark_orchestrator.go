@@ -0,0 +1,344 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/fffeng99999/hcp-server/internal/config"
+	"github.com/fffeng99999/hcp-server/internal/models"
+	"github.com/fffeng99999/hcp-server/internal/repository"
+	"github.com/fffeng99999/hcp-server/internal/utils"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultTimeoutGrace  = time.Minute
+	defaultSweepInterval = 15 * time.Second
+)
+
+// TransitionListener is notified after a benchmark has been moved to a new
+// status. b reflects the persisted state; from is the previous status.
+type TransitionListener func(ctx context.Context, b *models.Benchmark, from string)
+
+// BenchmarkOrchestrator drives benchmarks through their lifecycle.
+type BenchmarkOrchestrator interface {
+	// Start takes a queued benchmark and runs it through provisioning, warmup,
+	// running and cooldown in the background until it completes.
+	Start(ctx context.Context, id string) error
+	// Transition moves a benchmark to the given status if the move is legal.
+	// reason is recorded as the error message for failed and cancelled runs.
+	Transition(ctx context.Context, id, to, reason string) (*models.Benchmark, error)
+	// Cancel moves a benchmark that has not finished yet to cancelled,
+	// recording who cancelled it and why, and stops its run.
+	Cancel(ctx context.Context, id, by, reason string) (*models.Benchmark, error)
+	// Stop ends a benchmark that has not finished yet as failed or cancelled
+	// and writes req.Fields in the same statement, so either all of it is
+	// applied or none of it.
+	Stop(ctx context.Context, req StopRequest) (*models.Benchmark, error)
+	// Subscribe registers a listener for every successful transition.
+	Subscribe(l TransitionListener)
+	// Run sweeps for runs that exceeded their duration until ctx is done.
+	Run(ctx context.Context)
+}
+
+// StopRequest describes a run ended early through Stop.
+type StopRequest struct {
+	ID string
+	// Status is BenchmarkStatusFailed or BenchmarkStatusCancelled.
+	Status string
+	// CancelledBy is recorded for cancellations as Cancel does. Reason is
+	// recorded as the error message.
+	CancelledBy string
+	Reason      string
+	// Fields are further column updates; they cannot include the status.
+	Fields map[string]interface{}
+	// ExpectedUpdatedAt, when set, makes Stop fail with
+	// repository.ErrConflict if the benchmark was modified since.
+	ExpectedUpdatedAt *time.Time
+}
+
+type benchmarkOrchestrator struct {
+	repo   repository.BenchmarkRepository
+	cfg    config.BenchmarkConfig
+	logger *zap.Logger
+	now    func() time.Time
+
+	mu        sync.Mutex
+	runs      map[string]context.CancelFunc
+	listeners []TransitionListener
+}
+
+func NewBenchmarkOrchestrator(repo repository.BenchmarkRepository, cfg config.BenchmarkConfig) BenchmarkOrchestrator {
+	if cfg.TimeoutGrace <= 0 {
+		cfg.TimeoutGrace = defaultTimeoutGrace
+	}
+	if cfg.SweepInterval <= 0 {
+		cfg.SweepInterval = defaultSweepInterval
+	}
+	return &benchmarkOrchestrator{
+		repo:   repo,
+		cfg:    cfg,
+		logger: serviceLogger(),
+		now:    time.Now,
+		runs:   make(map[string]context.CancelFunc),
+	}
+}
+
+func (o *benchmarkOrchestrator) Subscribe(l TransitionListener) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.listeners = append(o.listeners, l)
+}
+
+func (o *benchmarkOrchestrator) Start(ctx context.Context, id string) error {
+	b, err := o.Transition(ctx, id, models.BenchmarkStatusProvisioning, "")
+	if err != nil {
+		return err
+	}
+
+	// The run outlives the request that started it.
+	runCtx, cancel := context.WithCancel(context.Background())
+	o.mu.Lock()
+	o.runs[id] = cancel
+	o.mu.Unlock()
+
+	go o.drive(runCtx, b)
+	return nil
+}
+
+func (o *benchmarkOrchestrator) Transition(ctx context.Context, id, to, reason string) (*models.Benchmark, error) {
+	return o.transition(ctx, id, to, reason, nil, nil)
+}
+
+// transition moves a benchmark to status to, writing fields along with the
+// lifecycle columns.
+func (o *benchmarkOrchestrator) transition(ctx context.Context, id, to, reason string, fields map[string]interface{}, expectedUpdatedAt *time.Time) (*models.Benchmark, error) {
+	b, err := o.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if expectedUpdatedAt != nil && !b.UpdatedAt.Equal(*expectedUpdatedAt) {
+		return nil, repository.ErrConflict
+	}
+	from := b.Status
+	if err := validateTransition(from, to); err != nil {
+		return nil, err
+	}
+
+	now := o.now()
+	updates := make(map[string]interface{}, len(fields))
+	for k, v := range fields {
+		updates[k] = v
+	}
+	switch to {
+	case models.BenchmarkStatusProvisioning:
+		updates["started_at"] = now
+		b.StartedAt = &now
+	case models.BenchmarkStatusRunning:
+		updates["measurement_started_at"] = now
+		b.MeasurementStartedAt = &now
+	case models.BenchmarkStatusCooldown:
+		updates["measurement_ended_at"] = now
+		b.MeasurementEndedAt = &now
+	}
+	switch to {
+	case models.BenchmarkStatusCompleted, models.BenchmarkStatusFailed, models.BenchmarkStatusCancelled:
+		updates["completed_at"] = now
+		b.CompletedAt = &now
+		if reason != "" {
+			updates["error_message"] = reason
+			b.ErrorMessage = reason
+		}
+		// A run aborted mid-measurement still has a usable window.
+		if b.MeasurementStartedAt != nil && b.MeasurementEndedAt == nil {
+			updates["measurement_ended_at"] = now
+			b.MeasurementEndedAt = &now
+		}
+	}
+
+	if err := o.repo.UpdateStatus(ctx, id, from, to, expectedUpdatedAt, updates); err != nil {
+		// A caller holding a version gets the stale write reported as such.
+		if errors.Is(err, repository.ErrConflict) && expectedUpdatedAt == nil {
+			return nil, fmt.Errorf("%w: %s changed concurrently", ErrInvalidTransition, id)
+		}
+		return nil, err
+	}
+	b.Status = to
+	if len(fields) > 0 {
+		if b, err = o.repo.GetByID(ctx, id); err != nil {
+			return nil, err
+		}
+	}
+
+	o.notify(ctx, b, from)
+	if b.IsTerminal() {
+		o.release(id)
+	}
+	return b, nil
+}
+
+func (o *benchmarkOrchestrator) Cancel(ctx context.Context, id, by, reason string) (*models.Benchmark, error) {
+	return o.Stop(ctx, StopRequest{ID: id, Status: models.BenchmarkStatusCancelled, CancelledBy: by, Reason: reason})
+}
+
+func (o *benchmarkOrchestrator) Stop(ctx context.Context, req StopRequest) (*models.Benchmark, error) {
+	reason := req.Reason
+	switch req.Status {
+	case models.BenchmarkStatusFailed:
+	case models.BenchmarkStatusCancelled:
+		reason = cancellationMessage(req.CancelledBy, req.Reason)
+	default:
+		return nil, fmt.Errorf("%w: a run can only be stopped as failed or cancelled, not %s", ErrInvalidTransition, req.Status)
+	}
+	if _, ok := req.Fields["status"]; ok {
+		return nil, errors.New("status cannot be updated directly")
+	}
+	return o.transition(ctx, req.ID, req.Status, reason, req.Fields, req.ExpectedUpdatedAt)
+}
+
+// cancellationMessage is the error message recorded for a cancelled run.
+func cancellationMessage(by, reason string) string {
+	msg := "cancelled by " + by
+	if reason != "" {
+		msg += ": " + reason
+	}
+	return msg
+}
+
+func (o *benchmarkOrchestrator) Run(ctx context.Context) {
+	ticker := time.NewTicker(o.cfg.SweepInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			o.mu.Lock()
+			for id, cancel := range o.runs {
+				cancel()
+				delete(o.runs, id)
+			}
+			o.mu.Unlock()
+			return
+		case <-ticker.C:
+			o.sweep(ctx)
+		}
+	}
+}
+
+// sweep fails every active run whose deadline has passed. This also catches
+// runs orphaned by a server restart, which no longer have a driver goroutine.
+func (o *benchmarkOrchestrator) sweep(ctx context.Context) {
+	benchmarks, err := o.repo.ListByStatus(ctx, activeBenchmarkStatuses...)
+	if err != nil {
+		o.logger.Warn("Failed to list active benchmarks", zap.Error(err))
+		return
+	}
+
+	now := o.now()
+	for i := range benchmarks {
+		b := &benchmarks[i]
+		if b.StartedAt == nil || now.Before(o.deadline(b)) {
+			continue
+		}
+		reason := fmt.Sprintf("timed out: exceeded duration of %ds", totalDuration(b))
+		if _, err := o.Transition(ctx, b.ID.String(), models.BenchmarkStatusFailed, reason); err != nil {
+			o.logger.Warn("Failed to time out benchmark", zap.String("benchmark_id", b.ID.String()), zap.Error(err))
+		}
+	}
+}
+
+func (o *benchmarkOrchestrator) deadline(b *models.Benchmark) time.Time {
+	return b.StartedAt.Add(time.Duration(totalDuration(b))*time.Second + o.cfg.TimeoutGrace)
+}
+
+// totalDuration is how long a run takes from warmup to the end of cooldown,
+// in seconds.
+func totalDuration(b *models.Benchmark) int {
+	return b.WarmupDuration + b.Duration + b.CooldownDuration
+}
+
+// drive advances a started benchmark through its remaining phases. It stops
+// as soon as the run is cancelled or a transition is rejected, which happens
+// when someone else moved the benchmark to a terminal state.
+func (o *benchmarkOrchestrator) drive(ctx context.Context, b *models.Benchmark) {
+	id := b.ID.String()
+	phases := []string{
+		models.BenchmarkStatusWarmup,
+		models.BenchmarkStatusRunning,
+		models.BenchmarkStatusCooldown,
+		models.BenchmarkStatusCompleted,
+	}
+
+	for _, next := range phases {
+		if !o.wait(ctx, phaseDuration(b)) {
+			return
+		}
+		var err error
+		if b, err = o.Transition(ctx, id, next, ""); err != nil {
+			if ctx.Err() == nil {
+				o.logger.Warn("Benchmark run stopped", zap.String("benchmark_id", id), zap.String("phase", next), zap.Error(err))
+			}
+			o.release(id)
+			return
+		}
+	}
+}
+
+// phaseDuration is how long a benchmark stays in its current phase before
+// the driver advances it.
+func phaseDuration(b *models.Benchmark) time.Duration {
+	switch b.Status {
+	case models.BenchmarkStatusWarmup:
+		return time.Duration(b.WarmupDuration) * time.Second
+	case models.BenchmarkStatusRunning:
+		return time.Duration(b.Duration) * time.Second
+	case models.BenchmarkStatusCooldown:
+		return time.Duration(b.CooldownDuration) * time.Second
+	}
+	return 0
+}
+
+func (o *benchmarkOrchestrator) wait(ctx context.Context, d time.Duration) bool {
+	if d <= 0 {
+		return ctx.Err() == nil
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+func (o *benchmarkOrchestrator) release(id string) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if cancel, ok := o.runs[id]; ok {
+		cancel()
+		delete(o.runs, id)
+	}
+}
+
+func (o *benchmarkOrchestrator) notify(ctx context.Context, b *models.Benchmark, from string) {
+	o.mu.Lock()
+	listeners := append([]TransitionListener(nil), o.listeners...)
+	o.mu.Unlock()
+
+	for _, l := range listeners {
+		l(ctx, b, from)
+	}
+}
+
+// serviceLogger returns the process logger, or a no-op logger when it has not
+// been initialised (as in unit tests).
+func serviceLogger() *zap.Logger {
+	if utils.Logger == nil {
+		return zap.NewNop()
+	}
+	return utils.Logger
+}
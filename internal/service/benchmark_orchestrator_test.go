@@ -0,0 +1,192 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fffeng99999/hcp-server/internal/config"
+	"github.com/fffeng99999/hcp-server/internal/models"
+	"github.com/fffeng99999/hcp-server/internal/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCanTransition(t *testing.T) {
+	assert.True(t, CanTransition(models.BenchmarkStatusQueued, models.BenchmarkStatusProvisioning))
+	assert.True(t, CanTransition(models.BenchmarkStatusRunning, models.BenchmarkStatusCooldown))
+	assert.True(t, CanTransition(models.BenchmarkStatusWarmup, models.BenchmarkStatusCancelled))
+	assert.False(t, CanTransition(models.BenchmarkStatusQueued, models.BenchmarkStatusRunning))
+	assert.False(t, CanTransition(models.BenchmarkStatusCompleted, models.BenchmarkStatusFailed))
+	assert.False(t, CanTransition(models.BenchmarkStatusCancelled, models.BenchmarkStatusQueued))
+}
+
+func TestBenchmarkOrchestrator_TransitionStampsTimes(t *testing.T) {
+	mockRepo := new(MockBenchmarkRepository)
+	orch := NewBenchmarkOrchestrator(mockRepo, config.BenchmarkConfig{})
+
+	ctx := context.Background()
+	id := uuid.New()
+	mockRepo.On("GetByID", ctx, id.String()).Return(&models.Benchmark{ID: id, Status: models.BenchmarkStatusCooldown}, nil)
+	mockRepo.On("UpdateStatus", ctx, id.String(), models.BenchmarkStatusCooldown, models.BenchmarkStatusCompleted, (*time.Time)(nil),
+		mock.MatchedBy(func(u map[string]interface{}) bool {
+			_, ok := u["completed_at"]
+			return ok
+		})).Return(nil)
+
+	var notified string
+	orch.Subscribe(func(ctx context.Context, b *models.Benchmark, from string) {
+		notified = from + "->" + b.Status
+	})
+
+	b, err := orch.Transition(ctx, id.String(), models.BenchmarkStatusCompleted, "")
+
+	assert.NoError(t, err)
+	assert.Equal(t, models.BenchmarkStatusCompleted, b.Status)
+	assert.NotNil(t, b.CompletedAt)
+	assert.Equal(t, "cooldown->completed", notified)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestBenchmarkOrchestrator_TransitionRejectsIllegalMove(t *testing.T) {
+	mockRepo := new(MockBenchmarkRepository)
+	orch := NewBenchmarkOrchestrator(mockRepo, config.BenchmarkConfig{})
+
+	ctx := context.Background()
+	id := uuid.New()
+	mockRepo.On("GetByID", ctx, id.String()).Return(&models.Benchmark{ID: id, Status: models.BenchmarkStatusCompleted}, nil)
+
+	_, err := orch.Transition(ctx, id.String(), models.BenchmarkStatusRunning, "")
+
+	assert.ErrorIs(t, err, ErrInvalidTransition)
+	mockRepo.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestBenchmarkOrchestrator_TransitionConflict(t *testing.T) {
+	mockRepo := new(MockBenchmarkRepository)
+	orch := NewBenchmarkOrchestrator(mockRepo, config.BenchmarkConfig{})
+
+	ctx := context.Background()
+	id := uuid.New()
+	mockRepo.On("GetByID", ctx, id.String()).Return(&models.Benchmark{ID: id, Status: models.BenchmarkStatusRunning}, nil)
+	mockRepo.On("UpdateStatus", ctx, id.String(), models.BenchmarkStatusRunning, models.BenchmarkStatusCooldown, (*time.Time)(nil), mock.Anything).
+		Return(repository.ErrConflict)
+
+	_, err := orch.Transition(ctx, id.String(), models.BenchmarkStatusCooldown, "")
+
+	assert.ErrorIs(t, err, ErrInvalidTransition)
+}
+
+func TestBenchmarkOrchestrator_SweepTimesOutOverdueRuns(t *testing.T) {
+	mockRepo := new(MockBenchmarkRepository)
+	orch := NewBenchmarkOrchestrator(mockRepo, config.BenchmarkConfig{TimeoutGrace: time.Second}).(*benchmarkOrchestrator)
+
+	ctx := context.Background()
+	now := time.Now()
+	orch.now = func() time.Time { return now }
+
+	overdueStart := now.Add(-time.Minute)
+	freshStart := now.Add(-5 * time.Second)
+	overdue := models.Benchmark{ID: uuid.New(), Status: models.BenchmarkStatusRunning, Duration: 30, StartedAt: &overdueStart}
+	fresh := models.Benchmark{ID: uuid.New(), Status: models.BenchmarkStatusRunning, Duration: 30, StartedAt: &freshStart}
+
+	mockRepo.On("ListByStatus", ctx, activeBenchmarkStatuses).Return([]models.Benchmark{overdue, fresh}, nil)
+	mockRepo.On("GetByID", ctx, overdue.ID.String()).Return(&overdue, nil)
+	mockRepo.On("UpdateStatus", ctx, overdue.ID.String(), models.BenchmarkStatusRunning, models.BenchmarkStatusFailed, (*time.Time)(nil),
+		mock.MatchedBy(func(u map[string]interface{}) bool {
+			return u["error_message"] == "timed out: exceeded duration of 30s"
+		})).Return(nil)
+
+	orch.sweep(ctx)
+
+	mockRepo.AssertExpectations(t)
+	mockRepo.AssertNotCalled(t, "GetByID", ctx, fresh.ID.String())
+}
+
+func TestBenchmarkOrchestrator_WarmupAndCooldownExtendTheRun(t *testing.T) {
+	orch := NewBenchmarkOrchestrator(nil, config.BenchmarkConfig{TimeoutGrace: time.Second}).(*benchmarkOrchestrator)
+
+	started := time.Now()
+	b := &models.Benchmark{WarmupDuration: 30, Duration: 60, CooldownDuration: 10, StartedAt: &started}
+	assert.Equal(t, started.Add(101*time.Second), orch.deadline(b))
+
+	b.Status = models.BenchmarkStatusWarmup
+	assert.Equal(t, 30*time.Second, phaseDuration(b))
+	b.Status = models.BenchmarkStatusRunning
+	assert.Equal(t, 60*time.Second, phaseDuration(b))
+	b.Status = models.BenchmarkStatusCooldown
+	assert.Equal(t, 10*time.Second, phaseDuration(b))
+	b.Status = models.BenchmarkStatusProvisioning
+	assert.Zero(t, phaseDuration(b))
+}
+
+func TestBenchmarkOrchestrator_CancelRecordsReasonAndStopsRun(t *testing.T) {
+	mockRepo := new(MockBenchmarkRepository)
+	orch := NewBenchmarkOrchestrator(mockRepo, config.BenchmarkConfig{}).(*benchmarkOrchestrator)
+
+	ctx := context.Background()
+	id := uuid.New()
+	runCtx, stop := context.WithCancel(context.Background())
+	orch.runs[id.String()] = stop
+
+	mockRepo.On("GetByID", ctx, id.String()).Return(&models.Benchmark{ID: id, Status: models.BenchmarkStatusRunning}, nil)
+	mockRepo.On("UpdateStatus", ctx, id.String(), models.BenchmarkStatusRunning, models.BenchmarkStatusCancelled, (*time.Time)(nil),
+		mock.MatchedBy(func(u map[string]interface{}) bool {
+			return u["error_message"] == "cancelled by alice: wrong config"
+		})).Return(nil)
+
+	b, err := orch.Cancel(ctx, id.String(), "alice", "wrong config")
+
+	assert.NoError(t, err)
+	assert.Equal(t, models.BenchmarkStatusCancelled, b.Status)
+	assert.Error(t, runCtx.Err(), "run context is cancelled")
+	mockRepo.AssertExpectations(t)
+}
+
+func TestBenchmarkOrchestrator_StopWritesFieldsWithStatus(t *testing.T) {
+	mockRepo := new(MockBenchmarkRepository)
+	orch := NewBenchmarkOrchestrator(mockRepo, config.BenchmarkConfig{})
+
+	ctx := context.Background()
+	id := uuid.New()
+	updatedAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	mockRepo.On("GetByID", ctx, id.String()).Return(&models.Benchmark{ID: id, Status: models.BenchmarkStatusRunning, UpdatedAt: updatedAt}, nil)
+	mockRepo.On("UpdateStatus", ctx, id.String(), models.BenchmarkStatusRunning, models.BenchmarkStatusFailed, &updatedAt,
+		mock.MatchedBy(func(u map[string]interface{}) bool {
+			return u["name"] == "renamed" && u["error_message"] == "nodes lost"
+		})).Return(nil).Once()
+
+	_, err := orch.Stop(ctx, StopRequest{ID: id.String(), Status: models.BenchmarkStatusFailed, Reason: "nodes lost",
+		Fields: map[string]interface{}{"name": "renamed"}, ExpectedUpdatedAt: &updatedAt})
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+
+	stale := updatedAt.Add(-time.Second)
+	_, err = orch.Stop(ctx, StopRequest{ID: id.String(), Status: models.BenchmarkStatusFailed, ExpectedUpdatedAt: &stale})
+	assert.ErrorIs(t, err, repository.ErrConflict)
+
+	// Starting a run is the queue's job.
+	_, err = orch.Stop(ctx, StopRequest{ID: id.String(), Status: models.BenchmarkStatusProvisioning})
+	assert.ErrorIs(t, err, ErrInvalidTransition)
+	mockRepo.AssertNumberOfCalls(t, "UpdateStatus", 1)
+}
+
+func TestBenchmarkOrchestrator_StopLosesToConcurrentEdit(t *testing.T) {
+	mockRepo := new(MockBenchmarkRepository)
+	orch := NewBenchmarkOrchestrator(mockRepo, config.BenchmarkConfig{})
+
+	ctx := context.Background()
+	id := uuid.New()
+	updatedAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	mockRepo.On("GetByID", ctx, id.String()).Return(&models.Benchmark{ID: id, Status: models.BenchmarkStatusRunning, UpdatedAt: updatedAt}, nil)
+	// An UpdateFields lands after the read and moves updated_at on, so the
+	// guarded write matches no row.
+	mockRepo.On("UpdateStatus", ctx, id.String(), models.BenchmarkStatusRunning, models.BenchmarkStatusFailed, &updatedAt, mock.Anything).
+		Return(repository.ErrConflict)
+
+	_, err := orch.Stop(ctx, StopRequest{ID: id.String(), Status: models.BenchmarkStatusFailed,
+		Fields: map[string]interface{}{"name": "renamed"}, ExpectedUpdatedAt: &updatedAt})
+	assert.ErrorIs(t, err, repository.ErrConflict)
+	mockRepo.AssertExpectations(t)
+}
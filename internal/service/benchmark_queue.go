@@ -0,0 +1,195 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/fffeng99999/hcp-server/internal/config"
+	"github.com/fffeng99999/hcp-server/internal/models"
+	"github.com/fffeng99999/hcp-server/internal/repository"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultMaxConcurrent    = 1
+	defaultDispatchInterval = 10 * time.Second
+)
+
+// ClusterQueue is the occupancy of one cluster.
+type ClusterQueue struct {
+	Cluster       string
+	Queued        int64
+	Active        int64
+	MaxConcurrent int
+}
+
+// BenchmarkQueue holds queued benchmarks until their cluster has a free slot
+// and then hands them to the orchestrator. The queue itself is the set of
+// benchmarks in the queued state, so it survives restarts.
+type BenchmarkQueue interface {
+	// Notify tells the dispatcher that runs were queued, so it need not wait
+	// for its next check.
+	Notify()
+	// Position returns the 1-based place of a benchmark in its cluster's
+	// queue, or 0 if it is not queued.
+	Position(ctx context.Context, id string) (int64, error)
+	// List returns the queued runs of a cluster, or of all clusters when
+	// cluster is empty, in dispatch order, with the occupancy of the clusters
+	// involved.
+	List(ctx context.Context, cluster string) ([]models.Benchmark, []ClusterQueue, error)
+	// OnTransition wakes the dispatcher when a run finishes. It is meant to be
+	// registered with BenchmarkOrchestrator.Subscribe.
+	OnTransition(ctx context.Context, b *models.Benchmark, from string)
+	// Run starts queued runs as slots free up until ctx is done. Several
+	// server instances may run it against the same database.
+	Run(ctx context.Context)
+}
+
+type benchmarkQueue struct {
+	repo repository.BenchmarkQueueRepository
+	orch BenchmarkOrchestrator
+	cfg  config.QueueConfig
+	wake chan struct{}
+}
+
+func NewBenchmarkQueue(repo repository.BenchmarkQueueRepository, orch BenchmarkOrchestrator, cfg config.QueueConfig) BenchmarkQueue {
+	if cfg.MaxConcurrent <= 0 {
+		cfg.MaxConcurrent = defaultMaxConcurrent
+	}
+	if cfg.DispatchInterval <= 0 {
+		cfg.DispatchInterval = defaultDispatchInterval
+	}
+	return &benchmarkQueue{
+		repo: repo,
+		orch: orch,
+		cfg:  cfg,
+		wake: make(chan struct{}, 1),
+	}
+}
+
+func (q *benchmarkQueue) Notify() {
+	select {
+	case q.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (q *benchmarkQueue) Position(ctx context.Context, id string) (int64, error) {
+	return q.repo.Position(ctx, id)
+}
+
+func (q *benchmarkQueue) List(ctx context.Context, cluster string) ([]models.Benchmark, []ClusterQueue, error) {
+	cluster = strings.ToLower(cluster)
+	queued, err := q.repo.ListQueued(ctx, cluster)
+	if err != nil {
+		return nil, nil, err
+	}
+	counts, err := q.repo.ClusterCounts(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var clusters []ClusterQueue
+	for _, c := range counts {
+		if cluster != "" && c.Cluster != cluster {
+			continue
+		}
+		clusters = append(clusters, ClusterQueue{
+			Cluster:       c.Cluster,
+			Queued:        c.Queued,
+			Active:        c.Active,
+			MaxConcurrent: q.limit(c.Cluster),
+		})
+	}
+	if cluster != "" && len(clusters) == 0 {
+		clusters = append(clusters, ClusterQueue{Cluster: cluster, MaxConcurrent: q.limit(cluster)})
+	}
+	return queued, clusters, nil
+}
+
+func (q *benchmarkQueue) OnTransition(ctx context.Context, b *models.Benchmark, from string) {
+	if b.IsTerminal() {
+		q.Notify()
+	}
+}
+
+func (q *benchmarkQueue) Run(ctx context.Context) {
+	ticker := time.NewTicker(q.cfg.DispatchInterval)
+	defer ticker.Stop()
+
+	// Runs queued before a restart are picked up straight away.
+	q.dispatch(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-q.wake:
+		}
+		q.dispatch(ctx)
+	}
+}
+
+// dispatch fills the free slots of every cluster with a queue.
+func (q *benchmarkQueue) dispatch(ctx context.Context) {
+	counts, err := q.repo.ClusterCounts(ctx)
+	if err != nil {
+		serviceLogger().Warn("Failed to count queued benchmarks", zap.Error(err))
+		return
+	}
+
+	for _, c := range counts {
+		if c.Queued == 0 || c.Active >= int64(q.limit(c.Cluster)) {
+			continue
+		}
+		cluster := c.Cluster
+		// A cluster locked by another instance is being filled there.
+		if _, err := q.repo.WithClusterLock(ctx, cluster, func(ctx context.Context) error {
+			return q.fill(ctx, cluster)
+		}); err != nil {
+			serviceLogger().Warn("Failed to dispatch benchmarks", zap.String("cluster", cluster), zap.Error(err))
+		}
+	}
+}
+
+// fill starts the cluster's queued runs in order until its slots are taken
+// or no queued run may start. It must hold the cluster's lock.
+func (q *benchmarkQueue) fill(ctx context.Context, cluster string) error {
+	limit := int64(q.limit(cluster))
+	for {
+		active, err := q.repo.CountActive(ctx, cluster)
+		if err != nil {
+			return err
+		}
+		if active >= limit {
+			return nil
+		}
+		next, err := q.repo.NextDispatchable(ctx, cluster)
+		if err != nil {
+			return err
+		}
+		if next == nil {
+			return nil
+		}
+
+		id := next.ID.String()
+		if err := q.orch.Start(ctx, id); err != nil {
+			// Cancelled after it was picked; the next query skips it.
+			if errors.Is(err, ErrInvalidTransition) {
+				continue
+			}
+			return err
+		}
+		serviceLogger().Info("Dispatched queued benchmark", zap.String("benchmark_id", id), zap.String("cluster", cluster))
+	}
+}
+
+// limit is the number of runs that may be active on the cluster at once.
+func (q *benchmarkQueue) limit(cluster string) int {
+	if n, ok := q.cfg.Clusters[cluster]; ok && n > 0 {
+		return n
+	}
+	return q.cfg.MaxConcurrent
+}
@@ -0,0 +1,135 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/fffeng99999/hcp-server/internal/config"
+	"github.com/fffeng99999/hcp-server/internal/models"
+	"github.com/fffeng99999/hcp-server/internal/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockBenchmarkQueueRepository struct {
+	mock.Mock
+}
+
+func (m *MockBenchmarkQueueRepository) ClusterCounts(ctx context.Context) ([]repository.ClusterCount, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]repository.ClusterCount), args.Error(1)
+}
+
+func (m *MockBenchmarkQueueRepository) CountActive(ctx context.Context, cluster string) (int64, error) {
+	args := m.Called(ctx, cluster)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockBenchmarkQueueRepository) ListQueued(ctx context.Context, cluster string) ([]models.Benchmark, error) {
+	args := m.Called(ctx, cluster)
+	return args.Get(0).([]models.Benchmark), args.Error(1)
+}
+
+func (m *MockBenchmarkQueueRepository) Position(ctx context.Context, id string) (int64, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockBenchmarkQueueRepository) NextDispatchable(ctx context.Context, cluster string) (*models.Benchmark, error) {
+	args := m.Called(ctx, cluster)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Benchmark), args.Error(1)
+}
+
+func (m *MockBenchmarkQueueRepository) WithClusterLock(ctx context.Context, cluster string, fn func(ctx context.Context) error) (bool, error) {
+	args := m.Called(ctx, cluster)
+	if !args.Bool(0) {
+		return false, args.Error(1)
+	}
+	return true, fn(ctx)
+}
+
+type MockBenchmarkOrchestrator struct {
+	mock.Mock
+}
+
+func (m *MockBenchmarkOrchestrator) Start(ctx context.Context, id string) error {
+	return m.Called(ctx, id).Error(0)
+}
+
+func (m *MockBenchmarkOrchestrator) Transition(ctx context.Context, id, to, reason string) (*models.Benchmark, error) {
+	args := m.Called(ctx, id, to, reason)
+	return args.Get(0).(*models.Benchmark), args.Error(1)
+}
+
+func (m *MockBenchmarkOrchestrator) Cancel(ctx context.Context, id, by, reason string) (*models.Benchmark, error) {
+	args := m.Called(ctx, id, by, reason)
+	return args.Get(0).(*models.Benchmark), args.Error(1)
+}
+
+func (m *MockBenchmarkOrchestrator) Stop(ctx context.Context, req StopRequest) (*models.Benchmark, error) {
+	args := m.Called(ctx, req)
+	return args.Get(0).(*models.Benchmark), args.Error(1)
+}
+
+func (m *MockBenchmarkOrchestrator) Subscribe(l TransitionListener) {}
+
+func (m *MockBenchmarkOrchestrator) Run(ctx context.Context) {}
+
+func TestBenchmarkQueue_DispatchFillsFreeSlots(t *testing.T) {
+	repo := new(MockBenchmarkQueueRepository)
+	orch := new(MockBenchmarkOrchestrator)
+	queue := NewBenchmarkQueue(repo, orch, config.QueueConfig{Clusters: map[string]int{"lab": 2}}).(*benchmarkQueue)
+
+	ctx := context.Background()
+	first, second := uuid.New(), uuid.New()
+	repo.On("ClusterCounts", ctx).Return([]repository.ClusterCount{
+		{Cluster: "default", Queued: 3, Active: 1}, // full
+		{Cluster: "lab", Queued: 3, Active: 0},
+	}, nil)
+	repo.On("WithClusterLock", ctx, "lab").Return(true, nil)
+	repo.On("CountActive", ctx, "lab").Return(int64(0), nil).Once()
+	repo.On("CountActive", ctx, "lab").Return(int64(1), nil).Once()
+	repo.On("CountActive", ctx, "lab").Return(int64(2), nil).Once()
+	repo.On("NextDispatchable", ctx, "lab").Return(&models.Benchmark{ID: first}, nil).Once()
+	repo.On("NextDispatchable", ctx, "lab").Return(&models.Benchmark{ID: second}, nil).Once()
+	orch.On("Start", ctx, first.String()).Return(nil)
+	orch.On("Start", ctx, second.String()).Return(nil)
+
+	queue.dispatch(ctx)
+
+	orch.AssertNumberOfCalls(t, "Start", 2)
+	repo.AssertNotCalled(t, "WithClusterLock", ctx, "default")
+	repo.AssertExpectations(t)
+}
+
+func TestBenchmarkQueue_FillSkipsRunsCancelledMeanwhile(t *testing.T) {
+	repo := new(MockBenchmarkQueueRepository)
+	orch := new(MockBenchmarkOrchestrator)
+	queue := NewBenchmarkQueue(repo, orch, config.QueueConfig{}).(*benchmarkQueue)
+
+	ctx := context.Background()
+	cancelled, next := uuid.New(), uuid.New()
+	repo.On("CountActive", ctx, "default").Return(int64(0), nil).Twice()
+	repo.On("CountActive", ctx, "default").Return(int64(1), nil).Once()
+	repo.On("NextDispatchable", ctx, "default").Return(&models.Benchmark{ID: cancelled}, nil).Once()
+	repo.On("NextDispatchable", ctx, "default").Return(&models.Benchmark{ID: next}, nil).Once()
+	orch.On("Start", ctx, cancelled.String()).Return(fmt.Errorf("%w: cancelled -> provisioning", ErrInvalidTransition))
+	orch.On("Start", ctx, next.String()).Return(nil)
+
+	assert.NoError(t, queue.fill(ctx, "default"))
+	orch.AssertExpectations(t)
+}
+
+func TestBenchmarkQueue_Limit(t *testing.T) {
+	queue := NewBenchmarkQueue(nil, nil, config.QueueConfig{MaxConcurrent: 3, Clusters: map[string]int{"eu-west": 1}}).(*benchmarkQueue)
+	assert.Equal(t, 1, queue.limit("eu-west"))
+	assert.Equal(t, 3, queue.limit("default"))
+
+	queue = NewBenchmarkQueue(nil, nil, config.QueueConfig{}).(*benchmarkQueue)
+	assert.Equal(t, defaultMaxConcurrent, queue.limit("default"))
+}
@@ -0,0 +1,277 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/fffeng99999/hcp-server/internal/models"
+	"github.com/fffeng99999/hcp-server/internal/repository"
+	"go.uber.org/zap"
+)
+
+const defaultRankingCacheTTL = 5 * time.Minute
+
+// Leaderboard orderings. Throughput ranks highest first, latency lowest first.
+const (
+	RankByMedianTPS        = "median_tps"
+	RankByBestTPS          = "best_tps"
+	RankByMedianLatencyP99 = "median_latency_p99"
+	RankByBestLatencyP99   = "best_latency_p99"
+)
+
+// Prefixes of the cache keys of leaderboards and trends.
+const (
+	leaderboardCachePrefix = "leaderboard:"
+	trendCachePrefix       = "trend:"
+)
+
+var ErrInvalidRanking = errors.New("invalid ranking query")
+
+// Cache holds computed results for a while. A miss or a failing cache only
+// costs a recomputation.
+type Cache interface {
+	Get(ctx context.Context, key string, dst interface{}) (bool, error)
+	Set(ctx context.Context, key string, v interface{}, ttl time.Duration) error
+	DeletePrefix(ctx context.Context, prefix string) error
+}
+
+type LeaderboardQuery struct {
+	Filter repository.RankingFilter
+	// RankBy is one of the RankBy constants; it defaults to RankByMedianTPS.
+	RankBy string
+}
+
+// LeaderboardEntry is an algorithm's standing among runs with the same node
+// count. Equal values share a rank.
+type LeaderboardEntry struct {
+	Rank int
+	repository.LeaderboardRow
+}
+
+type LeaderboardBucket struct {
+	NodeCount int
+	Entries   []LeaderboardEntry
+}
+
+type Leaderboard struct {
+	RankBy      string
+	Buckets     []LeaderboardBucket
+	GeneratedAt time.Time
+}
+
+type TrendQuery struct {
+	Filter repository.RankingFilter
+	// Metric is one of repository.TrendMetrics; it defaults to actual_tps.
+	Metric string
+	// GroupBy is repository.TrendByTime (the default) or TrendByVersion.
+	GroupBy string
+	// Interval is the time bucket width, one of repository.TrendIntervals;
+	// it defaults to day.
+	Interval string
+}
+
+type Trend struct {
+	Algorithm   string
+	Metric      string
+	GroupBy     string
+	Interval    string
+	Points      []repository.TrendRow
+	GeneratedAt time.Time
+}
+
+// BenchmarkRanking compares algorithms across completed runs.
+type BenchmarkRanking interface {
+	// Leaderboard ranks algorithms per node count.
+	Leaderboard(ctx context.Context, q LeaderboardQuery) (*Leaderboard, error)
+	// Trend follows a result metric of one algorithm, which must be the only
+	// one in the filter, over time or over implementation versions.
+	Trend(ctx context.Context, q TrendQuery) (*Trend, error)
+	// OnTransition drops cached rankings when a run completes. It is meant to
+	// be registered with BenchmarkOrchestrator.Subscribe after the finalizer.
+	OnTransition(ctx context.Context, b *models.Benchmark, from string)
+	// Invalidate drops cached rankings when b, a run that was edited,
+	// recomputed or deleted, counts towards them.
+	Invalidate(ctx context.Context, b *models.Benchmark)
+}
+
+type benchmarkRanking struct {
+	repo  repository.BenchmarkRankingRepository
+	cache Cache
+	ttl   time.Duration
+	now   func() time.Time
+}
+
+func NewBenchmarkRanking(repo repository.BenchmarkRankingRepository, cache Cache, ttl time.Duration) BenchmarkRanking {
+	if ttl <= 0 {
+		ttl = defaultRankingCacheTTL
+	}
+	return &benchmarkRanking{repo: repo, cache: cache, ttl: ttl, now: time.Now}
+}
+
+func (r *benchmarkRanking) Leaderboard(ctx context.Context, q LeaderboardQuery) (*Leaderboard, error) {
+	if q.RankBy == "" {
+		q.RankBy = RankByMedianTPS
+	}
+	better, ok := rankings[q.RankBy]
+	if !ok {
+		return nil, fmt.Errorf("%w: cannot rank by %q", ErrInvalidRanking, q.RankBy)
+	}
+	if err := validateRankingFilter(q.Filter); err != nil {
+		return nil, err
+	}
+
+	key := leaderboardCachePrefix + cacheKey(q)
+	var board Leaderboard
+	if r.cached(ctx, key, &board) {
+		return &board, nil
+	}
+
+	rows, err := r.repo.Leaderboard(ctx, q.Filter)
+	if err != nil {
+		return nil, err
+	}
+	board = Leaderboard{RankBy: q.RankBy, Buckets: rankBuckets(rows, better), GeneratedAt: r.now()}
+	r.store(ctx, key, &board)
+	return &board, nil
+}
+
+func (r *benchmarkRanking) Trend(ctx context.Context, q TrendQuery) (*Trend, error) {
+	if len(q.Filter.Algorithms) != 1 {
+		return nil, fmt.Errorf("%w: a trend needs exactly one algorithm", ErrInvalidRanking)
+	}
+	if err := validateRankingFilter(q.Filter); err != nil {
+		return nil, err
+	}
+	if q.Metric == "" {
+		q.Metric = "actual_tps"
+	}
+	if q.GroupBy == "" {
+		q.GroupBy = repository.TrendByTime
+	}
+	if q.GroupBy == repository.TrendByTime && q.Interval == "" {
+		q.Interval = "day"
+	}
+	if q.GroupBy == repository.TrendByVersion {
+		q.Interval = ""
+	}
+
+	key := trendCachePrefix + cacheKey(q)
+	var trend Trend
+	if r.cached(ctx, key, &trend) {
+		return &trend, nil
+	}
+
+	points, err := r.repo.Trend(ctx, q.Filter, q.Metric, q.GroupBy, q.Interval)
+	if err != nil {
+		return nil, err
+	}
+	trend = Trend{
+		Algorithm:   q.Filter.Algorithms[0],
+		Metric:      q.Metric,
+		GroupBy:     q.GroupBy,
+		Interval:    q.Interval,
+		Points:      points,
+		GeneratedAt: r.now(),
+	}
+	r.store(ctx, key, &trend)
+	return &trend, nil
+}
+
+func (r *benchmarkRanking) OnTransition(ctx context.Context, b *models.Benchmark, from string) {
+	r.Invalidate(ctx, b)
+}
+
+func (r *benchmarkRanking) Invalidate(ctx context.Context, b *models.Benchmark) {
+	if b.Status != models.BenchmarkStatusCompleted {
+		return
+	}
+	for _, prefix := range []string{leaderboardCachePrefix, trendCachePrefix} {
+		if err := r.cache.DeletePrefix(ctx, prefix); err != nil {
+			serviceLogger().Warn("Failed to invalidate cached rankings", zap.String("prefix", prefix), zap.Error(err))
+		}
+	}
+}
+
+func (r *benchmarkRanking) cached(ctx context.Context, key string, dst interface{}) bool {
+	ok, err := r.cache.Get(ctx, key, dst)
+	if err != nil {
+		serviceLogger().Warn("Failed to read cached ranking", zap.String("key", key), zap.Error(err))
+		return false
+	}
+	return ok
+}
+
+func (r *benchmarkRanking) store(ctx context.Context, key string, v interface{}) {
+	if err := r.cache.Set(ctx, key, v, r.ttl); err != nil {
+		serviceLogger().Warn("Failed to cache ranking", zap.String("key", key), zap.Error(err))
+	}
+}
+
+// rankings tell for each ordering whether row a ranks above row b.
+var rankings = map[string]func(a, b repository.LeaderboardRow) bool{
+	RankByMedianTPS: func(a, b repository.LeaderboardRow) bool { return a.MedianTPS > b.MedianTPS },
+	RankByBestTPS:   func(a, b repository.LeaderboardRow) bool { return a.BestTPS > b.BestTPS },
+	RankByMedianLatencyP99: func(a, b repository.LeaderboardRow) bool {
+		return lowerLatency(a.MedianLatencyP99, b.MedianLatencyP99)
+	},
+	RankByBestLatencyP99: func(a, b repository.LeaderboardRow) bool {
+		return lowerLatency(a.BestLatencyP99, b.BestLatencyP99)
+	},
+}
+
+// lowerLatency orders latencies ascending, with unmeasured (zero) ones last.
+func lowerLatency(a, b float64) bool {
+	if a == 0 || b == 0 {
+		return a != 0 && b == 0
+	}
+	return a < b
+}
+
+// rankBuckets groups rows, which come ordered by node count, into buckets and
+// ranks the algorithms in each.
+func rankBuckets(rows []repository.LeaderboardRow, better func(a, b repository.LeaderboardRow) bool) []LeaderboardBucket {
+	var buckets []LeaderboardBucket
+	for _, row := range rows {
+		if n := len(buckets); n == 0 || buckets[n-1].NodeCount != row.NodeCount {
+			buckets = append(buckets, LeaderboardBucket{NodeCount: row.NodeCount})
+		}
+		bucket := &buckets[len(buckets)-1]
+		bucket.Entries = append(bucket.Entries, LeaderboardEntry{LeaderboardRow: row})
+	}
+
+	for _, bucket := range buckets {
+		entries := bucket.Entries
+		sort.SliceStable(entries, func(i, j int) bool {
+			return better(entries[i].LeaderboardRow, entries[j].LeaderboardRow)
+		})
+		for i := range entries {
+			entries[i].Rank = i + 1
+			if i > 0 && !better(entries[i-1].LeaderboardRow, entries[i].LeaderboardRow) {
+				entries[i].Rank = entries[i-1].Rank
+			}
+		}
+	}
+	return buckets
+}
+
+func validateRankingFilter(f repository.RankingFilter) error {
+	for _, algorithm := range f.Algorithms {
+		if !isSupportedAlgorithm(algorithm) {
+			return fmt.Errorf("%w: unsupported algorithm %q", ErrInvalidRanking, algorithm)
+		}
+	}
+	if f.NodeCount < 0 {
+		return fmt.Errorf("%w: node count must not be negative", ErrInvalidRanking)
+	}
+	return nil
+}
+
+// cacheKey identifies a query in the cache by its JSON encoding.
+func cacheKey(q interface{}) string {
+	data, _ := json.Marshal(q)
+	return string(data)
+}
@@ -0,0 +1,135 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fffeng99999/hcp-server/internal/models"
+	"github.com/fffeng99999/hcp-server/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockBenchmarkRankingRepository struct {
+	mock.Mock
+}
+
+func (m *MockBenchmarkRankingRepository) Leaderboard(ctx context.Context, filter repository.RankingFilter) ([]repository.LeaderboardRow, error) {
+	args := m.Called(ctx, filter)
+	return args.Get(0).([]repository.LeaderboardRow), args.Error(1)
+}
+
+func (m *MockBenchmarkRankingRepository) Trend(ctx context.Context, filter repository.RankingFilter, metric, groupBy, interval string) ([]repository.TrendRow, error) {
+	args := m.Called(ctx, filter, metric, groupBy, interval)
+	return args.Get(0).([]repository.TrendRow), args.Error(1)
+}
+
+// memoryCache is a Cache backed by a map, ignoring TTLs.
+type memoryCache map[string][]byte
+
+func (c memoryCache) Get(ctx context.Context, key string, dst interface{}) (bool, error) {
+	data, ok := c[key]
+	if !ok {
+		return false, nil
+	}
+	return true, json.Unmarshal(data, dst)
+}
+
+func (c memoryCache) Set(ctx context.Context, key string, v interface{}, ttl time.Duration) error {
+	data, err := json.Marshal(v)
+	c[key] = data
+	return err
+}
+
+func (c memoryCache) DeletePrefix(ctx context.Context, prefix string) error {
+	for key := range c {
+		if strings.HasPrefix(key, prefix) {
+			delete(c, key)
+		}
+	}
+	return nil
+}
+
+func TestBenchmarkRanking_Leaderboard(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockBenchmarkRankingRepository)
+	cache := memoryCache{}
+	ranking := NewBenchmarkRanking(repo, cache, time.Minute)
+
+	rows := []repository.LeaderboardRow{
+		{Algorithm: "HotStuff", NodeCount: 4, MedianTPS: 900, MedianLatencyP99: 80},
+		{Algorithm: "Raft", NodeCount: 4, MedianTPS: 1200, MedianLatencyP99: 0},
+		{Algorithm: "tPBFT", NodeCount: 4, MedianTPS: 900, MedianLatencyP99: 60},
+		{Algorithm: "Raft", NodeCount: 16, MedianTPS: 700, MedianLatencyP99: 150},
+	}
+	repo.On("Leaderboard", ctx, repository.RankingFilter{}).Return(rows, nil)
+
+	board, err := ranking.Leaderboard(ctx, LeaderboardQuery{})
+	require.NoError(t, err)
+	assert.Equal(t, RankByMedianTPS, board.RankBy)
+	require.Len(t, board.Buckets, 2)
+	assert.Equal(t, 4, board.Buckets[0].NodeCount)
+	entries := board.Buckets[0].Entries
+	assert.Equal(t, []string{"Raft", "HotStuff", "tPBFT"}, []string{entries[0].Algorithm, entries[1].Algorithm, entries[2].Algorithm})
+	assert.Equal(t, []int{1, 2, 2}, []int{entries[0].Rank, entries[1].Rank, entries[2].Rank})
+	assert.Equal(t, 16, board.Buckets[1].NodeCount)
+
+	// Served from the cache the second time.
+	again, err := ranking.Leaderboard(ctx, LeaderboardQuery{RankBy: RankByMedianTPS})
+	require.NoError(t, err)
+	assert.Equal(t, board.Buckets, again.Buckets)
+	repo.AssertNumberOfCalls(t, "Leaderboard", 1)
+
+	// Lower latency ranks first; runs without latency go last.
+	board, err = ranking.Leaderboard(ctx, LeaderboardQuery{RankBy: RankByMedianLatencyP99})
+	require.NoError(t, err)
+	entries = board.Buckets[0].Entries
+	assert.Equal(t, []string{"tPBFT", "HotStuff", "Raft"}, []string{entries[0].Algorithm, entries[1].Algorithm, entries[2].Algorithm})
+
+	// A completed run invalidates the cache.
+	ranking.OnTransition(ctx, &models.Benchmark{Status: models.BenchmarkStatusCompleted}, models.BenchmarkStatusCooldown)
+	assert.Empty(t, cache)
+	_, err = ranking.Leaderboard(ctx, LeaderboardQuery{})
+	require.NoError(t, err)
+	repo.AssertNumberOfCalls(t, "Leaderboard", 3)
+
+	// So does editing or deleting one, but not a run that never completed.
+	ranking.Invalidate(ctx, &models.Benchmark{Status: models.BenchmarkStatusFailed})
+	assert.NotEmpty(t, cache)
+	ranking.Invalidate(ctx, &models.Benchmark{Status: models.BenchmarkStatusCompleted})
+	assert.Empty(t, cache)
+}
+
+func TestBenchmarkRanking_Trend(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockBenchmarkRankingRepository)
+	ranking := NewBenchmarkRanking(repo, memoryCache{}, time.Minute)
+
+	filter := repository.RankingFilter{Algorithms: []string{"Raft"}}
+	points := []repository.TrendRow{{Label: "v1.0.0", Runs: 3, Mean: 1000}, {Label: "v1.1.0", Runs: 2, Mean: 1100}}
+	repo.On("Trend", ctx, filter, "latency_p99", repository.TrendByVersion, "").Return(points, nil)
+
+	trend, err := ranking.Trend(ctx, TrendQuery{Filter: filter, Metric: "latency_p99", GroupBy: repository.TrendByVersion, Interval: "week"})
+	require.NoError(t, err)
+	assert.Equal(t, "Raft", trend.Algorithm)
+	assert.Equal(t, points, trend.Points)
+	assert.Empty(t, trend.Interval)
+}
+
+func TestBenchmarkRanking_InvalidQueries(t *testing.T) {
+	ctx := context.Background()
+	ranking := NewBenchmarkRanking(new(MockBenchmarkRankingRepository), memoryCache{}, time.Minute)
+
+	_, err := ranking.Leaderboard(ctx, LeaderboardQuery{RankBy: "fastest"})
+	assert.ErrorIs(t, err, ErrInvalidRanking)
+	_, err = ranking.Leaderboard(ctx, LeaderboardQuery{Filter: repository.RankingFilter{Algorithms: []string{"Paxos"}}})
+	assert.ErrorIs(t, err, ErrInvalidRanking)
+	_, err = ranking.Trend(ctx, TrendQuery{})
+	assert.ErrorIs(t, err, ErrInvalidRanking)
+	_, err = ranking.Trend(ctx, TrendQuery{Filter: repository.RankingFilter{Algorithms: []string{"Raft", "HotStuff"}}})
+	assert.ErrorIs(t, err, ErrInvalidRanking)
+}
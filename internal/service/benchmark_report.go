@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/fffeng99999/hcp-server/internal/models"
+	"github.com/fffeng99999/hcp-server/internal/report"
+	"github.com/fffeng99999/hcp-server/internal/repository"
+)
+
+// reportBuckets is roughly how many points a report series is cut into when
+// no bucket width is requested.
+const reportBuckets = 300
+
+var ErrBenchmarkNotCompleted = errors.New("benchmark has not completed")
+
+// BenchmarkReporter gathers everything recorded for a completed benchmark
+// into a report.
+type BenchmarkReporter interface {
+	// Build assembles the report for benchmark id. Series are averaged over
+	// buckets of the given width; zero picks one from the run's length.
+	Build(ctx context.Context, id string, bucket time.Duration) (*report.Report, error)
+}
+
+type benchmarkReporter struct {
+	benchmarkRepo   repository.BenchmarkRepository
+	transactionRepo repository.TransactionRepository
+	metricRepo      repository.MetricRepository
+	anomalyRepo     repository.AnomalyRepository
+	nodeRepo        repository.BenchmarkNodeRepository
+	finalizer       BenchmarkFinalizer
+}
+
+func NewBenchmarkReporter(benchmarkRepo repository.BenchmarkRepository, transactionRepo repository.TransactionRepository, metricRepo repository.MetricRepository, anomalyRepo repository.AnomalyRepository, nodeRepo repository.BenchmarkNodeRepository, finalizer BenchmarkFinalizer) BenchmarkReporter {
+	return &benchmarkReporter{
+		benchmarkRepo:   benchmarkRepo,
+		transactionRepo: transactionRepo,
+		metricRepo:      metricRepo,
+		anomalyRepo:     anomalyRepo,
+		nodeRepo:        nodeRepo,
+		finalizer:       finalizer,
+	}
+}
+
+func (r *benchmarkReporter) Build(ctx context.Context, id string, bucket time.Duration) (*report.Report, error) {
+	b, err := r.benchmarkRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if b.Status != models.BenchmarkStatusCompleted {
+		return nil, fmt.Errorf("%w: %s is %s", ErrBenchmarkNotCompleted, id, b.Status)
+	}
+	// Runs finished before results were persisted are summarised on the fly.
+	if !hasSummary(b) {
+		if err := r.finalizer.Compute(ctx, b); err != nil {
+			return nil, err
+		}
+	}
+	if bucket <= 0 {
+		bucket = reportBucket(b)
+	}
+
+	throughput, err := r.transactionRepo.GetThroughputSeries(ctx, id, bucket)
+	if err != nil {
+		return nil, err
+	}
+	nodeSeries, err := r.metricRepo.GetNodeSeries(ctx, id, bucket)
+	if err != nil {
+		return nil, err
+	}
+	anomalies, err := r.anomalyRepo.ListByBenchmark(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	nodes, err := r.nodeRepo.ListByBenchmark(ctx, id, repository.BenchmarkNodeFilter{})
+	if err != nil {
+		return nil, err
+	}
+
+	rep := &report.Report{
+		GeneratedAt:   time.Now(),
+		Benchmark:     b,
+		Nodes:         nodes,
+		BucketSeconds: bucket.Seconds(),
+		NodeMetrics:   groupNodeSeries(nodeSeries),
+		Anomalies:     anomalies,
+	}
+	for _, p := range throughput {
+		rep.Throughput = append(rep.Throughput, report.Point{Time: p.Time, Value: p.Value})
+	}
+	return rep, nil
+}
+
+// reportBucket picks a whole-second bucket width that cuts the run into about
+// reportBuckets points.
+func reportBucket(b *models.Benchmark) time.Duration {
+	seconds := float64(b.Duration)
+	if b.StartedAt != nil && b.CompletedAt != nil {
+		seconds = b.CompletedAt.Sub(*b.StartedAt).Seconds()
+	}
+	width := math.Ceil(seconds / reportBuckets)
+	if width < 1 {
+		width = 1
+	}
+	return time.Duration(width) * time.Second
+}
+
+// groupNodeSeries splits points ordered by node, metric and time into one
+// series per node and metric.
+func groupNodeSeries(points []repository.NodeSeriesPoint) []report.Series {
+	var series []report.Series
+	for _, p := range points {
+		n := len(series)
+		if n == 0 || series[n-1].NodeID != p.NodeID || series[n-1].Metric != p.MetricName {
+			series = append(series, report.Series{NodeID: p.NodeID, Metric: p.MetricName, Unit: p.MetricUnit})
+			n++
+		}
+		series[n-1].Points = append(series[n-1].Points, report.Point{Time: p.Time, Value: p.Value})
+	}
+	return series
+}
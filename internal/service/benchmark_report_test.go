@@ -0,0 +1,50 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fffeng99999/hcp-server/internal/models"
+	"github.com/fffeng99999/hcp-server/internal/repository"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReportBucket(t *testing.T) {
+	assert.Equal(t, time.Second, reportBucket(&models.Benchmark{Duration: 60}))
+	assert.Equal(t, 2*time.Second, reportBucket(&models.Benchmark{Duration: 600}))
+
+	start := time.Now()
+	end := start.Add(time.Hour)
+	b := &models.Benchmark{Duration: 60, StartedAt: &start, CompletedAt: &end}
+	assert.Equal(t, 12*time.Second, reportBucket(b))
+}
+
+func TestGroupNodeSeries(t *testing.T) {
+	t0 := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	t1 := t0.Add(time.Second)
+	series := groupNodeSeries([]repository.NodeSeriesPoint{
+		{NodeID: "node-1", MetricName: "cpu_usage", MetricUnit: "%", Time: t0, Value: 10},
+		{NodeID: "node-1", MetricName: "cpu_usage", MetricUnit: "%", Time: t1, Value: 20},
+		{NodeID: "node-1", MetricName: "memory_usage", MetricUnit: "MB", Time: t0, Value: 512},
+		{NodeID: "node-2", MetricName: "cpu_usage", MetricUnit: "%", Time: t0, Value: 30},
+	})
+
+	assert.Len(t, series, 3)
+	assert.Equal(t, "node-1", series[0].NodeID)
+	assert.Len(t, series[0].Points, 2)
+	assert.Equal(t, "MB", series[1].Unit)
+	assert.Equal(t, "node-2", series[2].NodeID)
+	assert.Nil(t, groupNodeSeries(nil))
+}
+
+func TestBenchmarkReporter_RequiresCompletedRun(t *testing.T) {
+	benchmarkRepo := new(MockBenchmarkRepository)
+	reporter := NewBenchmarkReporter(benchmarkRepo, nil, nil, nil, nil, nil)
+	ctx := context.Background()
+	for _, status := range []string{models.BenchmarkStatusRunning, models.BenchmarkStatusFailed, models.BenchmarkStatusCancelled} {
+		benchmarkRepo.On("GetByID", ctx, status).Return(&models.Benchmark{Status: status}, nil)
+		_, err := reporter.Build(ctx, status, 0)
+		assert.ErrorIs(t, err, ErrBenchmarkNotCompleted, status)
+	}
+}
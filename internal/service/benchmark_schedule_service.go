@@ -0,0 +1,262 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/fffeng99999/hcp-server/internal/cron"
+	"github.com/fffeng99999/hcp-server/internal/models"
+	"github.com/fffeng99999/hcp-server/internal/repository"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const defaultScheduleInterval = 15 * time.Second
+
+var ErrInvalidSchedule = errors.New("invalid benchmark schedule")
+
+// BenchmarkScheduleService manages schedules and fires them.
+type BenchmarkScheduleService interface {
+	// Create validates the schedule and computes its first run.
+	Create(ctx context.Context, schedule *models.BenchmarkSchedule) (*models.BenchmarkSchedule, error)
+	Get(ctx context.Context, id string) (*models.BenchmarkSchedule, error)
+	List(ctx context.Context, page, pageSize int) ([]models.BenchmarkSchedule, int64, error)
+	// Update saves a modified schedule and recomputes its next run.
+	Update(ctx context.Context, schedule *models.BenchmarkSchedule) (*models.BenchmarkSchedule, error)
+	Delete(ctx context.Context, id string) error
+	// Run queues the benchmarks of due schedules until ctx is done. Several
+	// server instances may run it against the same database.
+	Run(ctx context.Context)
+}
+
+type benchmarkScheduleService struct {
+	repo       repository.BenchmarkScheduleRepository
+	templates  BenchmarkTemplateService
+	benchmarks BenchmarkService
+	queue      BenchmarkQueue
+	interval   time.Duration
+	now        func() time.Time
+}
+
+func NewBenchmarkScheduleService(repo repository.BenchmarkScheduleRepository, templates BenchmarkTemplateService, benchmarks BenchmarkService, queue BenchmarkQueue, interval time.Duration) BenchmarkScheduleService {
+	if interval <= 0 {
+		interval = defaultScheduleInterval
+	}
+	return &benchmarkScheduleService{
+		repo:       repo,
+		templates:  templates,
+		benchmarks: benchmarks,
+		queue:      queue,
+		interval:   interval,
+		now:        time.Now,
+	}
+}
+
+func (s *benchmarkScheduleService) Create(ctx context.Context, schedule *models.BenchmarkSchedule) (*models.BenchmarkSchedule, error) {
+	if schedule.Timezone == "" {
+		schedule.Timezone = "UTC"
+	}
+	if err := validateSchedule(schedule); err != nil {
+		return nil, err
+	}
+	if schedule.TemplateID != nil {
+		if _, err := s.templates.Get(ctx, schedule.TemplateID.String()); err != nil {
+			return nil, err
+		}
+	}
+
+	var err error
+	if schedule.NextRunAt, err = nextRun(schedule, s.now().UTC()); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Create(ctx, schedule); err != nil {
+		return nil, err
+	}
+	return schedule, nil
+}
+
+func (s *benchmarkScheduleService) Get(ctx context.Context, id string) (*models.BenchmarkSchedule, error) {
+	return s.repo.GetByID(ctx, id)
+}
+
+func (s *benchmarkScheduleService) List(ctx context.Context, page, pageSize int) ([]models.BenchmarkSchedule, int64, error) {
+	return s.repo.List(ctx, page, pageSize)
+}
+
+func (s *benchmarkScheduleService) Update(ctx context.Context, schedule *models.BenchmarkSchedule) (*models.BenchmarkSchedule, error) {
+	if err := validateSchedule(schedule); err != nil {
+		return nil, err
+	}
+	var err error
+	if schedule.NextRunAt, err = nextRun(schedule, s.now().UTC()); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Update(ctx, schedule); err != nil {
+		return nil, err
+	}
+	return s.repo.GetByID(ctx, schedule.ID.String())
+}
+
+func (s *benchmarkScheduleService) Delete(ctx context.Context, id string) error {
+	return s.repo.Delete(ctx, id)
+}
+
+func (s *benchmarkScheduleService) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.fireDue(ctx)
+		}
+	}
+}
+
+// fireDue fires every schedule whose next run has come. A schedule that was
+// due several times while no server was running fires once.
+func (s *benchmarkScheduleService) fireDue(ctx context.Context) {
+	// The schedule columns hold UTC without a zone, and the driver writes a
+	// time's wall clock in its own location.
+	now := s.now().UTC()
+	due, err := s.repo.ListDue(ctx, now)
+	if err != nil {
+		serviceLogger().Warn("Failed to list due schedules", zap.Error(err))
+		return
+	}
+
+	for i := range due {
+		schedule := &due[i]
+		id := schedule.ID.String()
+		next, err := nextRun(schedule, now)
+		if err != nil {
+			// The definition was valid when saved, so this is an
+			// environment problem such as missing zone data. The run still
+			// fires, but without a next run the schedule stops there.
+			serviceLogger().Warn("Failed to compute next schedule run", zap.String("schedule_id", id), zap.Error(err))
+		}
+		claimed, err := s.repo.Claim(ctx, id, *schedule.NextRunAt, next, now)
+		if err != nil {
+			serviceLogger().Warn("Failed to claim schedule", zap.String("schedule_id", id), zap.Error(err))
+			continue
+		}
+		if claimed {
+			s.fire(ctx, schedule, now)
+		}
+	}
+}
+
+// fire queues one run of the schedule and records the outcome.
+func (s *benchmarkScheduleService) fire(ctx context.Context, schedule *models.BenchmarkSchedule, now time.Time) {
+	id := schedule.ID.String()
+	benchmarkID, err := s.enqueue(ctx, schedule, now)
+	runErr := ""
+	if err != nil {
+		runErr = err.Error()
+		serviceLogger().Warn("Failed to queue scheduled benchmark", zap.String("schedule_id", id), zap.Error(err))
+	} else {
+		serviceLogger().Info("Scheduled benchmark queued", zap.String("schedule_id", id), zap.String("benchmark_id", benchmarkID))
+	}
+	if err := s.repo.RecordRun(ctx, id, benchmarkID, runErr); err != nil {
+		serviceLogger().Warn("Failed to record schedule run", zap.String("schedule_id", id), zap.Error(err))
+	}
+}
+
+func (s *benchmarkScheduleService) enqueue(ctx context.Context, schedule *models.BenchmarkSchedule, now time.Time) (string, error) {
+	var b *models.Benchmark
+	if schedule.TemplateID != nil {
+		var err error
+		if b, err = s.templates.Instantiate(ctx, schedule.TemplateID.String()); err != nil {
+			return "", err
+		}
+	} else {
+		b = instantiateTemplate(scheduleTemplate(schedule), now)
+		b.TemplateID = nil
+	}
+	applySchedule(b, schedule)
+
+	created, err := s.benchmarks.Create(ctx, b)
+	if err != nil {
+		return "", err
+	}
+	s.queue.Notify()
+	return created.ID.String(), nil
+}
+
+// scheduleTemplate views a schedule's own configuration as a template.
+func scheduleTemplate(s *models.BenchmarkSchedule) *models.BenchmarkTemplate {
+	return &models.BenchmarkTemplate{
+		Name:             s.Name,
+		Description:      s.Description,
+		NamePattern:      s.NamePattern,
+		Algorithm:        s.Algorithm,
+		NodeCount:        s.NodeCount,
+		Duration:         s.Duration,
+		TargetTPS:        s.TargetTPS,
+		WarmupDuration:   s.WarmupDuration,
+		CooldownDuration: s.CooldownDuration,
+		ConsensusConfig:  s.ConsensusConfig,
+		ConsensusParams:  s.ConsensusParams,
+	}
+}
+
+// applySchedule marks a benchmark as started by the schedule.
+func applySchedule(b *models.Benchmark, s *models.BenchmarkSchedule) {
+	scheduleID := s.ID
+	b.ScheduleID = &scheduleID
+	switch {
+	case s.RunGroup != "":
+		b.RunGroup = s.RunGroup
+	case b.RunGroup == "":
+		b.RunGroup = s.Name
+	}
+	b.Tags = append(b.Tags, s.Tags...)
+}
+
+func validateSchedule(s *models.BenchmarkSchedule) error {
+	if s.Name == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidSchedule)
+	}
+	if _, err := cron.Parse(s.CronExpr); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidSchedule, err)
+	}
+	if _, err := time.LoadLocation(s.Timezone); err != nil {
+		return fmt.Errorf("%w: unknown timezone %q", ErrInvalidSchedule, s.Timezone)
+	}
+	if s.TemplateID != nil {
+		if *s.TemplateID == uuid.Nil {
+			return fmt.Errorf("%w: invalid template id", ErrInvalidSchedule)
+		}
+		return nil
+	}
+	if err := validateTemplate(scheduleTemplate(s)); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidSchedule, err)
+	}
+	return nil
+}
+
+// nextRun is the schedule's first activation after now, in UTC, or nil for
+// disabled schedules and expressions that never fire.
+func nextRun(s *models.BenchmarkSchedule, now time.Time) (*time.Time, error) {
+	if !s.Enabled {
+		return nil, nil
+	}
+	expr, err := cron.Parse(s.CronExpr)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSchedule, err)
+	}
+	loc, err := time.LoadLocation(s.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unknown timezone %q", ErrInvalidSchedule, s.Timezone)
+	}
+	next := expr.Next(now.In(loc))
+	if next.IsZero() {
+		return nil, nil
+	}
+	next = next.UTC()
+	return &next, nil
+}
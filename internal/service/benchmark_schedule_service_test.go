@@ -0,0 +1,148 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fffeng99999/hcp-server/internal/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNextRun(t *testing.T) {
+	schedule := &models.BenchmarkSchedule{
+		CronExpr: "30 2 * * *",
+		Timezone: "Asia/Shanghai",
+		Enabled:  true,
+	}
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC) // 20:00 in Shanghai
+
+	next, err := nextRun(schedule, now)
+	require.NoError(t, err)
+	require.NotNil(t, next)
+	assert.Equal(t, time.Date(2026, 3, 1, 18, 30, 0, 0, time.UTC), *next)
+	assert.Equal(t, time.UTC, next.Location())
+
+	schedule.Enabled = false
+	next, err = nextRun(schedule, now)
+	assert.NoError(t, err)
+	assert.Nil(t, next)
+}
+
+func TestValidateSchedule(t *testing.T) {
+	schedule := &models.BenchmarkSchedule{
+		Name:      "nightly-raft",
+		CronExpr:  "@daily",
+		Timezone:  "UTC",
+		Algorithm: "Raft",
+		NodeCount: 4,
+		Duration:  60,
+	}
+	assert.NoError(t, validateSchedule(schedule))
+
+	schedule.CronExpr = "61 * * * *"
+	assert.ErrorIs(t, validateSchedule(schedule), ErrInvalidSchedule)
+
+	schedule.CronExpr = "@daily"
+	schedule.Timezone = "Mars/Olympus"
+	assert.ErrorIs(t, validateSchedule(schedule), ErrInvalidSchedule)
+
+	// A template supplies the configuration, so the schedule needs none.
+	templateID := uuid.New()
+	assert.NoError(t, validateSchedule(&models.BenchmarkSchedule{Name: "t", CronExpr: "@hourly", Timezone: "UTC", TemplateID: &templateID}))
+}
+
+func TestApplySchedule(t *testing.T) {
+	schedule := &models.BenchmarkSchedule{ID: uuid.New(), Name: "nightly-raft", Tags: []string{"nightly"}}
+
+	b := &models.Benchmark{Tags: []string{"raft"}}
+	applySchedule(b, schedule)
+	assert.Equal(t, schedule.ID, *b.ScheduleID)
+	assert.Equal(t, "nightly-raft", b.RunGroup)
+	assert.Equal(t, []string{"raft", "nightly"}, []string(b.Tags))
+
+	// A template's run group is kept unless the schedule sets its own.
+	b = &models.Benchmark{RunGroup: "baseline"}
+	applySchedule(b, schedule)
+	assert.Equal(t, "baseline", b.RunGroup)
+
+	schedule.RunGroup = "regression"
+	applySchedule(b, schedule)
+	assert.Equal(t, "regression", b.RunGroup)
+}
+
+type MockBenchmarkScheduleRepository struct {
+	mock.Mock
+}
+
+func (m *MockBenchmarkScheduleRepository) Create(ctx context.Context, schedule *models.BenchmarkSchedule) error {
+	return m.Called(ctx, schedule).Error(0)
+}
+
+func (m *MockBenchmarkScheduleRepository) GetByID(ctx context.Context, id string) (*models.BenchmarkSchedule, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(*models.BenchmarkSchedule), args.Error(1)
+}
+
+func (m *MockBenchmarkScheduleRepository) List(ctx context.Context, page, pageSize int) ([]models.BenchmarkSchedule, int64, error) {
+	args := m.Called(ctx, page, pageSize)
+	return args.Get(0).([]models.BenchmarkSchedule), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockBenchmarkScheduleRepository) Update(ctx context.Context, schedule *models.BenchmarkSchedule) error {
+	return m.Called(ctx, schedule).Error(0)
+}
+
+func (m *MockBenchmarkScheduleRepository) Delete(ctx context.Context, id string) error {
+	return m.Called(ctx, id).Error(0)
+}
+
+func (m *MockBenchmarkScheduleRepository) ListDue(ctx context.Context, now time.Time) ([]models.BenchmarkSchedule, error) {
+	args := m.Called(ctx, now)
+	return args.Get(0).([]models.BenchmarkSchedule), args.Error(1)
+}
+
+func (m *MockBenchmarkScheduleRepository) Claim(ctx context.Context, id string, due time.Time, next *time.Time, now time.Time) (bool, error) {
+	args := m.Called(ctx, id, due, next, now)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockBenchmarkScheduleRepository) RecordRun(ctx context.Context, id, benchmarkID, runErr string) error {
+	return m.Called(ctx, id, benchmarkID, runErr).Error(0)
+}
+
+// isUTC matches times that the driver writes as UTC wall clock.
+func isUTC(t time.Time) bool {
+	return t.Location() == time.UTC
+}
+
+func TestBenchmarkScheduleService_UsesUTCOnHostsInOtherZones(t *testing.T) {
+	repo := new(MockBenchmarkScheduleRepository)
+	svc := NewBenchmarkScheduleService(repo, nil, nil, nil, 0).(*benchmarkScheduleService)
+	now := time.Date(2026, 3, 1, 20, 0, 0, 0, time.FixedZone("CST", 8*3600))
+	svc.now = func() time.Time { return now }
+
+	ctx := context.Background()
+	due := time.Date(2026, 3, 1, 11, 59, 0, 0, time.UTC)
+	schedule := models.BenchmarkSchedule{ID: uuid.New(), CronExpr: "0 * * * *", Timezone: "UTC", Enabled: true, NextRunAt: &due}
+	repo.On("ListDue", ctx, mock.MatchedBy(isUTC)).Return([]models.BenchmarkSchedule{schedule}, nil)
+	repo.On("Claim", ctx, schedule.ID.String(), due,
+		mock.MatchedBy(func(next *time.Time) bool {
+			return isUTC(*next) && next.Equal(time.Date(2026, 3, 1, 13, 0, 0, 0, time.UTC))
+		}),
+		mock.MatchedBy(func(at time.Time) bool { return isUTC(at) && at.Equal(now) })).
+		Return(false, nil)
+
+	svc.fireDue(ctx)
+	repo.AssertExpectations(t)
+
+	repo.On("Create", ctx, mock.Anything).Return(nil)
+	created, err := svc.Create(ctx, &models.BenchmarkSchedule{
+		Name: "hourly", CronExpr: "0 * * * *", Enabled: true, Algorithm: "Raft", NodeCount: 4, Duration: 60,
+	})
+	require.NoError(t, err)
+	assert.True(t, isUTC(*created.NextRunAt))
+}
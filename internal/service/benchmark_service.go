@@ -2,17 +2,30 @@ package service
 
 import (
 	"context"
+	"errors"
+	"strings"
+	"time"
 
 	"github.com/fffeng99999/hcp-server/internal/models"
 	"github.com/fffeng99999/hcp-server/internal/repository"
+	"github.com/lib/pq"
 )
 
 type BenchmarkService interface {
 	Create(ctx context.Context, req *models.Benchmark) (*models.Benchmark, error)
 	Get(ctx context.Context, id string) (*models.Benchmark, error)
-	List(ctx context.Context, page, pageSize int) ([]models.Benchmark, int64, error)
+	List(ctx context.Context, filter repository.BenchmarkFilter, page, pageSize int) ([]models.Benchmark, int64, error)
+	// Update writes the given columns, guarded by expectedUpdatedAt when set.
+	// Status changes go through BenchmarkOrchestrator.Transition instead.
+	Update(ctx context.Context, id string, updates map[string]interface{}, expectedUpdatedAt *time.Time) (*models.Benchmark, error)
+	Delete(ctx context.Context, id string) error
+	// Clone creates a queued run with the configuration of an existing one.
+	// An empty name or run group keeps the source's.
+	Clone(ctx context.Context, id, name, runGroup string) (*models.Benchmark, error)
 }
 
+var ErrBenchmarkActive = errors.New("benchmark is active; cancel it first")
+
 type benchmarkService struct {
 	repo repository.BenchmarkRepository
 }
@@ -22,6 +35,18 @@ func NewBenchmarkService(repo repository.BenchmarkRepository) BenchmarkService {
 }
 
 func (s *benchmarkService) Create(ctx context.Context, req *models.Benchmark) (*models.Benchmark, error) {
+	// New runs always enter the lifecycle at the start, in their cluster's
+	// queue; the dispatcher and orchestrator move them on.
+	req.Status = models.BenchmarkStatusQueued
+	// Viper lowercases the keys of benchmark.queue.clusters, so names are
+	// stored lowercased to find their limit.
+	req.Cluster = strings.ToLower(req.Cluster)
+	if req.Cluster == "" {
+		req.Cluster = models.DefaultCluster
+	}
+	if err := validateAssertions(req.Assertions); err != nil {
+		return nil, err
+	}
 	if err := s.repo.Create(ctx, req); err != nil {
 		return nil, err
 	}
@@ -32,6 +57,73 @@ func (s *benchmarkService) Get(ctx context.Context, id string) (*models.Benchmar
 	return s.repo.GetByID(ctx, id)
 }
 
-func (s *benchmarkService) List(ctx context.Context, page, pageSize int) ([]models.Benchmark, int64, error) {
-	return s.repo.List(ctx, page, pageSize)
+func (s *benchmarkService) List(ctx context.Context, filter repository.BenchmarkFilter, page, pageSize int) ([]models.Benchmark, int64, error) {
+	return s.repo.List(ctx, filter, page, pageSize)
+}
+
+func (s *benchmarkService) Update(ctx context.Context, id string, updates map[string]interface{}, expectedUpdatedAt *time.Time) (*models.Benchmark, error) {
+	if _, ok := updates["status"]; ok {
+		return nil, errors.New("status cannot be updated directly")
+	}
+	if err := s.repo.UpdateFields(ctx, id, expectedUpdatedAt, updates); err != nil {
+		return nil, err
+	}
+	return s.repo.GetByID(ctx, id)
+}
+
+func (s *benchmarkService) Delete(ctx context.Context, id string) error {
+	b, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	for _, active := range activeBenchmarkStatuses {
+		if b.Status == active {
+			return ErrBenchmarkActive
+		}
+	}
+	return s.repo.Delete(ctx, id)
+}
+
+func (s *benchmarkService) Clone(ctx context.Context, id, name, runGroup string) (*models.Benchmark, error) {
+	source, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.Create(ctx, cloneBenchmark(source, name, runGroup))
+}
+
+// cloneBenchmark copies the configuration of a run, leaving its results,
+// lifecycle and environment snapshot behind; the environment is recorded
+// afresh by whatever executes the clone.
+func cloneBenchmark(source *models.Benchmark, name, runGroup string) *models.Benchmark {
+	if name == "" {
+		name = source.Name
+	}
+	if runGroup == "" {
+		runGroup = source.RunGroup
+	}
+	params := make(map[string]string, len(source.ConsensusParams))
+	for k, v := range source.ConsensusParams {
+		params[k] = v
+	}
+	sourceID := source.ID
+	return &models.Benchmark{
+		Name:             name,
+		Description:      source.Description,
+		Algorithm:        source.Algorithm,
+		NodeCount:        source.NodeCount,
+		Duration:         source.Duration,
+		TargetTPS:        source.TargetTPS,
+		WarmupDuration:   source.WarmupDuration,
+		CooldownDuration: source.CooldownDuration,
+		ConsensusConfig:  source.ConsensusConfig,
+		ConsensusParams:  params,
+		RunGroup:         runGroup,
+		Tags:             append(pq.StringArray(nil), source.Tags...),
+		Assertions:       append(pq.StringArray(nil), source.Assertions...),
+		TemplateID:       source.TemplateID,
+		ClonedFromID:     &sourceID,
+		Priority:         source.Priority,
+		Cluster:          source.Cluster,
+	}
 }
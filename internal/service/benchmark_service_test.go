@@ -4,9 +4,11 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/fffeng99999/hcp-server/internal/models"
+	"github.com/fffeng99999/hcp-server/internal/repository"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -29,8 +31,8 @@ func (m *MockBenchmarkRepository) GetByID(ctx context.Context, id string) (*mode
 	return args.Get(0).(*models.Benchmark), args.Error(1)
 }
 
-func (m *MockBenchmarkRepository) List(ctx context.Context, page, pageSize int) ([]models.Benchmark, int64, error) {
-	args := m.Called(ctx, page, pageSize)
+func (m *MockBenchmarkRepository) List(ctx context.Context, filter repository.BenchmarkFilter, page, pageSize int) ([]models.Benchmark, int64, error) {
+	args := m.Called(ctx, filter, page, pageSize)
 	return args.Get(0).([]models.Benchmark), args.Get(1).(int64), args.Error(2)
 }
 
@@ -39,11 +41,31 @@ func (m *MockBenchmarkRepository) Update(ctx context.Context, benchmark *models.
 	return args.Error(0)
 }
 
+func (m *MockBenchmarkRepository) UpdateFields(ctx context.Context, id string, expectedUpdatedAt *time.Time, updates map[string]interface{}) error {
+	args := m.Called(ctx, id, expectedUpdatedAt, updates)
+	return args.Error(0)
+}
+
 func (m *MockBenchmarkRepository) Delete(ctx context.Context, id string) error {
 	args := m.Called(ctx, id)
 	return args.Error(0)
 }
 
+func (m *MockBenchmarkRepository) UpdateStatus(ctx context.Context, id, from, to string, expectedUpdatedAt *time.Time, updates map[string]interface{}) error {
+	args := m.Called(ctx, id, from, to, expectedUpdatedAt, updates)
+	return args.Error(0)
+}
+
+func (m *MockBenchmarkRepository) ListByRunGroup(ctx context.Context, runGroup string) ([]models.Benchmark, error) {
+	args := m.Called(ctx, runGroup)
+	return args.Get(0).([]models.Benchmark), args.Error(1)
+}
+
+func (m *MockBenchmarkRepository) ListByStatus(ctx context.Context, statuses ...string) ([]models.Benchmark, error) {
+	args := m.Called(ctx, statuses)
+	return args.Get(0).([]models.Benchmark), args.Error(1)
+}
+
 func TestBenchmarkService_Create(t *testing.T) {
 	mockRepo := new(MockBenchmarkRepository)
 	svc := NewBenchmarkService(mockRepo)
@@ -65,9 +87,26 @@ func TestBenchmarkService_Create(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, created)
 	assert.Equal(t, "Test Benchmark", created.Name)
+	assert.Equal(t, models.BenchmarkStatusQueued, created.Status)
 	mockRepo.AssertExpectations(t)
 }
 
+func TestBenchmarkService_CreateLowercasesCluster(t *testing.T) {
+	mockRepo := new(MockBenchmarkRepository)
+	svc := NewBenchmarkService(mockRepo)
+
+	ctx := context.Background()
+	mockRepo.On("Create", ctx, mock.Anything).Return(nil)
+
+	created, err := svc.Create(ctx, &models.Benchmark{Name: "gpu", Algorithm: "Raft", NodeCount: 4, Cluster: "GPU-A"})
+	assert.NoError(t, err)
+	assert.Equal(t, "gpu-a", created.Cluster)
+
+	created, err = svc.Create(ctx, &models.Benchmark{Name: "default", Algorithm: "Raft", NodeCount: 4})
+	assert.NoError(t, err)
+	assert.Equal(t, models.DefaultCluster, created.Cluster)
+}
+
 func TestBenchmarkService_Get(t *testing.T) {
 	mockRepo := new(MockBenchmarkRepository)
 	svc := NewBenchmarkService(mockRepo)
@@ -109,3 +148,96 @@ func TestBenchmarkService_Get_NotFound(t *testing.T) {
 	assert.Nil(t, result)
 	mockRepo.AssertExpectations(t)
 }
+
+func TestBenchmarkService_Update(t *testing.T) {
+	mockRepo := new(MockBenchmarkRepository)
+	svc := NewBenchmarkService(mockRepo)
+
+	ctx := context.Background()
+	id := uuid.New().String()
+	expected := time.Now()
+	updates := map[string]interface{}{"name": "Renamed"}
+
+	// Expectation
+	mockRepo.On("UpdateFields", ctx, id, &expected, updates).Return(nil)
+	mockRepo.On("GetByID", ctx, id).Return(&models.Benchmark{Name: "Renamed"}, nil)
+
+	// Action
+	result, err := svc.Update(ctx, id, updates, &expected)
+
+	// Assertion
+	assert.NoError(t, err)
+	assert.Equal(t, "Renamed", result.Name)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestBenchmarkService_Update_RejectsStatus(t *testing.T) {
+	mockRepo := new(MockBenchmarkRepository)
+	svc := NewBenchmarkService(mockRepo)
+
+	// Action
+	_, err := svc.Update(context.Background(), uuid.New().String(), map[string]interface{}{"status": "completed"}, nil)
+
+	// Assertion
+	assert.Error(t, err)
+	mockRepo.AssertNotCalled(t, "UpdateFields")
+}
+
+func TestBenchmarkService_Delete_ActiveRun(t *testing.T) {
+	mockRepo := new(MockBenchmarkRepository)
+	svc := NewBenchmarkService(mockRepo)
+
+	ctx := context.Background()
+	id := uuid.New().String()
+
+	// Expectation
+	mockRepo.On("GetByID", ctx, id).Return(&models.Benchmark{Status: models.BenchmarkStatusRunning}, nil)
+
+	// Action
+	err := svc.Delete(ctx, id)
+
+	// Assertion
+	assert.ErrorIs(t, err, ErrBenchmarkActive)
+	mockRepo.AssertNotCalled(t, "Delete", ctx, id)
+}
+
+func TestBenchmarkService_Clone(t *testing.T) {
+	mockRepo := new(MockBenchmarkRepository)
+	svc := NewBenchmarkService(mockRepo)
+
+	ctx := context.Background()
+	source := &models.Benchmark{
+		ID:              uuid.New(),
+		Name:            "nightly",
+		Algorithm:       "tPBFT",
+		NodeCount:       16,
+		Duration:        300,
+		TargetTPS:       5000,
+		RunGroup:        "nightly-tpbft",
+		ConsensusConfig: models.ConsensusConfig{BlockSize: 1000, Network: models.NetworkEmulation{LatencyMs: 50}},
+		ConsensusParams: map[string]string{"batch_size": "500"},
+		Environment:     models.Environment{GitCommit: "9f1c2ab"},
+		Status:          models.BenchmarkStatusCompleted,
+		ActualTPS:       4870,
+	}
+
+	// Expectation
+	mockRepo.On("GetByID", ctx, source.ID.String()).Return(source, nil)
+	mockRepo.On("Create", ctx, mock.AnythingOfType("*models.Benchmark")).Return(nil)
+
+	// Action
+	clone, err := svc.Clone(ctx, source.ID.String(), "", "")
+
+	// Assertion
+	assert.NoError(t, err)
+	assert.Equal(t, "nightly", clone.Name)
+	assert.Equal(t, "nightly-tpbft", clone.RunGroup)
+	assert.Equal(t, 16, clone.NodeCount)
+	assert.Equal(t, source.ConsensusConfig, clone.ConsensusConfig)
+	assert.Equal(t, "500", clone.ConsensusParams["batch_size"])
+	assert.Empty(t, clone.Environment.GitCommit)
+	assert.Equal(t, models.BenchmarkStatusQueued, clone.Status)
+	assert.Zero(t, clone.ActualTPS)
+	assert.Equal(t, source.ID, *clone.ClonedFromID)
+	mockRepo.AssertExpectations(t)
+}
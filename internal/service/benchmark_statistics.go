@@ -0,0 +1,161 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/fffeng99999/hcp-server/internal/repository"
+	"github.com/fffeng99999/hcp-server/internal/stats"
+)
+
+const (
+	VerdictImprovement  = "improvement"
+	VerdictRegression   = "regression"
+	VerdictInconclusive = "inconclusive"
+)
+
+const (
+	defaultConfidenceLevel     = 0.95
+	defaultSignificanceAlpha   = 0.05
+	defaultBootstrapIterations = 1000
+	defaultMaxSamples          = 10000
+)
+
+var ErrEmptyGroup = errors.New("benchmark group is empty")
+
+// BenchmarkGroup selects the runs forming one sample, either by run group or
+// by explicit benchmark IDs.
+type BenchmarkGroup struct {
+	RunGroup     string
+	BenchmarkIDs []string
+}
+
+type SignificanceOptions struct {
+	ConfidenceLevel     float64
+	Alpha               float64
+	BootstrapIterations int
+	// MaxSamples caps the latencies drawn per group.
+	MaxSamples int
+	Seed       int64
+}
+
+// GroupStatistics describes the per-transaction latency sample of a group.
+type GroupStatistics struct {
+	BenchmarkIDs []string
+	SampleSize   int
+	Mean         float64
+	MeanCI       stats.Interval
+	Median       float64
+	P99          float64
+	P99CI        stats.Interval
+}
+
+type GroupComparison struct {
+	Baseline    GroupStatistics
+	Candidate   GroupStatistics
+	MannWhitney stats.MannWhitneyResult
+	// Verdict is from the candidate's point of view; lower latency is an
+	// improvement.
+	Verdict string
+}
+
+type BenchmarkStatistics interface {
+	CompareGroups(ctx context.Context, baseline, candidate BenchmarkGroup, opts SignificanceOptions) (*GroupComparison, error)
+}
+
+type benchmarkStatistics struct {
+	benchmarkRepo   repository.BenchmarkRepository
+	transactionRepo repository.TransactionRepository
+}
+
+func NewBenchmarkStatistics(benchmarkRepo repository.BenchmarkRepository, transactionRepo repository.TransactionRepository) BenchmarkStatistics {
+	return &benchmarkStatistics{benchmarkRepo: benchmarkRepo, transactionRepo: transactionRepo}
+}
+
+func (s *benchmarkStatistics) CompareGroups(ctx context.Context, baseline, candidate BenchmarkGroup, opts SignificanceOptions) (*GroupComparison, error) {
+	opts = withSignificanceDefaults(opts)
+
+	baseIDs, baseSamples, err := s.samples(ctx, baseline, opts.MaxSamples)
+	if err != nil {
+		return nil, err
+	}
+	candIDs, candSamples, err := s.samples(ctx, candidate, opts.MaxSamples)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &GroupComparison{
+		Baseline:    describeGroup(baseIDs, baseSamples, opts),
+		Candidate:   describeGroup(candIDs, candSamples, opts),
+		MannWhitney: stats.MannWhitneyU(baseSamples, candSamples),
+	}
+	result.Verdict = verdict(result.MannWhitney, opts.Alpha)
+	return result, nil
+}
+
+func (s *benchmarkStatistics) samples(ctx context.Context, group BenchmarkGroup, limit int) ([]string, []float64, error) {
+	ids := group.BenchmarkIDs
+	if group.RunGroup != "" {
+		benchmarks, err := s.benchmarkRepo.ListByRunGroup(ctx, group.RunGroup)
+		if err != nil {
+			return nil, nil, err
+		}
+		ids = nil
+		for _, b := range benchmarks {
+			ids = append(ids, b.ID.String())
+		}
+	}
+	if len(ids) == 0 {
+		return nil, nil, ErrEmptyGroup
+	}
+
+	samples, err := s.transactionRepo.GetLatencySamples(ctx, ids, limit)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(samples) == 0 {
+		return nil, nil, ErrEmptyGroup
+	}
+	return ids, samples, nil
+}
+
+func withSignificanceDefaults(opts SignificanceOptions) SignificanceOptions {
+	if opts.ConfidenceLevel <= 0 || opts.ConfidenceLevel >= 1 {
+		opts.ConfidenceLevel = defaultConfidenceLevel
+	}
+	if opts.Alpha <= 0 || opts.Alpha >= 1 {
+		opts.Alpha = defaultSignificanceAlpha
+	}
+	if opts.BootstrapIterations <= 0 {
+		opts.BootstrapIterations = defaultBootstrapIterations
+	}
+	if opts.MaxSamples <= 0 {
+		opts.MaxSamples = defaultMaxSamples
+	}
+	return opts
+}
+
+func describeGroup(ids []string, samples []float64, opts SignificanceOptions) GroupStatistics {
+	return GroupStatistics{
+		BenchmarkIDs: ids,
+		SampleSize:   len(samples),
+		Mean:         stats.Mean(samples),
+		MeanCI:       stats.BootstrapCI(samples, stats.Mean, opts.ConfidenceLevel, opts.BootstrapIterations, opts.Seed),
+		Median:       stats.Quantile(samples, 0.5),
+		P99:          stats.P99(samples),
+		P99CI:        stats.BootstrapCI(samples, stats.P99, opts.ConfidenceLevel, opts.BootstrapIterations, opts.Seed),
+	}
+}
+
+// verdict classifies a latency comparison. A positive effect size means the
+// candidate's latencies tend to be higher than the baseline's.
+func verdict(mw stats.MannWhitneyResult, alpha float64) string {
+	switch {
+	case mw.PValue >= alpha || mw.EffectSize == 0:
+		return VerdictInconclusive
+	case mw.EffectSize < 0:
+		return VerdictImprovement
+	default:
+		return VerdictRegression
+	}
+}
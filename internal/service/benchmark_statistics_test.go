@@ -0,0 +1,28 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/fffeng99999/hcp-server/internal/stats"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerdict(t *testing.T) {
+	faster := stats.MannWhitneyU([]float64{10, 11, 12, 13, 14, 15}, []float64{1, 2, 3, 4, 5, 6})
+	slower := stats.MannWhitneyU([]float64{1, 2, 3, 4, 5, 6}, []float64{10, 11, 12, 13, 14, 15})
+	mixed := stats.MannWhitneyU([]float64{1, 5, 9, 13}, []float64{2, 6, 10, 12})
+
+	assert.Equal(t, VerdictImprovement, verdict(faster, 0.05))
+	assert.Equal(t, VerdictRegression, verdict(slower, 0.05))
+	assert.Equal(t, VerdictInconclusive, verdict(mixed, 0.05))
+}
+
+func TestWithSignificanceDefaults(t *testing.T) {
+	opts := withSignificanceDefaults(SignificanceOptions{Alpha: 0.01, Seed: 7})
+
+	assert.Equal(t, defaultConfidenceLevel, opts.ConfidenceLevel)
+	assert.Equal(t, 0.01, opts.Alpha)
+	assert.Equal(t, defaultBootstrapIterations, opts.BootstrapIterations)
+	assert.Equal(t, defaultMaxSamples, opts.MaxSamples)
+	assert.Equal(t, int64(7), opts.Seed)
+}
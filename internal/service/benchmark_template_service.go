@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fffeng99999/hcp-server/internal/models"
+	"github.com/fffeng99999/hcp-server/internal/repository"
+	"github.com/lib/pq"
+)
+
+var ErrInvalidTemplate = errors.New("invalid benchmark template")
+
+type BenchmarkTemplateService interface {
+	Create(ctx context.Context, template *models.BenchmarkTemplate) (*models.BenchmarkTemplate, error)
+	Get(ctx context.Context, id string) (*models.BenchmarkTemplate, error)
+	List(ctx context.Context, page, pageSize int) ([]models.BenchmarkTemplate, int64, error)
+	Delete(ctx context.Context, id string) error
+	// Instantiate builds an unsaved benchmark from the template's settings.
+	Instantiate(ctx context.Context, id string) (*models.Benchmark, error)
+}
+
+type benchmarkTemplateService struct {
+	repo repository.BenchmarkTemplateRepository
+	now  func() time.Time
+}
+
+func NewBenchmarkTemplateService(repo repository.BenchmarkTemplateRepository) BenchmarkTemplateService {
+	return &benchmarkTemplateService{repo: repo, now: time.Now}
+}
+
+func (s *benchmarkTemplateService) Create(ctx context.Context, template *models.BenchmarkTemplate) (*models.BenchmarkTemplate, error) {
+	if err := validateTemplate(template); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Create(ctx, template); err != nil {
+		return nil, err
+	}
+	return template, nil
+}
+
+func (s *benchmarkTemplateService) Get(ctx context.Context, id string) (*models.BenchmarkTemplate, error) {
+	return s.repo.GetByID(ctx, id)
+}
+
+func (s *benchmarkTemplateService) List(ctx context.Context, page, pageSize int) ([]models.BenchmarkTemplate, int64, error) {
+	return s.repo.List(ctx, page, pageSize)
+}
+
+func (s *benchmarkTemplateService) Delete(ctx context.Context, id string) error {
+	return s.repo.Delete(ctx, id)
+}
+
+func (s *benchmarkTemplateService) Instantiate(ctx context.Context, id string) (*models.Benchmark, error) {
+	template, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return instantiateTemplate(template, s.now()), nil
+}
+
+func validateTemplate(t *models.BenchmarkTemplate) error {
+	switch {
+	case t.Name == "":
+		return fmt.Errorf("%w: name is required", ErrInvalidTemplate)
+	case !isSupportedAlgorithm(t.Algorithm):
+		return fmt.Errorf("%w: unsupported algorithm %q", ErrInvalidTemplate, t.Algorithm)
+	case t.NodeCount <= 0 || t.NodeCount > 1000:
+		return fmt.Errorf("%w: node count %d out of range", ErrInvalidTemplate, t.NodeCount)
+	case t.Duration <= 0:
+		return fmt.Errorf("%w: duration must be positive", ErrInvalidTemplate)
+	case t.TargetTPS < 0:
+		return fmt.Errorf("%w: target tps must not be negative", ErrInvalidTemplate)
+	case t.WarmupDuration < 0 || t.CooldownDuration < 0:
+		return fmt.Errorf("%w: warmup and cooldown must not be negative", ErrInvalidTemplate)
+	}
+	return validateAssertions(t.Assertions)
+}
+
+func instantiateTemplate(t *models.BenchmarkTemplate, now time.Time) *models.Benchmark {
+	templateID := t.ID
+	params := make(map[string]string, len(t.ConsensusParams))
+	for k, v := range t.ConsensusParams {
+		params[k] = v
+	}
+	return &models.Benchmark{
+		Name:             renderNamePattern(t, now),
+		Description:      t.Description,
+		Algorithm:        t.Algorithm,
+		NodeCount:        t.NodeCount,
+		Duration:         t.Duration,
+		TargetTPS:        t.TargetTPS,
+		WarmupDuration:   t.WarmupDuration,
+		CooldownDuration: t.CooldownDuration,
+		RunGroup:         t.RunGroup,
+		ConsensusConfig:  t.ConsensusConfig,
+		ConsensusParams:  params,
+		Assertions:       append(pq.StringArray(nil), t.Assertions...),
+		TemplateID:       &templateID,
+	}
+}
+
+// renderNamePattern expands the template's name pattern, falling back to the
+// template name followed by the creation time.
+func renderNamePattern(t *models.BenchmarkTemplate, now time.Time) string {
+	pattern := t.NamePattern
+	if pattern == "" {
+		pattern = "{template} {date} {time}"
+	}
+	return strings.NewReplacer(
+		"{template}", t.Name,
+		"{algorithm}", t.Algorithm,
+		"{node_count}", strconv.Itoa(t.NodeCount),
+		"{target_tps}", strconv.Itoa(t.TargetTPS),
+		"{duration}", strconv.Itoa(t.Duration),
+		"{date}", now.Format("2006-01-02"),
+		"{time}", now.Format("15:04:05"),
+	).Replace(pattern)
+}
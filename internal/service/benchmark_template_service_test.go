@@ -0,0 +1,44 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fffeng99999/hcp-server/internal/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInstantiateTemplate(t *testing.T) {
+	template := &models.BenchmarkTemplate{
+		ID:              uuid.New(),
+		Name:            "raft-baseline",
+		NamePattern:     "{algorithm} n={node_count} {date}",
+		Algorithm:       "Raft",
+		NodeCount:       7,
+		Duration:        120,
+		TargetTPS:       2000,
+		ConsensusParams: map[string]string{"election_timeout": "150ms"},
+	}
+	now := time.Date(2026, 3, 1, 12, 30, 0, 0, time.UTC)
+
+	b := instantiateTemplate(template, now)
+	assert.Equal(t, "Raft n=7 2026-03-01", b.Name)
+	assert.Equal(t, 7, b.NodeCount)
+	assert.Equal(t, template.ID, *b.TemplateID)
+
+	// The run gets its own copy of the parameters.
+	b.ConsensusParams["election_timeout"] = "300ms"
+	assert.Equal(t, "150ms", template.ConsensusParams["election_timeout"])
+
+	template.NamePattern = ""
+	assert.Equal(t, "raft-baseline 2026-03-01 12:30:00", renderNamePattern(template, now))
+}
+
+func TestValidateTemplate(t *testing.T) {
+	template := &models.BenchmarkTemplate{Name: "t", Algorithm: "HotStuff", NodeCount: 4, Duration: 60}
+	assert.NoError(t, validateTemplate(template))
+
+	template.Algorithm = "PoW"
+	assert.ErrorIs(t, validateTemplate(template), ErrInvalidTemplate)
+}
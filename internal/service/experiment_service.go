@@ -0,0 +1,223 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/fffeng99999/hcp-server/internal/models"
+	"github.com/fffeng99999/hcp-server/internal/repository"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// maxExperimentSize bounds how many benchmarks one experiment may expand into.
+const maxExperimentSize = 1000
+
+var ErrInvalidExperiment = errors.New("invalid experiment")
+
+// ExperimentProgress counts an experiment's benchmarks by lifecycle stage.
+type ExperimentProgress struct {
+	Total     int64
+	Queued    int64
+	Active    int64
+	Completed int64
+	Failed    int64
+	Cancelled int64
+}
+
+// Done is the number of benchmarks that reached a terminal state.
+func (p ExperimentProgress) Done() int64 {
+	return p.Completed + p.Failed + p.Cancelled
+}
+
+type ExperimentService interface {
+	// Create expands the experiment matrix into queued benchmarks, which the
+	// queue runs one after another.
+	Create(ctx context.Context, experiment *models.Experiment) (*models.Experiment, error)
+	Get(ctx context.Context, id string) (*models.Experiment, *ExperimentProgress, error)
+	// Grid rolls results up along the given axes; no axes means all of them.
+	Grid(ctx context.Context, id string, axes []string) ([]repository.GridCell, error)
+	// OnTransition tracks experiments as their benchmarks start and finish.
+	// It is meant to be registered with BenchmarkOrchestrator.Subscribe.
+	OnTransition(ctx context.Context, b *models.Benchmark, from string)
+}
+
+type experimentService struct {
+	repo  repository.ExperimentRepository
+	queue BenchmarkQueue
+}
+
+func NewExperimentService(repo repository.ExperimentRepository, queue BenchmarkQueue) ExperimentService {
+	return &experimentService{repo: repo, queue: queue}
+}
+
+func (s *experimentService) Create(ctx context.Context, experiment *models.Experiment) (*models.Experiment, error) {
+	if err := validateExperiment(experiment); err != nil {
+		return nil, err
+	}
+
+	// Child run groups embed the experiment ID, so it is assigned up front.
+	if experiment.ID == uuid.Nil {
+		experiment.ID = uuid.New()
+	}
+	experiment.Status = models.ExperimentStatusQueued
+	benchmarks := expandMatrix(experiment, time.Now())
+	if err := s.repo.Create(ctx, experiment, benchmarks); err != nil {
+		return nil, err
+	}
+
+	s.queue.Notify()
+	return s.repo.GetByID(ctx, experiment.ID.String())
+}
+
+func (s *experimentService) Get(ctx context.Context, id string) (*models.Experiment, *ExperimentProgress, error) {
+	experiment, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	counts, err := s.repo.CountByStatus(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	return experiment, progressFromCounts(counts), nil
+}
+
+func (s *experimentService) Grid(ctx context.Context, id string, axes []string) ([]repository.GridCell, error) {
+	if _, err := s.repo.GetByID(ctx, id); err != nil {
+		return nil, err
+	}
+	if len(axes) == 0 {
+		axes = []string{repository.AxisAlgorithm, repository.AxisNodeCount, repository.AxisTargetTPS}
+	}
+	for _, axis := range axes {
+		switch axis {
+		case repository.AxisAlgorithm, repository.AxisNodeCount, repository.AxisTargetTPS:
+		default:
+			return nil, fmt.Errorf("%w: unknown axis %q", ErrInvalidExperiment, axis)
+		}
+	}
+	return s.repo.GetGrid(ctx, id, axes)
+}
+
+func (s *experimentService) OnTransition(ctx context.Context, b *models.Benchmark, from string) {
+	if b.ExperimentID == nil {
+		return
+	}
+	id := b.ExperimentID.String()
+	if err := s.advance(ctx, id, b, from); err != nil {
+		serviceLogger().Warn("Failed to advance experiment", zap.String("experiment_id", id), zap.Error(err))
+	}
+}
+
+// advance marks the experiment running when its first benchmark starts and
+// completed when none is left queued or active.
+func (s *experimentService) advance(ctx context.Context, id string, b *models.Benchmark, from string) error {
+	if from == models.BenchmarkStatusQueued && b.Status == models.BenchmarkStatusProvisioning {
+		return s.repo.UpdateStatus(ctx, id, models.ExperimentStatusRunning)
+	}
+	if !b.IsTerminal() {
+		return nil
+	}
+	counts, err := s.repo.CountByStatus(ctx, id)
+	if err != nil {
+		return err
+	}
+	if p := progressFromCounts(counts); p.Queued+p.Active > 0 {
+		return nil
+	}
+	return s.repo.UpdateStatus(ctx, id, models.ExperimentStatusCompleted)
+}
+
+func validateExperiment(e *models.Experiment) error {
+	m := e.Matrix
+	switch {
+	case e.Name == "":
+		return fmt.Errorf("%w: name is required", ErrInvalidExperiment)
+	case e.Duration <= 0:
+		return fmt.Errorf("%w: duration must be positive", ErrInvalidExperiment)
+	case e.WarmupDuration < 0 || e.CooldownDuration < 0:
+		return fmt.Errorf("%w: warmup and cooldown must not be negative", ErrInvalidExperiment)
+	case len(m.Algorithms) == 0 || len(m.NodeCounts) == 0 || len(m.TargetTPS) == 0:
+		return fmt.Errorf("%w: algorithms, node_counts and target_tps must not be empty", ErrInvalidExperiment)
+	case m.Repetitions <= 0:
+		return fmt.Errorf("%w: repetitions must be positive", ErrInvalidExperiment)
+	case m.Size() > maxExperimentSize:
+		return fmt.Errorf("%w: matrix expands to %d runs, limit is %d", ErrInvalidExperiment, m.Size(), maxExperimentSize)
+	}
+
+	for _, a := range m.Algorithms {
+		if !isSupportedAlgorithm(a) {
+			return fmt.Errorf("%w: unsupported algorithm %q", ErrInvalidExperiment, a)
+		}
+	}
+	for _, n := range m.NodeCounts {
+		if n <= 0 || n > 1000 {
+			return fmt.Errorf("%w: node count %d out of range", ErrInvalidExperiment, n)
+		}
+	}
+	for _, tps := range m.TargetTPS {
+		if tps <= 0 {
+			return fmt.Errorf("%w: target tps must be positive", ErrInvalidExperiment)
+		}
+	}
+	return nil
+}
+
+func isSupportedAlgorithm(algorithm string) bool {
+	for _, a := range models.SupportedAlgorithms {
+		if a == algorithm {
+			return true
+		}
+	}
+	return false
+}
+
+// expandMatrix creates one queued benchmark per matrix cell and repetition.
+// Repetitions form the outer loop so that repeated runs of a cell are spread
+// over the experiment instead of running back to back, which keeps slow drift
+// in the test cluster from biasing a single cell. Each cell gets its own run
+// group so repetitions can be analysed together. Creation times are staggered
+// by a microsecond so the queue hands the runs out in expansion order.
+func expandMatrix(e *models.Experiment, created time.Time) []*models.Benchmark {
+	m := e.Matrix
+	benchmarks := make([]*models.Benchmark, 0, m.Size())
+	for rep := 1; rep <= m.Repetitions; rep++ {
+		for _, algorithm := range m.Algorithms {
+			for _, nodes := range m.NodeCounts {
+				for _, tps := range m.TargetTPS {
+					cell := fmt.Sprintf("%s-n%d-tps%d", algorithm, nodes, tps)
+					benchmarks = append(benchmarks, &models.Benchmark{
+						Name:             fmt.Sprintf("%s %s #%d", e.Name, cell, rep),
+						Description:      e.Description,
+						Algorithm:        algorithm,
+						NodeCount:        nodes,
+						Duration:         e.Duration,
+						WarmupDuration:   e.WarmupDuration,
+						CooldownDuration: e.CooldownDuration,
+						TargetTPS:        tps,
+						RunGroup:         fmt.Sprintf("%s/%s", e.ID, cell),
+						Status:           models.BenchmarkStatusQueued,
+						CreatedAt:        created.Add(time.Duration(len(benchmarks)) * time.Microsecond),
+					})
+				}
+			}
+		}
+	}
+	return benchmarks
+}
+
+func progressFromCounts(counts map[string]int64) *ExperimentProgress {
+	p := &ExperimentProgress{
+		Queued:    counts[models.BenchmarkStatusQueued],
+		Completed: counts[models.BenchmarkStatusCompleted],
+		Failed:    counts[models.BenchmarkStatusFailed],
+		Cancelled: counts[models.BenchmarkStatusCancelled],
+	}
+	for _, status := range activeBenchmarkStatuses {
+		p.Active += counts[status]
+	}
+	p.Total = p.Queued + p.Active + p.Done()
+	return p
+}
@@ -0,0 +1,73 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fffeng99999/hcp-server/internal/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func validExperiment() *models.Experiment {
+	return &models.Experiment{
+		ID:       uuid.New(),
+		Name:     "sweep",
+		Duration: 60,
+		Matrix: models.ExperimentMatrix{
+			Algorithms:  []string{"tPBFT", "Raft"},
+			NodeCounts:  []int{4, 16},
+			TargetTPS:   []int{1000},
+			Repetitions: 3,
+		},
+	}
+}
+
+func TestExpandMatrix_OneRunPerCellAndRepetition(t *testing.T) {
+	e := validExperiment()
+	created := time.Now()
+	benchmarks := expandMatrix(e, created)
+
+	require.Len(t, benchmarks, 12)
+	first, second := benchmarks[0], benchmarks[1]
+	assert.Equal(t, "sweep tPBFT-n4-tps1000 #1", first.Name)
+	assert.Equal(t, e.ID.String()+"/tPBFT-n4-tps1000", first.RunGroup)
+	assert.Equal(t, models.BenchmarkStatusQueued, first.Status)
+	assert.Equal(t, 60, first.Duration)
+	assert.True(t, second.CreatedAt.After(first.CreatedAt))
+
+	// Repetitions are the outer loop.
+	assert.Equal(t, "sweep tPBFT-n4-tps1000 #2", benchmarks[4].Name)
+	assert.Equal(t, first.RunGroup, benchmarks[4].RunGroup)
+}
+
+func TestValidateExperiment(t *testing.T) {
+	assert.NoError(t, validateExperiment(validExperiment()))
+
+	cases := map[string]func(*models.Experiment){
+		"unknown algorithm": func(e *models.Experiment) { e.Matrix.Algorithms = []string{"PoW"} },
+		"no node counts":    func(e *models.Experiment) { e.Matrix.NodeCounts = nil },
+		"zero repetitions":  func(e *models.Experiment) { e.Matrix.Repetitions = 0 },
+		"negative tps":      func(e *models.Experiment) { e.Matrix.TargetTPS = []int{-1} },
+		"zero duration":     func(e *models.Experiment) { e.Duration = 0 },
+		"too large":         func(e *models.Experiment) { e.Matrix.Repetitions = maxExperimentSize },
+	}
+	for name, mutate := range cases {
+		e := validExperiment()
+		mutate(e)
+		assert.ErrorIs(t, validateExperiment(e), ErrInvalidExperiment, name)
+	}
+}
+
+func TestProgressFromCounts(t *testing.T) {
+	p := progressFromCounts(map[string]int64{
+		models.BenchmarkStatusQueued:    5,
+		models.BenchmarkStatusWarmup:    1,
+		models.BenchmarkStatusCompleted: 3,
+		models.BenchmarkStatusFailed:    1,
+	})
+	assert.Equal(t, int64(10), p.Total)
+	assert.Equal(t, int64(1), p.Active)
+	assert.Equal(t, int64(4), p.Done())
+}
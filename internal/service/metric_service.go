@@ -12,29 +12,47 @@ type MetricService interface {
 	Report(ctx context.Context, metric *models.Metric) error
 	ReportBatch(ctx context.Context, metrics []*models.Metric) error
 	GetNodeMetrics(ctx context.Context, nodeID, metricName string, startTime, endTime time.Time, page, pageSize int) ([]models.Metric, int64, error)
-	GetBenchmarkMetrics(ctx context.Context, benchmarkID, metricName string, page, pageSize int) ([]models.Metric, int64, error)
+	// GetBenchmarkMetrics lists the benchmark's metrics reported inside its
+	// measurement window, or all of them if includeUnmeasured is set.
+	GetBenchmarkMetrics(ctx context.Context, benchmarkID, metricName string, includeUnmeasured bool, page, pageSize int) ([]models.Metric, int64, error)
 }
 
 type metricService struct {
-	repo repository.MetricRepository
+	repo       repository.MetricRepository
+	benchmarks repository.BenchmarkRepository
+	monitor    BenchmarkMonitor
 }
 
-func NewMetricService(repo repository.MetricRepository) MetricService {
-	return &metricService{repo: repo}
+func NewMetricService(repo repository.MetricRepository, benchmarks repository.BenchmarkRepository, monitor BenchmarkMonitor) MetricService {
+	return &metricService{repo: repo, benchmarks: benchmarks, monitor: monitor}
 }
 
 func (s *metricService) Report(ctx context.Context, metric *models.Metric) error {
-	return s.repo.Create(ctx, metric)
+	if err := s.repo.Create(ctx, metric); err != nil {
+		return err
+	}
+	s.monitor.ObserveMetric(metric)
+	return nil
 }
 
 func (s *metricService) ReportBatch(ctx context.Context, metrics []*models.Metric) error {
-	return s.repo.CreateBatch(ctx, metrics)
+	if err := s.repo.CreateBatch(ctx, metrics); err != nil {
+		return err
+	}
+	for _, m := range metrics {
+		s.monitor.ObserveMetric(m)
+	}
+	return nil
 }
 
 func (s *metricService) GetNodeMetrics(ctx context.Context, nodeID, metricName string, startTime, endTime time.Time, page, pageSize int) ([]models.Metric, int64, error) {
 	return s.repo.GetNodeMetrics(ctx, nodeID, metricName, startTime, endTime, page, pageSize)
 }
 
-func (s *metricService) GetBenchmarkMetrics(ctx context.Context, benchmarkID, metricName string, page, pageSize int) ([]models.Metric, int64, error) {
-	return s.repo.GetBenchmarkMetrics(ctx, benchmarkID, metricName, page, pageSize)
+func (s *metricService) GetBenchmarkMetrics(ctx context.Context, benchmarkID, metricName string, includeUnmeasured bool, page, pageSize int) ([]models.Metric, int64, error) {
+	window, err := benchmarkWindow(ctx, s.benchmarks, benchmarkID, includeUnmeasured)
+	if err != nil {
+		return nil, 0, err
+	}
+	return s.repo.GetBenchmarkMetrics(ctx, benchmarkID, metricName, window, page, pageSize)
 }
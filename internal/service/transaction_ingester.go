@@ -0,0 +1,287 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/fffeng99999/hcp-server/internal/config"
+	"github.com/fffeng99999/hcp-server/internal/models"
+	"github.com/fffeng99999/hcp-server/internal/repository"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrInvalidIngest = errors.New("invalid transaction ingest")
+	// ErrIngestStopped is returned by Submit once the ingester shuts down.
+	ErrIngestStopped = errors.New("transaction ingestion is shutting down")
+)
+
+const (
+	defaultIngestBatchSize     = 2000
+	defaultIngestFlushInterval = 20 * time.Millisecond
+	defaultIngestWriters       = 4
+	defaultIngestQueueSize     = 64
+	// maxIngestRows bounds the rows of one submission.
+	maxIngestRows = 10000
+)
+
+// IngestRejection is a row of a submission that was not stored.
+type IngestRejection struct {
+	// Index is the row's position in the submission.
+	Index  int
+	Hash   string
+	Reason string
+}
+
+// IngestResult is the outcome of one submission.
+type IngestResult struct {
+	Accepted int
+	// Rejected is ordered by Index.
+	Rejected []IngestRejection
+}
+
+// IngestTicket resolves to the outcome of a submission once its rows have
+// been written.
+type IngestTicket struct {
+	done   chan struct{}
+	result IngestResult
+}
+
+// Wait blocks until the submission has been written or ctx is done.
+func (t *IngestTicket) Wait(ctx context.Context) (IngestResult, error) {
+	select {
+	case <-t.done:
+		return t.result, nil
+	case <-ctx.Done():
+		return IngestResult{}, ctx.Err()
+	}
+}
+
+func (t *IngestTicket) reject(index int, hash, reason string) {
+	t.result.Rejected = append(t.result.Rejected, IngestRejection{Index: index, Hash: hash, Reason: reason})
+}
+
+func (t *IngestTicket) resolve() {
+	sort.Slice(t.result.Rejected, func(i, j int) bool { return t.result.Rejected[i].Index < t.result.Rejected[j].Index })
+	close(t.done)
+}
+
+// TransactionIngester stores transactions in bulk. Submissions from all
+// callers are queued for a pool of writers, which coalesce them into
+// multi-row inserts. When the writers fall behind the queue fills up and
+// Submit blocks, holding back whoever is submitting.
+type TransactionIngester interface {
+	// Submit validates transactions of a benchmark as Create does and
+	// queues the valid ones, blocking while the queue is full. Invalid rows
+	// are rejected in the ticket's result without being written. Submissions
+	// for finished benchmarks fail with repository.ErrBenchmarkFinished.
+	Submit(ctx context.Context, benchmarkID uuid.UUID, txs []*models.Transaction) (*IngestTicket, error)
+	// Run writes queued submissions until ctx is done. It then stops
+	// accepting submissions and writes the ones still queued.
+	Run(ctx context.Context)
+}
+
+type transactionIngester struct {
+	repo       repository.TransactionRepository
+	benchmarks repository.BenchmarkRepository
+	monitor    BenchmarkMonitor
+	cfg        config.IngestConfig
+	now        func() time.Time
+
+	queue  chan *ingestSubmission
+	mu     sync.RWMutex
+	closed bool
+}
+
+type ingestSubmission struct {
+	rows []*models.Transaction
+	// index is the position of each row in the submission.
+	index  []int
+	ticket *IngestTicket
+}
+
+func NewTransactionIngester(repo repository.TransactionRepository, benchmarks repository.BenchmarkRepository, monitor BenchmarkMonitor, cfg config.IngestConfig) TransactionIngester {
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = defaultIngestBatchSize
+	}
+	if cfg.FlushInterval <= 0 {
+		cfg.FlushInterval = defaultIngestFlushInterval
+	}
+	if cfg.Writers <= 0 {
+		cfg.Writers = defaultIngestWriters
+	}
+	if cfg.QueueSize <= 0 {
+		cfg.QueueSize = defaultIngestQueueSize
+	}
+	return &transactionIngester{
+		repo:       repo,
+		benchmarks: benchmarks,
+		monitor:    monitor,
+		cfg:        cfg,
+		now:        time.Now,
+		queue:      make(chan *ingestSubmission, cfg.QueueSize),
+	}
+}
+
+func (in *transactionIngester) Submit(ctx context.Context, benchmarkID uuid.UUID, txs []*models.Transaction) (*IngestTicket, error) {
+	if len(txs) > maxIngestRows {
+		return nil, fmt.Errorf("%w: at most %d transactions per batch, got %d", ErrInvalidIngest, maxIngestRows, len(txs))
+	}
+	if err := in.checkBenchmark(ctx, benchmarkID); err != nil {
+		return nil, err
+	}
+
+	ticket := &IngestTicket{done: make(chan struct{})}
+	sub := &ingestSubmission{ticket: ticket}
+	seen := make(map[string]bool, len(txs))
+	now := in.now()
+	for i, tx := range txs {
+		// The benchmark is part of the hash.
+		tx.BenchmarkID = benchmarkID
+		if err := prepareTransaction(tx); err != nil {
+			ticket.reject(i, tx.Hash, err.Error())
+			continue
+		}
+		if seen[tx.Hash] {
+			ticket.reject(i, tx.Hash, "duplicate hash in batch")
+			continue
+		}
+		seen[tx.Hash] = true
+		tx.Status = models.TransactionStatusPending
+		if tx.SubmittedAt.IsZero() {
+			tx.SubmittedAt = now
+		}
+		sub.rows = append(sub.rows, tx)
+		sub.index = append(sub.index, i)
+	}
+	if len(sub.rows) == 0 {
+		ticket.resolve()
+		return ticket, nil
+	}
+
+	in.mu.RLock()
+	defer in.mu.RUnlock()
+	if in.closed {
+		return nil, ErrIngestStopped
+	}
+	select {
+	case in.queue <- sub:
+		return ticket, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// checkBenchmark rejects submissions for missing and finished benchmarks.
+// Rows of a benchmark that finishes while they are queued are rejected when
+// they are written.
+func (in *transactionIngester) checkBenchmark(ctx context.Context, id uuid.UUID) error {
+	b, err := in.benchmarks.GetByID(ctx, id.String())
+	if err != nil {
+		return err
+	}
+	if b.IsTerminal() {
+		return fmt.Errorf("%w: %s is %s", repository.ErrBenchmarkFinished, id, b.Status)
+	}
+	return nil
+}
+
+func (in *transactionIngester) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+	for i := 0; i < in.cfg.Writers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			in.writer()
+		}()
+	}
+
+	<-ctx.Done()
+	// Submits blocked on the queue hold the read lock; the writers keep
+	// draining it so they get through before the queue is closed.
+	in.mu.Lock()
+	in.closed = true
+	in.mu.Unlock()
+	close(in.queue)
+	wg.Wait()
+}
+
+// writer collects submissions until it has a full batch or the oldest has
+// waited for FlushInterval, and writes them together.
+func (in *transactionIngester) writer() {
+	var batch []*ingestSubmission
+	var deadline <-chan time.Time
+	rows := 0
+	flush := func() {
+		if len(batch) > 0 {
+			in.write(batch)
+		}
+		batch, deadline, rows = nil, nil, 0
+	}
+	for {
+		select {
+		case sub, ok := <-in.queue:
+			if !ok {
+				flush()
+				return
+			}
+			if len(batch) == 0 {
+				deadline = time.After(in.cfg.FlushInterval)
+			}
+			batch = append(batch, sub)
+			if rows += len(sub.rows); rows >= in.cfg.BatchSize {
+				flush()
+			}
+		case <-deadline:
+			flush()
+		}
+	}
+}
+
+// write inserts the rows of subs and resolves their tickets. Rows still
+// queued at shutdown are written too, so the insert is not tied to Run's
+// context.
+func (in *transactionIngester) write(subs []*ingestSubmission) {
+	var rows []*models.Transaction
+	for _, s := range subs {
+		rows = append(rows, s.rows...)
+	}
+	rowErrs, err := in.repo.CreateBatch(context.Background(), rows)
+	if err != nil && len(subs) > 1 {
+		// Retry one submission at a time so that one bad submission, e.g.
+		// for a benchmark deleted meanwhile, does not fail the others.
+		for _, s := range subs {
+			in.write([]*ingestSubmission{s})
+		}
+		return
+	}
+	if err != nil {
+		serviceLogger().Warn("Failed to write ingested transactions", zap.Int("rows", len(rows)), zap.Error(err))
+	}
+
+	added := make(map[string]int64)
+	i := 0
+	for _, s := range subs {
+		for j, tx := range s.rows {
+			switch {
+			case err != nil:
+				s.ticket.reject(s.index[j], tx.Hash, err.Error())
+			case rowErrs[i] != nil:
+				s.ticket.reject(s.index[j], tx.Hash, describeCreateError(tx, rowErrs[i]).Error())
+			default:
+				s.ticket.result.Accepted++
+				added[tx.BenchmarkID.String()]++
+			}
+			i++
+		}
+		s.ticket.resolve()
+	}
+	for id, n := range added {
+		in.monitor.ObserveStatusChange(id, "", models.TransactionStatusPending, n)
+	}
+}
@@ -0,0 +1,223 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/fffeng99999/hcp-server/internal/chain"
+	"github.com/fffeng99999/hcp-server/internal/config"
+	"github.com/fffeng99999/hcp-server/internal/models"
+	"github.com/fffeng99999/hcp-server/internal/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// ingestRow returns a valid transaction without a hash, so Submit derives it.
+func ingestRow(i int) *models.Transaction {
+	return &models.Transaction{
+		FromAddress: fmt.Sprintf("0x%040x", 1),
+		ToAddress:   fmt.Sprintf("0x%040x", 2),
+		Amount:      10,
+		Nonce:       int64(i),
+	}
+}
+
+func newTestIngester(t *testing.T, repo *MockTransactionRepository, cfg config.IngestConfig) (*transactionIngester, uuid.UUID) {
+	t.Helper()
+	benchmarks := new(MockBenchmarkRepository)
+	id := uuid.New()
+	benchmarks.On("GetByID", mock.Anything, id.String()).Return(&models.Benchmark{ID: id}, nil)
+	benchmarks.On("GetByID", mock.Anything, mock.Anything).Return(nil, errors.New("record not found"))
+	return NewTransactionIngester(repo, benchmarks, NewBenchmarkMonitor(nil, nil), cfg).(*transactionIngester), id
+}
+
+func TestTransactionIngester_CoalescesSubmissions(t *testing.T) {
+	repo := new(MockTransactionRepository)
+	in, id := newTestIngester(t, repo, config.IngestConfig{BatchSize: 3, FlushInterval: time.Hour, Writers: 1})
+	repo.On("CreateBatch", mock.Anything, mock.MatchedBy(func(txs []*models.Transaction) bool { return len(txs) == 4 })).
+		Return([]error{nil, nil, repository.ErrDuplicateTransaction, nil}, nil).Once()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		in.Run(ctx)
+		close(done)
+	}()
+
+	bad := ingestRow(9)
+	bad.ToAddress = bad.FromAddress
+	first, err := in.Submit(ctx, id, []*models.Transaction{ingestRow(1), bad, ingestRow(2)})
+	require.NoError(t, err)
+	second, err := in.Submit(ctx, id, []*models.Transaction{ingestRow(3), ingestRow(3), ingestRow(4)})
+	require.NoError(t, err)
+
+	r1, err := first.Wait(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, r1.Accepted)
+	require.Len(t, r1.Rejected, 1)
+	assert.Equal(t, 1, r1.Rejected[0].Index)
+
+	r2, err := second.Wait(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, r2.Accepted)
+	require.Len(t, r2.Rejected, 2)
+	replayed := ingestRow(3)
+	replayed.BenchmarkID = id
+	hash, _ := chain.TransactionHash(replayed)
+	assert.Equal(t, IngestRejection{Index: 0, Hash: hash, Reason: "transaction " + hash + ": repository: transaction already exists"}, r2.Rejected[0])
+	assert.Equal(t, "duplicate hash in batch", r2.Rejected[1].Reason)
+
+	cancel()
+	<-done
+	repo.AssertExpectations(t)
+}
+
+func TestTransactionIngester_RetriesSubmissionsSeparately(t *testing.T) {
+	repo := new(MockTransactionRepository)
+	in, id := newTestIngester(t, repo, config.IngestConfig{BatchSize: 2, Writers: 1})
+	repo.On("CreateBatch", mock.Anything, mock.MatchedBy(func(txs []*models.Transaction) bool { return len(txs) == 2 })).
+		Return(nil, errors.New("foreign key violation")).Once()
+	repo.On("CreateBatch", mock.Anything, mock.MatchedBy(func(txs []*models.Transaction) bool { return txs[0].Nonce == 1 })).
+		Return([]error{nil}, nil).Once()
+	repo.On("CreateBatch", mock.Anything, mock.MatchedBy(func(txs []*models.Transaction) bool { return txs[0].Nonce == 2 })).
+		Return(nil, errors.New("foreign key violation")).Once()
+
+	ctx := context.Background()
+	first, err := in.Submit(ctx, id, []*models.Transaction{ingestRow(1)})
+	require.NoError(t, err)
+	second, err := in.Submit(ctx, id, []*models.Transaction{ingestRow(2)})
+	require.NoError(t, err)
+
+	runCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	in.Run(runCtx)
+
+	r1, _ := first.Wait(ctx)
+	assert.Equal(t, 1, r1.Accepted)
+	r2, _ := second.Wait(ctx)
+	assert.Zero(t, r2.Accepted)
+	require.Len(t, r2.Rejected, 1)
+	assert.Equal(t, "foreign key violation", r2.Rejected[0].Reason)
+	repo.AssertExpectations(t)
+}
+
+func TestTransactionIngester_Backpressure(t *testing.T) {
+	in, id := newTestIngester(t, new(MockTransactionRepository), config.IngestConfig{QueueSize: 1})
+
+	_, err := in.Submit(context.Background(), id, []*models.Transaction{ingestRow(1)})
+	require.NoError(t, err)
+
+	// Nothing drains the queue, so the next submission waits.
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err = in.Submit(ctx, id, []*models.Transaction{ingestRow(2)})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestTransactionIngester_Rejects(t *testing.T) {
+	repo := new(MockTransactionRepository)
+	in, id := newTestIngester(t, repo, config.IngestConfig{})
+	ctx := context.Background()
+
+	_, err := in.Submit(ctx, uuid.New(), []*models.Transaction{ingestRow(1)})
+	assert.Error(t, err)
+	_, err = in.Submit(ctx, id, make([]*models.Transaction, maxIngestRows+1))
+	assert.ErrorIs(t, err, ErrInvalidIngest)
+
+	// A submission without valid rows resolves without being queued.
+	badHash := ingestRow(1)
+	badHash.Hash = "0x1234"
+	wrongHash := ingestRow(2)
+	other := ingestRow(3)
+	other.BenchmarkID = id
+	wrongHash.Hash, _ = chain.TransactionHash(other)
+	badAddress := ingestRow(4)
+	badAddress.FromAddress = "0x5AAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"
+	ticket, err := in.Submit(ctx, id, []*models.Transaction{badHash, wrongHash, badAddress})
+	require.NoError(t, err)
+	result, err := ticket.Wait(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, result.Accepted)
+	assert.Len(t, result.Rejected, 3)
+
+	runCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	in.Run(runCtx)
+	_, err = in.Submit(ctx, id, []*models.Transaction{ingestRow(2)})
+	assert.ErrorIs(t, err, ErrIngestStopped)
+}
+
+func TestTransactionIngester_RejectsFinishedBenchmark(t *testing.T) {
+	benchmarks := new(MockBenchmarkRepository)
+	id := uuid.New()
+	benchmarks.On("GetByID", mock.Anything, id.String()).Return(&models.Benchmark{ID: id, Status: models.BenchmarkStatusCancelled}, nil)
+	in := NewTransactionIngester(new(MockTransactionRepository), benchmarks, NewBenchmarkMonitor(nil, nil), config.IngestConfig{})
+
+	_, err := in.Submit(context.Background(), id, []*models.Transaction{ingestRow(1)})
+	assert.ErrorIs(t, err, repository.ErrBenchmarkFinished)
+}
+
+// discardRepository accepts every row of a batch without storing it.
+type discardRepository struct {
+	MockTransactionRepository
+}
+
+func (r *discardRepository) CreateBatch(ctx context.Context, txs []*models.Transaction) ([]error, error) {
+	return make([]error, len(txs)), nil
+}
+
+// BenchmarkTransactionIngester measures the ingester without a database: the
+// validation, hashing and coalescing of 1000-row batches with the default
+// configuration. It reports rows per second as tx/s.
+func BenchmarkTransactionIngester(b *testing.B) {
+	const batchSize = 1000
+	benchmarks := new(MockBenchmarkRepository)
+	id := uuid.New()
+	benchmarks.On("GetByID", mock.Anything, id.String()).Return(&models.Benchmark{ID: id}, nil)
+	in := NewTransactionIngester(&discardRepository{}, benchmarks, NewBenchmarkMonitor(nil, nil), config.IngestConfig{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		in.Run(ctx)
+		close(done)
+	}()
+	defer func() {
+		cancel()
+		<-done
+	}()
+
+	batches := make([][]*models.Transaction, b.N)
+	for i := range batches {
+		batches[i] = make([]*models.Transaction, batchSize)
+		for j := range batches[i] {
+			batches[i][j] = ingestRow(i*batchSize + j)
+		}
+	}
+
+	tickets := make(chan *IngestTicket, defaultIngestQueueSize)
+	b.ResetTimer()
+	go func() {
+		defer close(tickets)
+		for _, txs := range batches {
+			ticket, err := in.Submit(ctx, id, txs)
+			if err != nil {
+				b.Error(err)
+				return
+			}
+			tickets <- ticket
+		}
+	}()
+	for ticket := range tickets {
+		result, err := ticket.Wait(ctx)
+		if err != nil || result.Accepted != batchSize {
+			b.Fatalf("accepted %d of %d: %v", result.Accepted, batchSize, err)
+		}
+	}
+	b.ReportMetric(float64(b.N*batchSize)/b.Elapsed().Seconds(), "tx/s")
+}
@@ -2,42 +2,297 @@ package service
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
 
+	"github.com/fffeng99999/hcp-server/internal/chain"
 	"github.com/fffeng99999/hcp-server/internal/models"
 	"github.com/fffeng99999/hcp-server/internal/repository"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
 )
 
+var (
+	ErrInvalidTransaction = errors.New("invalid transaction")
+	ErrInvalidSettlement  = errors.New("invalid transaction settlement")
+	// ErrTransactionSettled is returned when confirming or failing a
+	// transaction that is no longer pending.
+	ErrTransactionSettled = errors.New("transaction is no longer pending")
+)
+
+const (
+	// maxSettleBatch bounds the number of settlements in one Settle call.
+	maxSettleBatch = 1000
+	// maxNonceAttempts bounds how often CreateWithNextNonce retries after
+	// losing a nonce to a concurrent request.
+	maxNonceAttempts = 5
+)
+
+// SettleResult is the outcome of one settlement of a batch: the updated
+// transaction or the reason it was not applied.
+type SettleResult struct {
+	Transaction *models.Transaction
+	Err         error
+}
+
 type TransactionService interface {
+	// Create validates and stores a new transaction. Addresses are stored in
+	// EIP-55 form. An empty hash is derived from the contents; a supplied one
+	// must match them.
+	// The sender's nonce must not have been used in the benchmark before;
+	// replays and duplicate hashes fail with repository.ErrNonceUsed and
+	// repository.ErrDuplicateTransaction. Transactions of finished benchmarks
+	// fail with repository.ErrBenchmarkFinished.
 	Create(ctx context.Context, tx *models.Transaction) (*models.Transaction, error)
+	// CreateWithNextNonce creates a transaction with its sender's next nonce
+	// in the benchmark instead of tx.Nonce. The hash is always derived.
+	CreateWithNextNonce(ctx context.Context, tx *models.Transaction) (*models.Transaction, error)
 	Get(ctx context.Context, hash string) (*models.Transaction, error)
 	List(ctx context.Context, filter repository.TransactionFilter, page, pageSize int) ([]models.Transaction, int64, error)
-	GetStats(ctx context.Context, benchmarkID string) (*repository.TransactionStats, error)
+	// GetStats counts the benchmark's transactions submitted inside its
+	// measurement window, or all of them if includeUnmeasured is set, and the
+	// nonces its senders skipped over the whole run.
+	GetStats(ctx context.Context, benchmarkID string, includeUnmeasured bool) (*repository.TransactionStats, error)
+	// Confirm marks a pending transaction confirmed. ConfirmedAt defaults to
+	// now and the latency is measured from the submission time.
+	Confirm(ctx context.Context, settlement repository.TransactionSettlement) (*models.Transaction, error)
+	// Fail marks a pending transaction failed.
+	Fail(ctx context.Context, settlement repository.TransactionSettlement) (*models.Transaction, error)
+	// Settle confirms or fails many transactions at once. Settlements that
+	// cannot be applied are reported in their result without affecting the
+	// others; the error is for the batch as a whole.
+	Settle(ctx context.Context, settlements []repository.TransactionSettlement) ([]SettleResult, error)
+	// OnTransition fails the pending transactions of cancelled benchmarks. It
+	// is meant to be registered with BenchmarkOrchestrator.Subscribe.
+	OnTransition(ctx context.Context, b *models.Benchmark, from string)
 }
 
 type transactionService struct {
-	repo repository.TransactionRepository
+	repo       repository.TransactionRepository
+	benchmarks repository.BenchmarkRepository
+	monitor    BenchmarkMonitor
+	now        func() time.Time
 }
 
-func NewTransactionService(repo repository.TransactionRepository) TransactionService {
-	return &transactionService{repo: repo}
+func NewTransactionService(repo repository.TransactionRepository, benchmarks repository.BenchmarkRepository, monitor BenchmarkMonitor) TransactionService {
+	return &transactionService{repo: repo, benchmarks: benchmarks, monitor: monitor, now: time.Now}
 }
 
 func (s *transactionService) Create(ctx context.Context, tx *models.Transaction) (*models.Transaction, error) {
-	// Add business logic here if needed (e.g. validation, event publishing)
-	if err := s.repo.Create(ctx, tx); err != nil {
+	if err := prepareTransaction(tx); err != nil {
 		return nil, err
 	}
+	if err := s.repo.Create(ctx, tx); err != nil {
+		return nil, describeCreateError(tx, err)
+	}
+	s.monitor.ObserveTransaction(tx, "")
 	return tx, nil
 }
 
+func (s *transactionService) CreateWithNextNonce(ctx context.Context, tx *models.Transaction) (*models.Transaction, error) {
+	if tx.Hash != "" {
+		return nil, fmt.Errorf("%w: a hash cannot be supplied when the server assigns the nonce", ErrInvalidTransaction)
+	}
+	from, err := chain.ChecksumAddress(tx.FromAddress)
+	if err != nil {
+		return nil, err
+	}
+	for attempt := 1; ; attempt++ {
+		nonce, err := s.repo.NextNonce(ctx, tx.BenchmarkID.String(), from)
+		if err != nil {
+			return nil, err
+		}
+		tx.Nonce, tx.Hash = nonce, ""
+		created, err := s.Create(ctx, tx)
+		// Another request may take the same nonce in between.
+		if errors.Is(err, repository.ErrNonceUsed) && attempt < maxNonceAttempts {
+			continue
+		}
+		return created, err
+	}
+}
+
+// describeCreateError adds the sender and nonce or the hash to the errors
+// Create reports for replays and duplicates.
+func describeCreateError(tx *models.Transaction, err error) error {
+	switch {
+	case errors.Is(err, repository.ErrNonceUsed):
+		return fmt.Errorf("nonce %d of %s: %w", tx.Nonce, tx.FromAddress, err)
+	case errors.Is(err, repository.ErrDuplicateTransaction):
+		return fmt.Errorf("transaction %s: %w", tx.Hash, err)
+	}
+	return err
+}
+
 func (s *transactionService) Get(ctx context.Context, hash string) (*models.Transaction, error) {
-	return s.repo.GetByHash(ctx, hash)
+	return s.repo.GetByHash(ctx, strings.ToLower(hash))
+}
+
+// prepareTransaction validates a new transaction, normalizes its addresses to
+// EIP-55 form and derives its hash or verifies the one supplied.
+func prepareTransaction(tx *models.Transaction) error {
+	if tx.BenchmarkID == uuid.Nil {
+		return fmt.Errorf("%w: benchmark_id is required", ErrInvalidTransaction)
+	}
+	if tx.Amount < 0 || tx.GasPrice < 0 || tx.GasLimit < 0 || tx.Nonce < 0 {
+		return fmt.Errorf("%w: amount, gas price, gas limit and nonce must not be negative", ErrInvalidTransaction)
+	}
+	from, err := chain.ChecksumAddress(tx.FromAddress)
+	if err != nil {
+		return err
+	}
+	to, err := chain.ChecksumAddress(tx.ToAddress)
+	if err != nil {
+		return err
+	}
+	if from == to {
+		return fmt.Errorf("%w: from_address and to_address must differ", ErrInvalidTransaction)
+	}
+	tx.FromAddress, tx.ToAddress = from, to
+
+	if tx.Hash == "" {
+		tx.Hash, err = chain.TransactionHash(tx)
+		return err
+	}
+	if err := chain.VerifyHash(tx); err != nil {
+		return err
+	}
+	tx.Hash = strings.ToLower(tx.Hash)
+	return nil
 }
 
 func (s *transactionService) List(ctx context.Context, filter repository.TransactionFilter, page, pageSize int) ([]models.Transaction, int64, error) {
 	return s.repo.List(ctx, filter, page, pageSize)
 }
 
-func (s *transactionService) GetStats(ctx context.Context, benchmarkID string) (*repository.TransactionStats, error) {
-	return s.repo.GetStats(ctx, benchmarkID)
+func (s *transactionService) GetStats(ctx context.Context, benchmarkID string, includeUnmeasured bool) (*repository.TransactionStats, error) {
+	window, err := benchmarkWindow(ctx, s.benchmarks, benchmarkID, includeUnmeasured)
+	if err != nil {
+		return nil, err
+	}
+	stats, err := s.repo.GetStats(ctx, benchmarkID, window)
+	if err != nil {
+		return nil, err
+	}
+	gaps, err := s.repo.GetNonceGaps(ctx, benchmarkID)
+	if err != nil {
+		return nil, err
+	}
+	stats.NonceGaps = *gaps
+	return stats, nil
+}
+
+func (s *transactionService) Confirm(ctx context.Context, settlement repository.TransactionSettlement) (*models.Transaction, error) {
+	settlement.Status = models.TransactionStatusConfirmed
+	return s.settleOne(ctx, settlement)
+}
+
+func (s *transactionService) Fail(ctx context.Context, settlement repository.TransactionSettlement) (*models.Transaction, error) {
+	settlement.Status = models.TransactionStatusFailed
+	return s.settleOne(ctx, settlement)
+}
+
+func (s *transactionService) settleOne(ctx context.Context, settlement repository.TransactionSettlement) (*models.Transaction, error) {
+	results, err := s.Settle(ctx, []repository.TransactionSettlement{settlement})
+	if err != nil {
+		return nil, err
+	}
+	return results[0].Transaction, results[0].Err
+}
+
+func (s *transactionService) Settle(ctx context.Context, settlements []repository.TransactionSettlement) ([]SettleResult, error) {
+	if len(settlements) > maxSettleBatch {
+		return nil, fmt.Errorf("%w: at most %d per batch, got %d", ErrInvalidSettlement, maxSettleBatch, len(settlements))
+	}
+
+	results := make([]SettleResult, len(settlements))
+	valid := make([]repository.TransactionSettlement, 0, len(settlements))
+	index := make([]int, 0, len(settlements))
+	now := s.now()
+	for i, st := range settlements {
+		// Hashes are stored in lowercase.
+		st.Hash = strings.ToLower(st.Hash)
+		if st.Status == models.TransactionStatusConfirmed && st.ConfirmedAt.IsZero() {
+			st.ConfirmedAt = now
+		}
+		if err := validateSettlement(st); err != nil {
+			results[i].Err = err
+			continue
+		}
+		valid = append(valid, st)
+		index = append(index, i)
+	}
+	if len(valid) == 0 {
+		return results, nil
+	}
+
+	settled, err := s.repo.Settle(ctx, valid)
+	if err != nil {
+		return nil, err
+	}
+	for j, tx := range settled {
+		i := index[j]
+		if tx == nil {
+			results[i].Err = s.unsettled(ctx, valid[j])
+			continue
+		}
+		results[i].Transaction = tx
+		s.monitor.ObserveTransaction(tx, models.TransactionStatusPending)
+	}
+	return results, nil
+}
+
+// unsettled explains why a settlement matched no pending transaction.
+func (s *transactionService) unsettled(ctx context.Context, st repository.TransactionSettlement) error {
+	tx, err := s.repo.GetByHash(ctx, st.Hash)
+	switch {
+	case err != nil:
+		return err
+	case tx == nil:
+		return fmt.Errorf("transaction %s: %w", st.Hash, gorm.ErrRecordNotFound)
+	case tx.Status == models.TransactionStatusPending:
+		return fmt.Errorf("%w: transaction %s was not submitted at %s", ErrInvalidSettlement, st.Hash, st.SubmittedAt.Format(time.RFC3339))
+	}
+	return fmt.Errorf("transaction %s is %s: %w", st.Hash, tx.Status, ErrTransactionSettled)
+}
+
+func validateSettlement(st repository.TransactionSettlement) error {
+	switch {
+	case st.Hash == "":
+		return fmt.Errorf("%w: hash is required", ErrInvalidSettlement)
+	case st.Status != models.TransactionStatusConfirmed && st.Status != models.TransactionStatusFailed:
+		return fmt.Errorf("%w: cannot settle as %q", ErrInvalidSettlement, st.Status)
+	case st.Status == models.TransactionStatusFailed && !st.ConfirmedAt.IsZero():
+		return fmt.Errorf("%w: failed transactions have no confirmation time", ErrInvalidSettlement)
+	case st.BlockNumber < 0 || st.TransactionIndex < 0 || st.GasUsed < 0:
+		return fmt.Errorf("%w: block number, transaction index and gas used must not be negative", ErrInvalidSettlement)
+	case st.SubmittedAt.IsZero() && st.BenchmarkID == "":
+		return fmt.Errorf("%w: submitted_at or benchmark_id is required", ErrInvalidSettlement)
+	case !st.SubmittedAt.IsZero() && !st.ConfirmedAt.IsZero() &&
+		float64(st.ConfirmedAt.Sub(st.SubmittedAt))/float64(time.Millisecond) > models.MaxLatencyMs:
+		return fmt.Errorf("%w: latency from %s to %s is too long to record", ErrInvalidSettlement,
+			st.SubmittedAt.Format(time.RFC3339), st.ConfirmedAt.Format(time.RFC3339))
+	}
+	if st.BenchmarkID != "" {
+		if _, err := uuid.Parse(st.BenchmarkID); err != nil {
+			return fmt.Errorf("%w: invalid benchmark_id: %v", ErrInvalidSettlement, err)
+		}
+	}
+	return nil
+}
+
+func (s *transactionService) OnTransition(ctx context.Context, b *models.Benchmark, from string) {
+	if b.Status != models.BenchmarkStatusCancelled {
+		return
+	}
+	id := b.ID.String()
+	n, err := s.repo.FailPending(ctx, id, "benchmark "+b.ErrorMessage)
+	if err != nil {
+		serviceLogger().Warn("Failed to fail pending transactions", zap.String("benchmark_id", id), zap.Error(err))
+		return
+	}
+	s.monitor.ObserveStatusChange(id, models.TransactionStatusPending, models.TransactionStatusFailed, n)
 }
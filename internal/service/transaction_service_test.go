@@ -0,0 +1,297 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fffeng99999/hcp-server/internal/chain"
+	"github.com/fffeng99999/hcp-server/internal/models"
+	"github.com/fffeng99999/hcp-server/internal/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// MockTransactionRepository is a mock implementation of
+// repository.TransactionRepository.
+type MockTransactionRepository struct {
+	mock.Mock
+}
+
+func (m *MockTransactionRepository) Create(ctx context.Context, tx *models.Transaction) error {
+	return m.Called(ctx, tx).Error(0)
+}
+
+func (m *MockTransactionRepository) CreateBatch(ctx context.Context, txs []*models.Transaction) ([]error, error) {
+	args := m.Called(ctx, txs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]error), args.Error(1)
+}
+
+func (m *MockTransactionRepository) NextNonce(ctx context.Context, benchmarkID, fromAddress string) (int64, error) {
+	args := m.Called(ctx, benchmarkID, fromAddress)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockTransactionRepository) GetNonceGaps(ctx context.Context, benchmarkID string) (*repository.NonceGaps, error) {
+	args := m.Called(ctx, benchmarkID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*repository.NonceGaps), args.Error(1)
+}
+
+func (m *MockTransactionRepository) GetByHash(ctx context.Context, hash string) (*models.Transaction, error) {
+	args := m.Called(ctx, hash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Transaction), args.Error(1)
+}
+
+func (m *MockTransactionRepository) List(ctx context.Context, filter repository.TransactionFilter, page, pageSize int) ([]models.Transaction, int64, error) {
+	args := m.Called(ctx, filter, page, pageSize)
+	return args.Get(0).([]models.Transaction), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockTransactionRepository) GetStats(ctx context.Context, benchmarkID string, window repository.TimeWindow) (*repository.TransactionStats, error) {
+	args := m.Called(ctx, benchmarkID, window)
+	return args.Get(0).(*repository.TransactionStats), args.Error(1)
+}
+
+func (m *MockTransactionRepository) GetSummary(ctx context.Context, benchmarkID string, window repository.TimeWindow) (*repository.TransactionSummary, error) {
+	args := m.Called(ctx, benchmarkID, window)
+	return args.Get(0).(*repository.TransactionSummary), args.Error(1)
+}
+
+func (m *MockTransactionRepository) GetLatencySamples(ctx context.Context, benchmarkIDs []string, limit int) ([]float64, error) {
+	args := m.Called(ctx, benchmarkIDs, limit)
+	return args.Get(0).([]float64), args.Error(1)
+}
+
+func (m *MockTransactionRepository) GetThroughputSeries(ctx context.Context, benchmarkID string, bucket time.Duration) ([]repository.SeriesPoint, error) {
+	args := m.Called(ctx, benchmarkID, bucket)
+	return args.Get(0).([]repository.SeriesPoint), args.Error(1)
+}
+
+func (m *MockTransactionRepository) FailPending(ctx context.Context, benchmarkID, reason string) (int64, error) {
+	args := m.Called(ctx, benchmarkID, reason)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockTransactionRepository) Settle(ctx context.Context, settlements []repository.TransactionSettlement) ([]*models.Transaction, error) {
+	args := m.Called(ctx, settlements)
+	return args.Get(0).([]*models.Transaction), args.Error(1)
+}
+
+func newTestTransactionService(repo *MockTransactionRepository, now time.Time) *transactionService {
+	svc := NewTransactionService(repo, nil, NewBenchmarkMonitor(nil, nil)).(*transactionService)
+	svc.now = func() time.Time { return now }
+	return svc
+}
+
+func TestTransactionService_ConfirmDefaultsConfirmedAt(t *testing.T) {
+	repo := new(MockTransactionRepository)
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	svc := newTestTransactionService(repo, now)
+	ctx := context.Background()
+
+	benchmarkID := uuid.NewString()
+	confirmed := &models.Transaction{Hash: "0xaa", Status: models.TransactionStatusConfirmed, LatencyMs: 250}
+	repo.On("Settle", ctx, []repository.TransactionSettlement{{
+		Hash:        "0xaa",
+		BenchmarkID: benchmarkID,
+		Status:      models.TransactionStatusConfirmed,
+		BlockNumber: 7,
+		ConfirmedAt: now,
+	}}).Return([]*models.Transaction{confirmed}, nil)
+
+	// Hashes are matched in lowercase.
+	tx, err := svc.Confirm(ctx, repository.TransactionSettlement{Hash: "0xAA", BenchmarkID: benchmarkID, BlockNumber: 7})
+
+	require.NoError(t, err)
+	assert.Equal(t, confirmed, tx)
+	repo.AssertExpectations(t)
+}
+
+func TestTransactionService_SettleReportsEachEntry(t *testing.T) {
+	repo := new(MockTransactionRepository)
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	svc := newTestTransactionService(repo, now)
+	ctx := context.Background()
+	submittedAt := now.Add(-time.Minute)
+	benchmarkID := uuid.NewString()
+
+	failed := &models.Transaction{Hash: "0x01", Status: models.TransactionStatusFailed}
+	repo.On("Settle", ctx, mock.MatchedBy(func(s []repository.TransactionSettlement) bool {
+		return len(s) == 4
+	})).Return([]*models.Transaction{failed, nil, nil, nil}, nil)
+	repo.On("GetByHash", ctx, "0x02").Return(nil, nil)
+	repo.On("GetByHash", ctx, "0x03").Return(&models.Transaction{Hash: "0x03", Status: models.TransactionStatusConfirmed}, nil)
+	repo.On("GetByHash", ctx, "0x04").Return(&models.Transaction{Hash: "0x04", Status: models.TransactionStatusPending}, nil)
+
+	results, err := svc.Settle(ctx, []repository.TransactionSettlement{
+		{Hash: "0x01", BenchmarkID: benchmarkID, Status: models.TransactionStatusFailed, ErrorMessage: "reverted"},
+		{Hash: "", Status: models.TransactionStatusFailed},
+		{Hash: "0x02", BenchmarkID: benchmarkID, Status: models.TransactionStatusConfirmed},
+		{Hash: "0x03", BenchmarkID: benchmarkID, Status: models.TransactionStatusFailed},
+		{Hash: "0x04", Status: models.TransactionStatusConfirmed, SubmittedAt: submittedAt},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, results, 5)
+	assert.Equal(t, failed, results[0].Transaction)
+	assert.ErrorIs(t, results[1].Err, ErrInvalidSettlement)
+	assert.ErrorIs(t, results[2].Err, gorm.ErrRecordNotFound)
+	assert.ErrorIs(t, results[3].Err, ErrTransactionSettled)
+	assert.ErrorIs(t, results[4].Err, ErrInvalidSettlement)
+	repo.AssertExpectations(t)
+}
+
+func TestTransactionService_SettleValidates(t *testing.T) {
+	svc := newTestTransactionService(new(MockTransactionRepository), time.Now())
+	ctx := context.Background()
+
+	_, err := svc.Fail(ctx, repository.TransactionSettlement{Hash: "0xaa", ConfirmedAt: time.Now()})
+	assert.ErrorIs(t, err, ErrInvalidSettlement)
+	_, err = svc.Confirm(ctx, repository.TransactionSettlement{Hash: "0xaa", GasUsed: -1})
+	assert.ErrorIs(t, err, ErrInvalidSettlement)
+
+	// A hash alone could match transactions of several benchmarks.
+	_, err = svc.Confirm(ctx, repository.TransactionSettlement{Hash: "0xaa"})
+	assert.ErrorIs(t, err, ErrInvalidSettlement)
+	_, err = svc.Confirm(ctx, repository.TransactionSettlement{Hash: "0xaa", BenchmarkID: "run-1"})
+	assert.ErrorIs(t, err, ErrInvalidSettlement)
+
+	// latency_ms holds about 115 days.
+	submittedAt := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	_, err = svc.Confirm(ctx, repository.TransactionSettlement{
+		Hash: "0xaa", SubmittedAt: submittedAt, ConfirmedAt: submittedAt.Add(200 * 24 * time.Hour),
+	})
+	assert.ErrorIs(t, err, ErrInvalidSettlement)
+
+	_, err = svc.Settle(ctx, make([]repository.TransactionSettlement, maxSettleBatch+1))
+	assert.ErrorIs(t, err, ErrInvalidSettlement)
+}
+
+func TestTransactionService_CreateHashesAndChecksums(t *testing.T) {
+	repo := new(MockTransactionRepository)
+	svc := newTestTransactionService(repo, time.Now())
+	ctx := context.Background()
+	repo.On("Create", ctx, mock.Anything).Return(nil)
+
+	tx, err := svc.Create(ctx, &models.Transaction{
+		BenchmarkID: uuid.New(),
+		FromAddress: "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed",
+		ToAddress:   "0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359",
+		Amount:      5,
+		Nonce:       1,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", tx.FromAddress)
+	assert.NoError(t, chain.VerifyHash(tx))
+
+	// A supplied hash must match the contents.
+	_, err = svc.Create(ctx, &models.Transaction{
+		Hash:        tx.Hash,
+		BenchmarkID: tx.BenchmarkID,
+		FromAddress: tx.FromAddress,
+		ToAddress:   tx.ToAddress,
+		Amount:      6,
+		Nonce:       1,
+	})
+	assert.ErrorIs(t, err, chain.ErrHashMismatch)
+	// So does the benchmark.
+	_, err = svc.Create(ctx, &models.Transaction{
+		Hash:        tx.Hash,
+		BenchmarkID: uuid.New(),
+		FromAddress: tx.FromAddress,
+		ToAddress:   tx.ToAddress,
+		Amount:      5,
+		Nonce:       1,
+	})
+	assert.ErrorIs(t, err, chain.ErrHashMismatch)
+
+	_, err = svc.Create(ctx, &models.Transaction{FromAddress: tx.FromAddress, ToAddress: tx.FromAddress})
+	assert.ErrorIs(t, err, ErrInvalidTransaction)
+	repo.AssertNumberOfCalls(t, "Create", 1)
+}
+
+func TestTransactionService_CreateWithNextNonceRetries(t *testing.T) {
+	repo := new(MockTransactionRepository)
+	svc := newTestTransactionService(repo, time.Now())
+	ctx := context.Background()
+	id := uuid.New()
+	from := "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"
+
+	// A concurrent request takes nonce 3 first.
+	repo.On("NextNonce", ctx, id.String(), from).Return(int64(3), nil).Once()
+	repo.On("NextNonce", ctx, id.String(), from).Return(int64(4), nil).Once()
+	repo.On("Create", ctx, mock.MatchedBy(func(tx *models.Transaction) bool { return tx.Nonce == 3 })).Return(repository.ErrNonceUsed).Once()
+	repo.On("Create", ctx, mock.MatchedBy(func(tx *models.Transaction) bool { return tx.Nonce == 4 })).Return(nil).Once()
+
+	tx, err := svc.CreateWithNextNonce(ctx, &models.Transaction{
+		FromAddress: strings.ToLower(from),
+		ToAddress:   "0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359",
+		BenchmarkID: id,
+		Nonce:       99,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), tx.Nonce)
+	assert.NoError(t, chain.VerifyHash(tx))
+	repo.AssertExpectations(t)
+
+	_, err = svc.CreateWithNextNonce(ctx, &models.Transaction{Hash: tx.Hash, FromAddress: from, ToAddress: tx.ToAddress})
+	assert.ErrorIs(t, err, ErrInvalidTransaction)
+}
+
+func TestTransactionService_CreateRejectsReplay(t *testing.T) {
+	repo := new(MockTransactionRepository)
+	svc := newTestTransactionService(repo, time.Now())
+	ctx := context.Background()
+	repo.On("Create", ctx, mock.Anything).Return(repository.ErrNonceUsed)
+
+	_, err := svc.Create(ctx, &models.Transaction{
+		BenchmarkID: uuid.New(),
+		FromAddress: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+		ToAddress:   "0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359",
+		Nonce:       2,
+	})
+	assert.ErrorIs(t, err, repository.ErrNonceUsed)
+	assert.Contains(t, err.Error(), "nonce 2 of 0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed")
+}
+
+func TestTransactionService_GetStatsReportsNonceGaps(t *testing.T) {
+	repo := new(MockTransactionRepository)
+	svc := newTestTransactionService(repo, time.Now())
+	ctx := context.Background()
+	gaps := repository.NonceGaps{Senders: 4, SendersWithGaps: 1, MissingNonces: 3}
+	repo.On("GetStats", ctx, "b1", repository.TimeWindow{}).Return(&repository.TransactionStats{TotalTransactions: 10}, nil)
+	repo.On("GetNonceGaps", ctx, "b1").Return(&gaps, nil)
+
+	stats, err := svc.GetStats(ctx, "b1", true)
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), stats.TotalTransactions)
+	assert.Equal(t, gaps, stats.NonceGaps)
+}
+
+func TestTransactionService_CreateRejectsFinishedBenchmark(t *testing.T) {
+	repo := new(MockTransactionRepository)
+	svc := newTestTransactionService(repo, time.Now())
+	ctx := context.Background()
+	repo.On("Create", ctx, mock.Anything).Return(repository.ErrBenchmarkFinished)
+
+	_, err := svc.Create(ctx, &models.Transaction{
+		BenchmarkID: uuid.New(),
+		FromAddress: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+		ToAddress:   "0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359",
+	})
+	assert.ErrorIs(t, err, repository.ErrBenchmarkFinished)
+}
@@ -0,0 +1,190 @@
+// Package sla parses and evaluates service-level assertions on the results of
+// a benchmark run, e.g. "actual_tps >= 0.9*target_tps", "latency_p99 < 500ms"
+// or "max(cpu_usage) < 80".
+//
+// An assertion is two arithmetic expressions joined by one of < <= > >= == !=.
+// Expressions are built from numbers, result fields, + - * / and parentheses,
+// and aggregates over reported metrics: max, min, avg, sum and count. Numbers
+// may carry a unit: "ms" and "s" give milliseconds, the unit latencies are
+// recorded in, and "%" gives a fraction, so "failed_tx/transaction_count < 1%"
+// reads as expected.
+package sla
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// ErrInvalid is returned for assertions that do not parse.
+var ErrInvalid = errors.New("invalid assertion")
+
+// Functions are the aggregates assertions may apply to a metric.
+var Functions = []string{"max", "min", "avg", "sum", "count"}
+
+// Env supplies the values an assertion refers to.
+type Env interface {
+	// Field returns a result field of the run, e.g. actual_tps.
+	Field(name string) (float64, bool)
+	// Aggregate returns fn, one of Functions, over the samples of a metric.
+	// It reports false if the metric was not recorded.
+	Aggregate(fn, metric string) (float64, bool)
+}
+
+// Assertion is a parsed assertion.
+type Assertion struct {
+	source      string
+	op          string
+	left, right node
+}
+
+// Result is the outcome of evaluating an assertion. Left and Right are the
+// values the two sides evaluated to. Err is set when the assertion could not
+// be evaluated, e.g. because a metric was never reported; it does not pass.
+type Result struct {
+	Left, Right float64
+	Passed      bool
+	Err         error
+}
+
+// String returns the assertion as it was written.
+func (a *Assertion) String() string {
+	return a.source
+}
+
+// Fields returns the result fields the assertion refers to.
+func (a *Assertion) Fields() []string {
+	var fields []string
+	seen := map[string]bool{}
+	for _, n := range []node{a.left, a.right} {
+		n.walk(func(n node) {
+			if f, ok := n.(field); ok && !seen[string(f)] {
+				seen[string(f)] = true
+				fields = append(fields, string(f))
+			}
+		})
+	}
+	return fields
+}
+
+// Evaluate computes both sides of the assertion and compares them.
+func (a *Assertion) Evaluate(env Env) Result {
+	left, err := a.left.eval(env)
+	if err != nil {
+		return Result{Err: err}
+	}
+	right, err := a.right.eval(env)
+	if err != nil {
+		return Result{Left: left, Err: err}
+	}
+	return Result{Left: left, Right: right, Passed: compare(a.op, left, right)}
+}
+
+func compare(op string, l, r float64) bool {
+	switch op {
+	case "<":
+		return l < r
+	case "<=":
+		return l <= r
+	case ">":
+		return l > r
+	case ">=":
+		return l >= r
+	case "==":
+		return l == r
+	case "!=":
+		return l != r
+	}
+	return false
+}
+
+// node is an arithmetic expression.
+type node interface {
+	eval(env Env) (float64, error)
+	walk(fn func(node))
+}
+
+type number float64
+
+func (n number) eval(Env) (float64, error) { return float64(n), nil }
+func (n number) walk(fn func(node))        { fn(n) }
+
+type field string
+
+func (f field) eval(env Env) (float64, error) {
+	v, ok := env.Field(string(f))
+	if !ok {
+		return 0, fmt.Errorf("unknown field %q", string(f))
+	}
+	return v, nil
+}
+
+func (f field) walk(fn func(node)) { fn(f) }
+
+type aggregate struct {
+	fn, metric string
+}
+
+func (a aggregate) eval(env Env) (float64, error) {
+	v, ok := env.Aggregate(a.fn, a.metric)
+	if !ok {
+		return 0, fmt.Errorf("metric %q was not reported", a.metric)
+	}
+	return v, nil
+}
+
+func (a aggregate) walk(fn func(node)) { fn(a) }
+
+type negate struct {
+	x node
+}
+
+func (n negate) eval(env Env) (float64, error) {
+	v, err := n.x.eval(env)
+	return -v, err
+}
+
+func (n negate) walk(fn func(node)) {
+	fn(n)
+	n.x.walk(fn)
+}
+
+type binary struct {
+	op          byte
+	left, right node
+}
+
+func (b binary) eval(env Env) (float64, error) {
+	l, err := b.left.eval(env)
+	if err != nil {
+		return 0, err
+	}
+	r, err := b.right.eval(env)
+	if err != nil {
+		return 0, err
+	}
+	var v float64
+	switch b.op {
+	case '+':
+		v = l + r
+	case '-':
+		v = l - r
+	case '*':
+		v = l * r
+	case '/':
+		if r == 0 {
+			return 0, errors.New("division by zero")
+		}
+		v = l / r
+	}
+	if math.IsInf(v, 0) || math.IsNaN(v) {
+		return 0, errors.New("result out of range")
+	}
+	return v, nil
+}
+
+func (b binary) walk(fn func(node)) {
+	fn(b)
+	b.left.walk(fn)
+	b.right.walk(fn)
+}
@@ -0,0 +1,255 @@
+package sla
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// units maps number suffixes onto the factor they scale the number by.
+var units = map[string]float64{
+	"ms": 1,
+	"s":  1000,
+	"%":  0.01,
+}
+
+// Parse parses an assertion. It checks syntax only; whether the fields it
+// names exist is up to the caller, see Assertion.Fields.
+func Parse(s string) (*Assertion, error) {
+	source := strings.TrimSpace(s)
+	tokens, err := tokenize(source)
+	if err != nil {
+		return nil, fmt.Errorf("%w %q: %v", ErrInvalid, source, err)
+	}
+	p := &parser{tokens: tokens}
+	a, err := p.assertion()
+	if err != nil {
+		return nil, fmt.Errorf("%w %q: %v", ErrInvalid, source, err)
+	}
+	a.source = source
+	return a, nil
+}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenNumber
+	tokenIdent
+	tokenOp
+)
+
+type token struct {
+	kind  tokenKind
+	text  string
+	value float64
+}
+
+func tokenize(s string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(s); {
+		c := rune(s[i])
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case unicode.IsDigit(c) || c == '.':
+			j := i
+			for j < len(s) && (unicode.IsDigit(rune(s[j])) || s[j] == '.') {
+				j++
+			}
+			value, err := strconv.ParseFloat(s[i:j], 64)
+			if err != nil {
+				return nil, fmt.Errorf("bad number %q", s[i:j])
+			}
+			k := j
+			for k < len(s) && (unicode.IsLetter(rune(s[k])) || s[k] == '%') {
+				k++
+			}
+			if k > j {
+				factor, ok := units[s[j:k]]
+				if !ok {
+					return nil, fmt.Errorf("unknown unit %q", s[j:k])
+				}
+				value *= factor
+			}
+			tokens = append(tokens, token{kind: tokenNumber, text: s[i:k], value: value})
+			i = k
+		case unicode.IsLetter(c) || c == '_':
+			j := i
+			for j < len(s) && (unicode.IsLetter(rune(s[j])) || unicode.IsDigit(rune(s[j])) || s[j] == '_') {
+				j++
+			}
+			tokens = append(tokens, token{kind: tokenIdent, text: s[i:j]})
+			i = j
+		default:
+			op := s[i : i+1]
+			if i+1 < len(s) && s[i+1] == '=' && strings.ContainsRune("<>=!", c) {
+				op = s[i : i+2]
+			}
+			switch op {
+			case "+", "-", "*", "/", "(", ")", "<", "<=", ">", ">=", "==", "!=":
+			default:
+				return nil, fmt.Errorf("unexpected %q", op)
+			}
+			tokens = append(tokens, token{kind: tokenOp, text: op})
+			i += len(op)
+		}
+	}
+	return append(tokens, token{kind: tokenEOF}), nil
+}
+
+// parser is a recursive-descent parser over the grammar
+//
+//	assertion = expr cmp expr
+//	expr      = term { ("+" | "-") term }
+//	term      = unary { ("*" | "/") unary }
+//	unary     = "-" unary | primary
+//	primary   = number | field | func "(" metric ")" | "(" expr ")"
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) accept(ops ...string) (string, bool) {
+	t := p.peek()
+	if t.kind != tokenOp {
+		return "", false
+	}
+	for _, op := range ops {
+		if t.text == op {
+			p.pos++
+			return op, true
+		}
+	}
+	return "", false
+}
+
+func (p *parser) assertion() (*Assertion, error) {
+	left, err := p.expr()
+	if err != nil {
+		return nil, err
+	}
+	op, ok := p.accept("<", "<=", ">", ">=", "==", "!=")
+	if !ok {
+		return nil, fmt.Errorf("expected a comparison, got %s", describe(p.peek()))
+	}
+	right, err := p.expr()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != tokenEOF {
+		return nil, fmt.Errorf("unexpected %s", describe(t))
+	}
+	return &Assertion{op: op, left: left, right: right}, nil
+}
+
+func (p *parser) expr() (node, error) {
+	left, err := p.term()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op, ok := p.accept("+", "-")
+		if !ok {
+			return left, nil
+		}
+		right, err := p.term()
+		if err != nil {
+			return nil, err
+		}
+		left = binary{op: op[0], left: left, right: right}
+	}
+}
+
+func (p *parser) term() (node, error) {
+	left, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op, ok := p.accept("*", "/")
+		if !ok {
+			return left, nil
+		}
+		right, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		left = binary{op: op[0], left: left, right: right}
+	}
+}
+
+func (p *parser) unary() (node, error) {
+	if _, ok := p.accept("-"); ok {
+		x, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		return negate{x: x}, nil
+	}
+	return p.primary()
+}
+
+func (p *parser) primary() (node, error) {
+	t := p.next()
+	switch t.kind {
+	case tokenNumber:
+		return number(t.value), nil
+	case tokenIdent:
+		if _, ok := p.accept("("); !ok {
+			return field(t.text), nil
+		}
+		if !isFunction(t.text) {
+			return nil, fmt.Errorf("unknown function %q (available: %s)", t.text, strings.Join(Functions, ", "))
+		}
+		metric := p.next()
+		if metric.kind != tokenIdent {
+			return nil, fmt.Errorf("%s() expects a metric name, got %s", t.text, describe(metric))
+		}
+		if _, ok := p.accept(")"); !ok {
+			return nil, fmt.Errorf("expected \")\", got %s", describe(p.peek()))
+		}
+		return aggregate{fn: t.text, metric: metric.text}, nil
+	case tokenOp:
+		if t.text == "(" {
+			x, err := p.expr()
+			if err != nil {
+				return nil, err
+			}
+			if _, ok := p.accept(")"); !ok {
+				return nil, fmt.Errorf("expected \")\", got %s", describe(p.peek()))
+			}
+			return x, nil
+		}
+	}
+	return nil, fmt.Errorf("expected a value, got %s", describe(t))
+}
+
+func isFunction(name string) bool {
+	for _, fn := range Functions {
+		if fn == name {
+			return true
+		}
+	}
+	return false
+}
+
+func describe(t token) string {
+	if t.kind == tokenEOF {
+		return "end of input"
+	}
+	return strconv.Quote(t.text)
+}
@@ -0,0 +1,103 @@
+package sla
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testEnv struct {
+	fields     map[string]float64
+	aggregates map[string]float64
+}
+
+func (e testEnv) Field(name string) (float64, bool) {
+	v, ok := e.fields[name]
+	return v, ok
+}
+
+func (e testEnv) Aggregate(fn, metric string) (float64, bool) {
+	v, ok := e.aggregates[fn+"("+metric+")"]
+	return v, ok
+}
+
+func TestEvaluate(t *testing.T) {
+	env := testEnv{
+		fields: map[string]float64{
+			"actual_tps":        950,
+			"target_tps":        1000,
+			"latency_p99":       620,
+			"failed_tx":         5,
+			"transaction_count": 1000,
+		},
+		aggregates: map[string]float64{"max(cpu_usage)": 72.5},
+	}
+
+	cases := []struct {
+		expr        string
+		passed      bool
+		left, right float64
+	}{
+		{"actual_tps >= 0.9*target_tps", true, 950, 900},
+		{"latency_p99 < 500ms", false, 620, 500},
+		{"latency_p99 < 1s", true, 620, 1000},
+		{"failed_tx/transaction_count < 0.01", true, 0.005, 0.01},
+		{"failed_tx / transaction_count <= 0.1%", false, 0.005, 0.001},
+		{"max(cpu_usage) < 80", true, 72.5, 80},
+		{"(actual_tps - target_tps) / target_tps > -10%", true, -0.05, -0.1},
+		{"transaction_count == 1000", true, 1000, 1000},
+	}
+	for _, c := range cases {
+		a, err := Parse(c.expr)
+		require.NoError(t, err, c.expr)
+		res := a.Evaluate(env)
+		require.NoError(t, res.Err, c.expr)
+		assert.Equal(t, c.passed, res.Passed, c.expr)
+		assert.InDelta(t, c.left, res.Left, 1e-9, c.expr)
+		assert.InDelta(t, c.right, res.Right, 1e-9, c.expr)
+	}
+}
+
+func TestEvaluate_Errors(t *testing.T) {
+	env := testEnv{fields: map[string]float64{"failed_tx": 0, "transaction_count": 0}}
+
+	res := mustParse(t, "failed_tx/transaction_count < 0.01").Evaluate(env)
+	assert.ErrorContains(t, res.Err, "division by zero")
+	assert.False(t, res.Passed)
+
+	res = mustParse(t, "max(cpu_usage) < 80").Evaluate(env)
+	assert.ErrorContains(t, res.Err, `"cpu_usage" was not reported`)
+	assert.False(t, res.Passed)
+}
+
+func TestParse_Invalid(t *testing.T) {
+	for _, bad := range []string{
+		"",
+		"actual_tps",
+		"actual_tps > ",
+		"actual_tps >> 1",
+		"latency_p99 < 5min",
+		"median(cpu_usage) < 80",
+		"max(80) < 80",
+		"(actual_tps > 1",
+		"actual_tps > 1 2",
+		"actual_tps > 1 < 2",
+	} {
+		_, err := Parse(bad)
+		assert.ErrorIs(t, err, ErrInvalid, bad)
+	}
+}
+
+func TestAssertion_Fields(t *testing.T) {
+	a := mustParse(t, "failed_tx / transaction_count < 0.01 * failed_tx + max(cpu_usage)")
+	assert.Equal(t, []string{"failed_tx", "transaction_count"}, a.Fields())
+	assert.Equal(t, "failed_tx / transaction_count < 0.01 * failed_tx + max(cpu_usage)", a.String())
+}
+
+func mustParse(t *testing.T, s string) *Assertion {
+	t.Helper()
+	a, err := Parse(s)
+	require.NoError(t, err)
+	return a
+}
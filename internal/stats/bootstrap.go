@@ -0,0 +1,45 @@
+package stats
+
+import (
+	"math/rand"
+	"sort"
+)
+
+// Interval is a two-sided confidence interval.
+type Interval struct {
+	Lower float64
+	Upper float64
+	Level float64
+}
+
+// Statistic computes a summary value from a sample.
+type Statistic func(xs []float64) float64
+
+// P99 is the 99th percentile statistic.
+func P99(xs []float64) float64 { return Quantile(xs, 0.99) }
+
+// BootstrapCI estimates a percentile bootstrap confidence interval for stat
+// over xs, drawing iterations resamples with replacement. The same seed
+// always yields the same interval.
+func BootstrapCI(xs []float64, stat Statistic, level float64, iterations int, seed int64) Interval {
+	ci := Interval{Level: level}
+	if len(xs) == 0 || iterations <= 0 {
+		return ci
+	}
+
+	rng := rand.New(rand.NewSource(seed))
+	resample := make([]float64, len(xs))
+	estimates := make([]float64, iterations)
+	for i := range estimates {
+		for j := range resample {
+			resample[j] = xs[rng.Intn(len(xs))]
+		}
+		estimates[i] = stat(resample)
+	}
+	sort.Float64s(estimates)
+
+	alpha := (1 - level) / 2
+	ci.Lower = quantileSorted(estimates, alpha)
+	ci.Upper = quantileSorted(estimates, 1-alpha)
+	return ci
+}
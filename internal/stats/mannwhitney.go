@@ -0,0 +1,87 @@
+package stats
+
+import (
+	"math"
+	"sort"
+)
+
+// MannWhitneyResult is the outcome of a two-sided Mann-Whitney U test.
+type MannWhitneyResult struct {
+	// U is the statistic for the second sample: the number of pairs (x, y)
+	// with y > x, counting ties as one half.
+	U float64
+	Z float64
+	// PValue is two-sided, from the normal approximation with tie and
+	// continuity correction.
+	PValue float64
+	// EffectSize is the rank-biserial correlation in [-1, 1]. Positive values
+	// mean the second sample tends to be larger.
+	EffectSize float64
+}
+
+// MannWhitneyU tests whether samples x and y come from the same distribution.
+func MannWhitneyU(x, y []float64) MannWhitneyResult {
+	n1, n2 := float64(len(x)), float64(len(y))
+	if n1 == 0 || n2 == 0 {
+		return MannWhitneyResult{PValue: 1}
+	}
+
+	type obs struct {
+		v      float64
+		second bool
+	}
+	all := make([]obs, 0, len(x)+len(y))
+	for _, v := range x {
+		all = append(all, obs{v: v})
+	}
+	for _, v := range y {
+		all = append(all, obs{v: v, second: true})
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].v < all[j].v })
+
+	// Average ranks over ties and collect the tie correction term.
+	rankSumY, tieTerm := 0.0, 0.0
+	for i := 0; i < len(all); {
+		j := i
+		for j < len(all) && all[j].v == all[i].v {
+			j++
+		}
+		rank := float64(i+j+1) / 2 // ranks are 1-based
+		for k := i; k < j; k++ {
+			if all[k].second {
+				rankSumY += rank
+			}
+		}
+		t := float64(j - i)
+		tieTerm += t*t*t - t
+		i = j
+	}
+
+	u := rankSumY - n2*(n2+1)/2
+	mean := n1 * n2 / 2
+	n := n1 + n2
+	variance := n1 * n2 / 12 * ((n + 1) - tieTerm/(n*(n-1)))
+
+	result := MannWhitneyResult{
+		U:          u,
+		EffectSize: 2*u/(n1*n2) - 1,
+		PValue:     1,
+	}
+	if variance <= 0 {
+		return result
+	}
+
+	diff := u - mean
+	// Continuity correction towards the mean.
+	switch {
+	case diff > 0.5:
+		diff -= 0.5
+	case diff < -0.5:
+		diff += 0.5
+	default:
+		diff = 0
+	}
+	result.Z = diff / math.Sqrt(variance)
+	result.PValue = math.Erfc(math.Abs(result.Z) / math.Sqrt2)
+	return result
+}
@@ -0,0 +1,48 @@
+package stats
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuantile(t *testing.T) {
+	xs := []float64{4, 1, 3, 2, 5}
+
+	assert.Equal(t, 3.0, Quantile(xs, 0.5))
+	assert.Equal(t, 1.0, Quantile(xs, 0))
+	assert.Equal(t, 5.0, Quantile(xs, 1))
+	assert.InDelta(t, 4.96, Quantile(xs, 0.99), 1e-9)
+	assert.Equal(t, []float64{4, 1, 3, 2, 5}, xs)
+	assert.Equal(t, 3.0, Mean(xs))
+}
+
+func TestBootstrapCI_ContainsEstimateAndIsDeterministic(t *testing.T) {
+	xs := make([]float64, 200)
+	for i := range xs {
+		xs[i] = float64(i % 20)
+	}
+
+	ci := BootstrapCI(xs, Mean, 0.95, 500, 42)
+
+	assert.Less(t, ci.Lower, Mean(xs))
+	assert.Greater(t, ci.Upper, Mean(xs))
+	assert.Equal(t, 0.95, ci.Level)
+	assert.Equal(t, ci, BootstrapCI(xs, Mean, 0.95, 500, 42))
+}
+
+func TestMannWhitneyU(t *testing.T) {
+	// Classic textbook example with no overlap: every y exceeds every x.
+	x := []float64{1, 2, 3, 4, 5}
+	y := []float64{6, 7, 8, 9, 10}
+
+	r := MannWhitneyU(x, y)
+	assert.Equal(t, 25.0, r.U)
+	assert.Equal(t, 1.0, r.EffectSize)
+	assert.Less(t, r.PValue, 0.05)
+
+	same := MannWhitneyU(x, x)
+	assert.Equal(t, 12.5, same.U)
+	assert.Equal(t, 1.0, same.PValue)
+	assert.Zero(t, same.EffectSize)
+}
@@ -17,6 +17,7 @@ mkdir -p api/generated/benchmark
 mkdir -p api/generated/transaction
 mkdir -p api/generated/node
 mkdir -p api/generated/metric
+mkdir -p api/generated/experiment
 
 # Generate
 protoc --proto_path=. \